@@ -0,0 +1,219 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// ARC is an Adaptive Replacement Cache. It balances recently and frequently used entries, tracking the keys
+// recently evicted from each side to adapt, which makes it resistant to scans that would flush an LRU cache.
+// It is safe for concurrent use.
+type ARC[K comparable, V any] struct {
+	stats    recorder[K] // must stay first, see recorder
+	capacity int
+	p        int // target size of t1
+	mu       sync.Mutex
+
+	t1, t2 *list.List // entries seen once and at least twice recently, front is the most recent
+	b1, b2 *list.List // ghost keys recently evicted from t1 and t2
+	items  map[K]*arcItem[K, V]
+
+	evicted []K // keys evicted by the running Set
+}
+
+type arcItem[K comparable, V any] struct {
+	key   K
+	value V
+	elem  *list.Element
+	list  *list.List
+}
+
+// NewARC returns an ARC cache holding up to capacity entries.
+func NewARC[K comparable, V any](capacity int) (*ARC[K, V], error) {
+	if err := checkCapacity(capacity); err != nil {
+		return nil, err
+	}
+
+	return &ARC[K, V]{
+		capacity: capacity,
+		t1:       list.New(),
+		t2:       list.New(),
+		b1:       list.New(),
+		b2:       list.New(),
+		items:    make(map[K]*arcItem[K, V]),
+	}, nil
+}
+
+// Get returns the value of key, promoting it to the frequently used entries.
+func (c *ARC[K, V]) Get(key K) (V, bool) {
+	value, ok := c.get(key)
+	c.stats.lookup(key, ok)
+
+	return value, ok
+}
+
+func (c *ARC[K, V]) get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, ok := c.items[key]
+	if !ok || item.list == c.b1 || item.list == c.b2 {
+		var zero V
+		return zero, false
+	}
+
+	c.move(item, c.t2)
+
+	return item.value, true
+}
+
+// Set stores value under key, evicting an entry chosen by the adaptive policy if the cache is full.
+func (c *ARC[K, V]) Set(key K, value V) {
+	for _, evicted := range c.set(key, value) {
+		c.stats.record(EventEviction, evicted)
+	}
+}
+
+// set stores value under key and returns the evicted keys.
+func (c *ARC[K, V]) set(key K, value V) []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.evicted = nil
+
+	item, ok := c.items[key]
+	switch {
+	case ok && (item.list == c.t1 || item.list == c.t2):
+		item.value = value
+		c.move(item, c.t2)
+	case ok && item.list == c.b1:
+		// a recently evicted once-seen key is back, favor recency
+		c.p = minInt(c.capacity, c.p+maxInt(c.b2.Len()/c.b1.Len(), 1))
+		c.replace(false)
+		item.value = value
+		c.move(item, c.t2)
+	case ok && item.list == c.b2:
+		// a recently evicted frequent key is back, favor frequency
+		c.p = maxInt(0, c.p-maxInt(c.b1.Len()/c.b2.Len(), 1))
+		c.replace(true)
+		item.value = value
+		c.move(item, c.t2)
+	default:
+		c.admit()
+		item = &arcItem[K, V]{key: key, value: value}
+		c.items[key] = item
+		c.move(item, c.t1)
+	}
+
+	return c.evicted
+}
+
+// Delete removes key.
+func (c *ARC[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if item, ok := c.items[key]; ok {
+		item.list.Remove(item.elem)
+		delete(c.items, key)
+	}
+}
+
+// Len returns the number of entries, not counting the ghost keys.
+func (c *ARC[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.t1.Len() + c.t2.Len()
+}
+
+// Stats returns a snapshot of the counters of the cache.
+func (c *ARC[K, V]) Stats() Stats {
+	return c.stats.snapshot(c.Len())
+}
+
+// SetObserver sets the observer notified of the events of the cache, or removes it if o is nil.
+func (c *ARC[K, V]) SetObserver(o Observer[K]) {
+	c.stats.setObserver(o)
+}
+
+// admit makes room for a new key, the caller must hold c.mu.
+func (c *ARC[K, V]) admit() {
+	l1 := c.t1.Len() + c.b1.Len()
+	total := l1 + c.t2.Len() + c.b2.Len()
+
+	switch {
+	case l1 == c.capacity:
+		if c.t1.Len() < c.capacity {
+			c.drop(c.b1.Back())
+			c.replace(false)
+		} else {
+			c.evicted = append(c.evicted, c.t1.Back().Value.(*arcItem[K, V]).key)
+			c.drop(c.t1.Back())
+		}
+	case l1 < c.capacity && total >= c.capacity:
+		if total == 2*c.capacity {
+			c.drop(c.b2.Back())
+		}
+
+		c.replace(false)
+	}
+}
+
+// replace evicts an entry from t1 or t2 to its ghost list, the caller must hold c.mu.
+func (c *ARC[K, V]) replace(inB2 bool) {
+	if c.t1.Len()+c.t2.Len() < c.capacity {
+		return
+	}
+
+	if c.t1.Len() > 0 && (c.t1.Len() > c.p || (inB2 && c.t1.Len() == c.p)) {
+		c.ghost(c.t1.Back(), c.b1)
+	} else if c.t2.Len() > 0 {
+		c.ghost(c.t2.Back(), c.b2)
+	} else {
+		c.ghost(c.t1.Back(), c.b1)
+	}
+}
+
+// ghost moves an entry to a ghost list, dropping its value, the caller must hold c.mu.
+func (c *ARC[K, V]) ghost(elem *list.Element, to *list.List) {
+	item := elem.Value.(*arcItem[K, V])
+	c.evicted = append(c.evicted, item.key)
+
+	var zero V
+	item.value = zero
+	c.move(item, to)
+}
+
+// drop forgets a ghost key, the caller must hold c.mu.
+func (c *ARC[K, V]) drop(elem *list.Element) {
+	item := elem.Value.(*arcItem[K, V])
+	item.list.Remove(elem)
+	delete(c.items, item.key)
+}
+
+// move moves the item to the front of a list, the caller must hold c.mu.
+func (c *ARC[K, V]) move(item *arcItem[K, V], to *list.List) {
+	if item.list != nil {
+		item.list.Remove(item.elem)
+	}
+
+	item.list = to
+	item.elem = to.PushFront(item)
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
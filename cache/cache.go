@@ -0,0 +1,30 @@
+package cache
+
+import "fmt"
+
+// Cache is implemented by every cache of the package, so callers can switch eviction policies.
+type Cache[K comparable, V any] interface {
+	// Get returns the value of key and whether it was present.
+	Get(key K) (V, bool)
+	// Set stores value under key, possibly evicting other entries.
+	Set(key K, value V)
+	// Delete removes key.
+	Delete(key K)
+	// Len returns the number of entries.
+	Len() int
+}
+
+var (
+	_ Cache[string, int] = (*TTL[string, int])(nil)
+	_ Cache[string, int] = (*LRU[string, int])(nil)
+	_ Cache[string, int] = (*LFU[string, int])(nil)
+	_ Cache[string, int] = (*ARC[string, int])(nil)
+)
+
+func checkCapacity(capacity int) error {
+	if capacity <= 0 {
+		return fmt.Errorf("capacity must be positive, got %d", capacity)
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package cache
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// newCaches returns one cache of each eviction policy holding up to capacity entries.
+func newCaches(t *testing.T, capacity int) map[string]Cache[string, int] {
+	t.Helper()
+
+	lru, err := NewLRU[string, int](capacity)
+	if err != nil {
+		t.Fatalf("NewLRU() error = %v", err)
+	}
+
+	lfu, err := NewLFU[string, int](capacity)
+	if err != nil {
+		t.Fatalf("NewLFU() error = %v", err)
+	}
+
+	arc, err := NewARC[string, int](capacity)
+	if err != nil {
+		t.Fatalf("NewARC() error = %v", err)
+	}
+
+	return map[string]Cache[string, int]{"lru": lru, "lfu": lfu, "arc": arc}
+}
+
+func TestCapacity(t *testing.T) {
+	if _, err := NewLRU[string, int](0); err == nil {
+		t.Errorf("NewLRU() expected error for zero capacity")
+	}
+
+	if _, err := NewLFU[string, int](-1); err == nil {
+		t.Errorf("NewLFU() expected error for negative capacity")
+	}
+
+	if _, err := NewARC[string, int](0); err == nil {
+		t.Errorf("NewARC() expected error for zero capacity")
+	}
+}
+
+func TestCacheInterface(t *testing.T) {
+	for name, c := range newCaches(t, 3) {
+		t.Run(name, func(t *testing.T) {
+			c.Set("a", 1)
+			c.Set("b", 2)
+			c.Set("a", 10)
+
+			if got, ok := c.Get("a"); !ok || got != 10 {
+				t.Errorf("Get(a) = %v, %v, want 10, true", got, ok)
+			}
+
+			if _, ok := c.Get("missing"); ok {
+				t.Errorf("Get(missing) = true")
+			}
+
+			c.Delete("a")
+			if _, ok := c.Get("a"); ok {
+				t.Errorf("Get(a) found a deleted entry")
+			}
+
+			for i := 0; i < 10; i++ {
+				c.Set(fmt.Sprint(i), i)
+			}
+
+			if got := c.Len(); got != 3 {
+				t.Errorf("Len() = %v, want the capacity %v", got, 3)
+			}
+		})
+	}
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	for name, c := range newCaches(t, 50) {
+		t.Run(name, func(t *testing.T) {
+			var wg sync.WaitGroup
+			for w := 0; w < 8; w++ {
+				wg.Add(1)
+				go func(w int) {
+					defer wg.Done()
+					for i := 0; i < 500; i++ {
+						key := fmt.Sprint((i * (w + 1)) % 120)
+						c.Set(key, i)
+						c.Get(key)
+						if i%7 == 0 {
+							c.Delete(key)
+						}
+					}
+				}(w)
+			}
+			wg.Wait()
+
+			if got := c.Len(); got > 50 {
+				t.Errorf("Len() = %v over the capacity", got)
+			}
+		})
+	}
+}
+
+func TestLRUEviction(t *testing.T) {
+	c, _ := NewLRU[string, int](2)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")
+	c.Set("c", 3)
+
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("Get(b) = true, the least recently used entry must be evicted")
+	}
+
+	if _, ok := c.Get("a"); !ok {
+		t.Errorf("Get(a) = false, a recently used entry was evicted")
+	}
+}
+
+func TestLFUEviction(t *testing.T) {
+	c, _ := NewLFU[string, int](2)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Set("c", 3)
+
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("Get(b) = true, the least frequently used entry must be evicted")
+	}
+
+	// c has the lowest count, ties are broken by recency
+	c.Set("d", 4)
+	if _, ok := c.Get("c"); ok {
+		t.Errorf("Get(c) = true, the least frequently used entry must be evicted")
+	}
+
+	if _, ok := c.Get("a"); !ok {
+		t.Errorf("Get(a) = false, the most frequently used entry was evicted")
+	}
+
+	// deleting the entries of the lowest count keeps eviction working
+	c.Delete("d")
+	c.Set("e", 5)
+	c.Set("f", 6)
+	if got := c.Len(); got != 2 {
+		t.Errorf("Len() = %v, want %v", got, 2)
+	}
+}
+
+func TestLFUDeleteLowestCount(t *testing.T) {
+	c, _ := NewLFU[string, int](3)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Set("c", 3)
+	c.Get("a")
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Get("b")
+
+	// c was alone with the lowest count, b now has it
+	c.Delete("c")
+	c.Set("d", 4)
+	c.Get("d")
+	c.Get("d")
+	c.Get("d")
+	c.Get("d")
+	c.Set("e", 5)
+
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("Get(b) = true, the least frequently used entry must be evicted")
+	}
+
+	for _, key := range []string{"a", "d", "e"} {
+		if _, ok := c.Get(key); !ok {
+			t.Errorf("Get(%v) = false, want true", key)
+		}
+	}
+}
+
+func TestARCScanResistance(t *testing.T) {
+	c, _ := NewARC[string, int](4)
+
+	// a hot working set accessed repeatedly
+	hot := []string{"h1", "h2"}
+	for _, key := range hot {
+		c.Set(key, 1)
+		c.Get(key)
+	}
+
+	// a scan of keys used once
+	for i := 0; i < 100; i++ {
+		c.Set(fmt.Sprintf("scan%d", i), i)
+	}
+
+	for _, key := range hot {
+		if _, ok := c.Get(key); !ok {
+			t.Errorf("Get(%s) = false, the scan flushed the frequently used entries", key)
+		}
+	}
+
+	// ghost hits adapt the policy and keep the cache within capacity
+	for i := 0; i < 100; i++ {
+		c.Set(fmt.Sprintf("scan%d", i%10), i)
+		c.Get(fmt.Sprintf("scan%d", (i+3)%10))
+	}
+
+	if got := c.Len(); got > 4 {
+		t.Errorf("Len() = %v over the capacity", got)
+	}
+}
@@ -0,0 +1,156 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// LFU is a cache evicting the least frequently used entry once full, the least recently used among ties.
+// Every operation runs in constant time. It is safe for concurrent use.
+type LFU[K comparable, V any] struct {
+	stats    recorder[K] // must stay first, see recorder
+	capacity int
+	mu       sync.Mutex
+	entries  map[K]*list.Element // elements of the entries lists of the buckets
+	buckets  *list.List          // non-empty lfuBuckets by increasing access count
+}
+
+type lfuEntry[K comparable, V any] struct {
+	key    K
+	value  V
+	bucket *list.Element // element of the bucket holding the entry in buckets
+}
+
+// lfuBucket holds the entries accessed freq times, front is the most recently used.
+type lfuBucket struct {
+	freq    int
+	entries *list.List
+}
+
+// NewLFU returns an LFU cache holding up to capacity entries.
+func NewLFU[K comparable, V any](capacity int) (*LFU[K, V], error) {
+	if err := checkCapacity(capacity); err != nil {
+		return nil, err
+	}
+
+	return &LFU[K, V]{capacity: capacity, entries: make(map[K]*list.Element), buckets: list.New()}, nil
+}
+
+// Get returns the value of key, counting the access.
+func (c *LFU[K, V]) Get(key K) (V, bool) {
+	value, ok := c.get(key)
+	c.stats.lookup(key, ok)
+
+	return value, ok
+}
+
+func (c *LFU[K, V]) get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	c.touch(elem)
+
+	return elem.Value.(*lfuEntry[K, V]).value, true
+}
+
+// Set stores value under key, evicting the least frequently used entry if the cache is full.
+func (c *LFU[K, V]) Set(key K, value V) {
+	if evicted, ok := c.set(key, value); ok {
+		c.stats.record(EventEviction, evicted)
+	}
+}
+
+// set stores value under key and returns the evicted key, if any.
+func (c *LFU[K, V]) set(key K, value V) (evicted K, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*lfuEntry[K, V]).value = value
+		c.touch(elem)
+
+		return evicted, false
+	}
+
+	if len(c.entries) >= c.capacity {
+		victim := c.buckets.Front().Value.(*lfuBucket).entries.Back()
+		evicted, ok = victim.Value.(*lfuEntry[K, V]).key, true
+		c.remove(victim)
+	}
+
+	first := c.buckets.Front()
+	if first == nil || first.Value.(*lfuBucket).freq != 1 {
+		first = c.buckets.PushFront(&lfuBucket{freq: 1, entries: list.New()})
+	}
+
+	entry := &lfuEntry[K, V]{key: key, value: value, bucket: first}
+	c.entries[key] = first.Value.(*lfuBucket).entries.PushFront(entry)
+
+	return evicted, ok
+}
+
+// Delete removes key.
+func (c *LFU[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+}
+
+// Len returns the number of entries.
+func (c *LFU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+// Stats returns a snapshot of the counters of the cache.
+func (c *LFU[K, V]) Stats() Stats {
+	return c.stats.snapshot(c.Len())
+}
+
+// SetObserver sets the observer notified of the events of the cache, or removes it if o is nil.
+func (c *LFU[K, V]) SetObserver(o Observer[K]) {
+	c.stats.setObserver(o)
+}
+
+// touch moves the entry to the bucket of the next frequency, the caller must hold c.mu.
+func (c *LFU[K, V]) touch(elem *list.Element) {
+	entry := elem.Value.(*lfuEntry[K, V])
+	current := entry.bucket.Value.(*lfuBucket)
+
+	next := entry.bucket.Next()
+	if next == nil || next.Value.(*lfuBucket).freq != current.freq+1 {
+		next = c.buckets.InsertAfter(&lfuBucket{freq: current.freq + 1, entries: list.New()}, entry.bucket)
+	}
+
+	c.unlink(elem)
+	entry.bucket = next
+	c.entries[entry.key] = next.Value.(*lfuBucket).entries.PushFront(entry)
+}
+
+// remove removes the entry, the caller must hold c.mu.
+func (c *LFU[K, V]) remove(elem *list.Element) {
+	c.unlink(elem)
+	delete(c.entries, elem.Value.(*lfuEntry[K, V]).key)
+}
+
+// unlink removes the entry from its bucket, dropping the bucket once empty.
+func (c *LFU[K, V]) unlink(elem *list.Element) {
+	entry := elem.Value.(*lfuEntry[K, V])
+
+	bucket := entry.bucket.Value.(*lfuBucket)
+	bucket.entries.Remove(elem)
+	if bucket.entries.Len() == 0 {
+		c.buckets.Remove(entry.bucket)
+	}
+}
@@ -0,0 +1,121 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kashifkhan0771/utils/single"
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+// LoadingOptions contains options to configure a Loading cache.
+type LoadingOptions[K comparable] struct {
+	RefreshAfter   time.Duration          // Age after which a value is reloaded in the background while still served, never if zero
+	OnRefreshError func(key K, err error) // Called when a background reload fails, the stale value is kept
+	Clock          timex.Clock            // Clock measuring the age of the values, the real clock by default
+}
+
+// Loading wraps a Cache with a loader called on misses. Concurrent loads of the same key are coalesced
+// into a single call. It is safe for concurrent use if the wrapped cache is.
+type Loading[K comparable, V any] struct {
+	cache  Cache[K, V]
+	loader func(ctx context.Context, key K) (V, error)
+	opts   LoadingOptions[K]
+	group  single.Group[K, V]
+
+	mu       sync.Mutex
+	loadedAt map[K]time.Time // load time of the cached keys, only tracked with RefreshAfter
+}
+
+// NewLoading returns a Loading cache storing in c the values returned by loader.
+func NewLoading[K comparable, V any](c Cache[K, V], loader func(ctx context.Context, key K) (V, error)) *Loading[K, V] {
+	return NewLoadingWithOptions(c, loader, LoadingOptions[K]{})
+}
+
+// NewLoadingWithOptions returns a Loading cache configured with opts.
+func NewLoadingWithOptions[K comparable, V any](c Cache[K, V], loader func(ctx context.Context, key K) (V, error),
+	opts LoadingOptions[K]) *Loading[K, V] {
+	if opts.Clock == nil {
+		opts.Clock = timex.RealClock{}
+	}
+
+	return &Loading[K, V]{cache: c, loader: loader, opts: opts, loadedAt: make(map[K]time.Time)}
+}
+
+// Get returns the cached value of key, calling the loader on a miss. Load errors are returned and not cached.
+// A value older than RefreshAfter is returned as is while it is reloaded in the background.
+func (l *Loading[K, V]) Get(ctx context.Context, key K) (V, error) {
+	if value, ok := l.cache.Get(key); ok {
+		if l.stale(key) {
+			go l.refresh(key)
+		}
+
+		return value, nil
+	}
+
+	return l.group.Do(ctx, key, func(ctx context.Context) (V, error) {
+		return l.load(ctx, key)
+	})
+}
+
+// Delete removes key from the cache. A load in flight for key is not cancelled.
+func (l *Loading[K, V]) Delete(key K) {
+	l.cache.Delete(key)
+
+	l.mu.Lock()
+	delete(l.loadedAt, key)
+	l.mu.Unlock()
+}
+
+func (l *Loading[K, V]) load(ctx context.Context, key K) (V, error) {
+	value, err := l.loader(ctx, key)
+	if err != nil {
+		return value, err
+	}
+
+	l.cache.Set(key, value)
+
+	if l.opts.RefreshAfter > 0 {
+		l.mu.Lock()
+		l.loadedAt[key] = l.opts.Clock.Now()
+		l.mu.Unlock()
+	}
+
+	return value, nil
+}
+
+func (l *Loading[K, V]) refresh(key K) {
+	_, err := l.group.Do(context.Background(), key, func(ctx context.Context) (V, error) {
+		return l.load(ctx, key)
+	})
+	if err != nil && l.opts.OnRefreshError != nil {
+		l.opts.OnRefreshError(key, err)
+	}
+}
+
+// stale reports whether key must be refreshed, marking it as loaded now so a single refresh is started.
+// Keys evicted from the wrapped cache leave their load time behind, so the load times are dropped when they
+// outnumber the cached keys, and a cached key without a load time is treated as just loaded.
+func (l *Loading[K, V]) stale(key K) bool {
+	if l.opts.RefreshAfter <= 0 {
+		return false
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.loadedAt) > 2*l.cache.Len()+64 {
+		l.loadedAt = make(map[K]time.Time)
+	}
+
+	now := l.opts.Clock.Now()
+	loadedAt, ok := l.loadedAt[key]
+	if ok && now.Sub(loadedAt) < l.opts.RefreshAfter {
+		return false
+	}
+
+	l.loadedAt[key] = now
+
+	return ok
+}
@@ -0,0 +1,157 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+func TestLoadingGet(t *testing.T) {
+	lru, _ := NewLRU[string, int](10)
+	var calls int64
+	l := NewLoading[string, int](lru, func(ctx context.Context, key string) (int, error) {
+		atomic.AddInt64(&calls, 1)
+		if key == "bad" {
+			return 0, errors.New("not found")
+		}
+
+		return len(key), nil
+	})
+
+	tests := []struct {
+		name    string
+		key     string
+		want    int
+		wantErr bool
+	}{
+		{name: "success - loaded on miss", key: "abc", want: 3},
+		{name: "success - cached", key: "abc", want: 3},
+		{name: "fail - loader error", key: "bad", wantErr: true},
+		{name: "fail - errors are not cached", key: "bad", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := l.Get(context.Background(), tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("Get() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := atomic.LoadInt64(&calls); got != 3 {
+		t.Errorf("loader called %v times, want %v", got, 3)
+	}
+
+	l.Delete("abc")
+	if _, ok := lru.Get("abc"); ok {
+		t.Errorf("Delete() kept the key in the wrapped cache")
+	}
+}
+
+func TestLoadingCoalescing(t *testing.T) {
+	lru, _ := NewLRU[string, int](10)
+	var calls int64
+	release := make(chan struct{})
+	l := NewLoading[string, int](lru, func(ctx context.Context, key string) (int, error) {
+		atomic.AddInt64(&calls, 1)
+		<-release
+
+		return 42, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got, err := l.Get(context.Background(), "key"); err != nil || got != 42 {
+				t.Errorf("Get() = %v, %v, want 42, nil", got, err)
+			}
+		}()
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt64(&calls); got != 1 {
+		t.Errorf("loader called %v times, want %v", got, 1)
+	}
+}
+
+func TestLoadingRefresh(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+	lru, _ := NewLRU[string, int](10)
+	var version int64
+	fail := make(chan struct{}, 1)
+	refreshErrs := make(chan error, 1)
+	l := NewLoadingWithOptions[string, int](lru, func(ctx context.Context, key string) (int, error) {
+		select {
+		case <-fail:
+			return 0, errors.New("unavailable")
+		default:
+		}
+
+		return int(atomic.AddInt64(&version, 1)), nil
+	}, LoadingOptions[string]{
+		RefreshAfter:   time.Minute,
+		OnRefreshError: func(key string, err error) { refreshErrs <- err },
+		Clock:          clock,
+	})
+
+	ctx := context.Background()
+	if got, _ := l.Get(ctx, "key"); got != 1 {
+		t.Fatalf("Get() = %v, want %v", got, 1)
+	}
+
+	clock.Advance(2 * time.Minute)
+
+	// the stale value is served while it is reloaded
+	if got, _ := l.Get(ctx, "key"); got != 1 {
+		t.Errorf("Get() = %v, want the stale value %v", got, 1)
+	}
+
+	waitFor(t, func() bool {
+		got, _ := lru.Get("key")
+		return got == 2
+	})
+
+	// a failed refresh keeps the stale value
+	fail <- struct{}{}
+	clock.Advance(2 * time.Minute)
+	l.Get(ctx, "key")
+
+	select {
+	case err := <-refreshErrs:
+		if err == nil {
+			t.Errorf("OnRefreshError() got a nil error")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("OnRefreshError() not called")
+	}
+
+	if got, _ := l.Get(ctx, "key"); got != 2 {
+		t.Errorf("Get() = %v, want %v", got, 2)
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("condition not met in time")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
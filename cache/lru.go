@@ -0,0 +1,112 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// LRU is a cache evicting the least recently used entry once full. It is safe for concurrent use.
+type LRU[K comparable, V any] struct {
+	stats    recorder[K] // must stay first, see recorder
+	capacity int
+	mu       sync.Mutex
+	order    *list.List // front is the most recently used
+	entries  map[K]*list.Element
+}
+
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// NewLRU returns an LRU cache holding up to capacity entries.
+func NewLRU[K comparable, V any](capacity int) (*LRU[K, V], error) {
+	if err := checkCapacity(capacity); err != nil {
+		return nil, err
+	}
+
+	return &LRU[K, V]{capacity: capacity, order: list.New(), entries: make(map[K]*list.Element)}, nil
+}
+
+// Get returns the value of key, marking it as recently used.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	value, ok := c.get(key)
+	c.stats.lookup(key, ok)
+
+	return value, ok
+}
+
+func (c *LRU[K, V]) get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	c.order.MoveToFront(elem)
+
+	return elem.Value.(*lruEntry[K, V]).value, true
+}
+
+// Set stores value under key, evicting the least recently used entry if the cache is full.
+func (c *LRU[K, V]) Set(key K, value V) {
+	if evicted, ok := c.set(key, value); ok {
+		c.stats.record(EventEviction, evicted)
+	}
+}
+
+// set stores value under key and returns the evicted key, if any.
+func (c *LRU[K, V]) set(key K, value V) (evicted K, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*lruEntry[K, V]).value = value
+		c.order.MoveToFront(elem)
+
+		return evicted, false
+	}
+
+	if c.order.Len() >= c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		evicted, ok = oldest.Value.(*lruEntry[K, V]).key, true
+		delete(c.entries, evicted)
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value})
+
+	return evicted, ok
+}
+
+// Delete removes key.
+func (c *LRU[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+	}
+}
+
+// Len returns the number of entries.
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+// Stats returns a snapshot of the counters of the cache.
+func (c *LRU[K, V]) Stats() Stats {
+	return c.stats.snapshot(c.Len())
+}
+
+// SetObserver sets the observer notified of the events of the cache, or removes it if o is nil.
+func (c *LRU[K, V]) SetObserver(o Observer[K]) {
+	c.stats.setObserver(o)
+}
@@ -0,0 +1,137 @@
+package cache
+
+import (
+	"sync/atomic"
+)
+
+// EventKind is the kind of a cache event.
+type EventKind int
+
+const (
+	EventHit        EventKind = iota // A key was found
+	EventMiss                        // A key was not found, or expired
+	EventEviction                    // An entry was removed by the eviction policy to make room
+	EventExpiration                  // An entry was removed because its lifetime ended
+)
+
+// String returns the name of the kind.
+func (k EventKind) String() string {
+	switch k {
+	case EventHit:
+		return "hit"
+	case EventMiss:
+		return "miss"
+	case EventEviction:
+		return "eviction"
+	case EventExpiration:
+		return "expiration"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is an operation observed on a cache.
+type Event[K comparable] struct {
+	Kind EventKind
+	Key  K
+}
+
+// Observer is notified of the events of a cache, for instance to update metrics. OnEvent is called
+// synchronously outside the cache locks, so it must be fast and may use the cache.
+type Observer[K comparable] interface {
+	OnEvent(event Event[K])
+}
+
+// ObserverFunc adapts a function to an Observer.
+type ObserverFunc[K comparable] func(event Event[K])
+
+// OnEvent calls f(event).
+func (f ObserverFunc[K]) OnEvent(event Event[K]) {
+	f(event)
+}
+
+// Instrumented is implemented by the caches reporting their statistics.
+type Instrumented[K comparable] interface {
+	// Stats returns a snapshot of the counters of the cache.
+	Stats() Stats
+	// SetObserver sets the observer notified of the events of the cache, or removes it if o is nil.
+	SetObserver(o Observer[K])
+}
+
+var (
+	_ Instrumented[string] = (*TTL[string, int])(nil)
+	_ Instrumented[string] = (*LRU[string, int])(nil)
+	_ Instrumented[string] = (*LFU[string, int])(nil)
+	_ Instrumented[string] = (*ARC[string, int])(nil)
+)
+
+// Stats is a snapshot of the counters of a cache. It encodes to JSON, so it can be published with expvar.
+type Stats struct {
+	Hits        uint64 `json:"hits"`
+	Misses      uint64 `json:"misses"`
+	Evictions   uint64 `json:"evictions"`
+	Expirations uint64 `json:"expirations"`
+	Size        int    `json:"size"`
+}
+
+// HitRatio returns the share of lookups that were hits, or 0 without lookups.
+func (s Stats) HitRatio() float64 {
+	lookups := s.Hits + s.Misses
+	if lookups == 0 {
+		return 0
+	}
+
+	return float64(s.Hits) / float64(lookups)
+}
+
+// recorder counts the events of a cache and forwards them to its observer. Its counters are updated
+// atomically, so it must be the first field of the caches to be 64-bit aligned on 32-bit platforms.
+type recorder[K comparable] struct {
+	hits, misses, evictions, expirations uint64
+	observer                             atomic.Value // observerHolder[K]
+}
+
+type observerHolder[K comparable] struct {
+	observer Observer[K]
+}
+
+func (r *recorder[K]) setObserver(o Observer[K]) {
+	r.observer.Store(observerHolder[K]{o})
+}
+
+// record counts an event and notifies the observer, it must not be called with a cache lock held.
+func (r *recorder[K]) record(kind EventKind, key K) {
+	switch kind {
+	case EventHit:
+		atomic.AddUint64(&r.hits, 1)
+	case EventMiss:
+		atomic.AddUint64(&r.misses, 1)
+	case EventEviction:
+		atomic.AddUint64(&r.evictions, 1)
+	case EventExpiration:
+		atomic.AddUint64(&r.expirations, 1)
+	}
+
+	if holder, ok := r.observer.Load().(observerHolder[K]); ok && holder.observer != nil {
+		holder.observer.OnEvent(Event[K]{Kind: kind, Key: key})
+	}
+}
+
+// lookup records a hit or a miss.
+func (r *recorder[K]) lookup(key K, found bool) {
+	if found {
+		r.record(EventHit, key)
+	} else {
+		r.record(EventMiss, key)
+	}
+}
+
+func (r *recorder[K]) snapshot(size int) Stats {
+	return Stats{
+		Hits:        atomic.LoadUint64(&r.hits),
+		Misses:      atomic.LoadUint64(&r.misses),
+		Evictions:   atomic.LoadUint64(&r.evictions),
+		Expirations: atomic.LoadUint64(&r.expirations),
+		Size:        size,
+	}
+}
@@ -0,0 +1,142 @@
+package cache
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+// eventLog is an Observer recording the events it receives.
+type eventLog struct {
+	mu     sync.Mutex
+	events []Event[string]
+}
+
+func (l *eventLog) OnEvent(event Event[string]) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.events = append(l.events, event)
+}
+
+func TestStats(t *testing.T) {
+	for name, c := range newCaches(t, 2) {
+		t.Run(name, func(t *testing.T) {
+			instrumented := c.(Instrumented[string])
+			log := &eventLog{}
+			instrumented.SetObserver(log)
+
+			c.Set("a", 1)
+			c.Get("a")
+			c.Get("missing")
+			c.Set("b", 2)
+			c.Set("c", 3)
+
+			want := Stats{Hits: 1, Misses: 1, Evictions: 1, Size: 2}
+			if got := instrumented.Stats(); got != want {
+				t.Errorf("Stats() = %+v, want %+v", got, want)
+			}
+
+			wantEvents := []EventKind{EventHit, EventMiss, EventEviction}
+			if len(log.events) != len(wantEvents) {
+				t.Fatalf("events = %v, want kinds %v", log.events, wantEvents)
+			}
+
+			for i, kind := range wantEvents {
+				if log.events[i].Kind != kind {
+					t.Errorf("event %d = %v, want %v", i, log.events[i].Kind, kind)
+				}
+			}
+
+			if log.events[0].Key != "a" || log.events[1].Key != "missing" {
+				t.Errorf("events = %v, want the keys a and missing", log.events)
+			}
+
+			instrumented.SetObserver(nil)
+			c.Get("a")
+			if len(log.events) != 3 {
+				t.Errorf("events recorded after the observer was removed")
+			}
+		})
+	}
+}
+
+func TestTTLStats(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+	c := NewTTLWithOptions(TTLOptions[string, int]{DefaultTTL: time.Minute, Clock: clock})
+
+	var kinds []EventKind
+	c.SetObserver(ObserverFunc[string](func(event Event[string]) { kinds = append(kinds, event.Kind) }))
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")
+	clock.Advance(time.Minute)
+	c.Get("a")
+	c.DeleteExpired()
+
+	want := Stats{Hits: 1, Misses: 1, Expirations: 2}
+	if got := c.Stats(); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+
+	wantKinds := []EventKind{EventHit, EventExpiration, EventMiss, EventExpiration}
+	if len(kinds) != len(wantKinds) {
+		t.Fatalf("events = %v, want %v", kinds, wantKinds)
+	}
+
+	for i := range wantKinds {
+		if kinds[i] != wantKinds[i] {
+			t.Errorf("event %d = %v, want %v", i, kinds[i], wantKinds[i])
+		}
+	}
+}
+
+func TestStatsHitRatio(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats Stats
+		want  float64
+	}{
+		{name: "success - no lookups", stats: Stats{}, want: 0},
+		{name: "success - hits and misses", stats: Stats{Hits: 3, Misses: 1}, want: 0.75},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.stats.HitRatio(); got != tt.want {
+				t.Errorf("HitRatio() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	got, err := json.Marshal(Stats{Hits: 1, Size: 2})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	want := `{"hits":1,"misses":0,"evictions":0,"expirations":0,"size":2}`
+	if string(got) != want {
+		t.Errorf("json.Marshal() = %s, want %s", got, want)
+	}
+}
+
+func TestEventKindString(t *testing.T) {
+	tests := []struct {
+		kind EventKind
+		want string
+	}{
+		{EventHit, "hit"},
+		{EventMiss, "miss"},
+		{EventEviction, "eviction"},
+		{EventExpiration, "expiration"},
+		{EventKind(42), "unknown"},
+	}
+	for _, tt := range tests {
+		if got := tt.kind.String(); got != tt.want {
+			t.Errorf("String() = %v, want %v", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kashifkhan0771/utils/ctxutils"
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+// DefaultNegativeCapacity is the number of missing keys remembered by a Tiered cache with negative caching.
+const DefaultNegativeCapacity = 1024
+
+// RemoteStore is a shared cache backend, such as Redis or memcached, behind a Tiered cache.
+type RemoteStore[K comparable, V any] interface {
+	// Get returns the value of key and whether it was present.
+	Get(ctx context.Context, key K) (V, bool, error)
+	// Set stores value under key expiring after ttl, or never if ttl is zero.
+	Set(ctx context.Context, key K, value V, ttl time.Duration) error
+	// Delete removes key.
+	Delete(ctx context.Context, key K) error
+}
+
+// TieredOptions contains options to configure a Tiered cache.
+type TieredOptions[K comparable] struct {
+	TTL              time.Duration          // Lifetime of the values set in the remote store, no expiration if zero
+	WriteThrough     bool                   // Set waits for the remote store and returns its error, otherwise the remote write is done in the background
+	OnError          func(key K, err error) // Called when a background remote write fails
+	NegativeTTL      time.Duration          // How long a key missing from the remote store is remembered as missing, not at all if zero
+	NegativeCapacity int                    // Number of missing keys remembered, DefaultNegativeCapacity if zero
+	Clock            timex.Clock            // Clock measuring the negative TTL, the real clock by default
+}
+
+// Tiered is a two-level cache reading from a local cache first and from a remote store on a miss,
+// keeping the values found remotely in the local cache. The local cache decides how long they are kept locally.
+type Tiered[K comparable, V any] struct {
+	local    Cache[K, V]
+	remote   RemoteStore[K, V]
+	opts     TieredOptions[K]
+	negative *LRU[K, time.Time] // expiration of the keys known to be missing
+}
+
+// NewTiered returns a Tiered cache in front of remote, using local as the first level.
+func NewTiered[K comparable, V any](local Cache[K, V], remote RemoteStore[K, V], opts TieredOptions[K]) *Tiered[K, V] {
+	if opts.Clock == nil {
+		opts.Clock = timex.RealClock{}
+	}
+
+	if opts.NegativeCapacity <= 0 {
+		opts.NegativeCapacity = DefaultNegativeCapacity
+	}
+
+	t := &Tiered[K, V]{local: local, remote: remote, opts: opts}
+	if opts.NegativeTTL > 0 {
+		t.negative, _ = NewLRU[K, time.Time](opts.NegativeCapacity)
+	}
+
+	return t
+}
+
+// Get returns the value of key from the local cache, or from the remote store on a local miss.
+func (t *Tiered[K, V]) Get(ctx context.Context, key K) (V, bool, error) {
+	if value, ok := t.local.Get(key); ok {
+		return value, true, nil
+	}
+
+	var zero V
+	if t.knownMissing(key) {
+		return zero, false, nil
+	}
+
+	value, ok, err := t.remote.Get(ctx, key)
+	if err != nil {
+		return zero, false, fmt.Errorf("failed to get from remote store: %w", err)
+	}
+
+	if !ok {
+		if t.negative != nil {
+			t.negative.Set(key, t.opts.Clock.Now().Add(t.opts.NegativeTTL))
+		}
+
+		return zero, false, nil
+	}
+
+	t.local.Set(key, value)
+
+	return value, true, nil
+}
+
+// Set stores value under key in both levels. Without WriteThrough the remote write is done
+// in the background and its errors are reported to OnError.
+func (t *Tiered[K, V]) Set(ctx context.Context, key K, value V) error {
+	t.local.Set(key, value)
+	t.forgetMissing(key)
+
+	if !t.opts.WriteThrough {
+		go func() {
+			if err := t.remote.Set(ctxutils.Detach(ctx), key, value, t.opts.TTL); err != nil && t.opts.OnError != nil {
+				t.opts.OnError(key, fmt.Errorf("failed to set in remote store: %w", err))
+			}
+		}()
+
+		return nil
+	}
+
+	if err := t.remote.Set(ctx, key, value, t.opts.TTL); err != nil {
+		return fmt.Errorf("failed to set in remote store: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes key from both levels.
+func (t *Tiered[K, V]) Delete(ctx context.Context, key K) error {
+	t.local.Delete(key)
+	t.forgetMissing(key)
+
+	if err := t.remote.Delete(ctx, key); err != nil {
+		return fmt.Errorf("failed to delete from remote store: %w", err)
+	}
+
+	return nil
+}
+
+func (t *Tiered[K, V]) knownMissing(key K) bool {
+	if t.negative == nil {
+		return false
+	}
+
+	expires, ok := t.negative.Get(key)
+	if !ok {
+		return false
+	}
+
+	if !t.opts.Clock.Now().Before(expires) {
+		t.negative.Delete(key)
+		return false
+	}
+
+	return true
+}
+
+func (t *Tiered[K, V]) forgetMissing(key K) {
+	if t.negative != nil {
+		t.negative.Delete(key)
+	}
+}
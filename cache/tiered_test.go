@@ -0,0 +1,199 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+// mapStore is an in-memory RemoteStore counting its reads.
+type mapStore struct {
+	mu     sync.Mutex
+	values map[string]int
+	ttls   map[string]time.Duration
+	gets   int
+	err    error
+}
+
+func newMapStore() *mapStore {
+	return &mapStore{values: make(map[string]int), ttls: make(map[string]time.Duration)}
+}
+
+func (s *mapStore) Get(ctx context.Context, key string) (int, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.gets++
+	if s.err != nil {
+		return 0, false, s.err
+	}
+
+	value, ok := s.values[key]
+
+	return value, ok, nil
+}
+
+func (s *mapStore) Set(ctx context.Context, key string, value int, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return s.err
+	}
+
+	s.values[key] = value
+	s.ttls[key] = ttl
+
+	return nil
+}
+
+func (s *mapStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return s.err
+	}
+
+	delete(s.values, key)
+
+	return nil
+}
+
+func (s *mapStore) lookup(key string) (int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.values[key]
+
+	return value, ok
+}
+
+func TestTieredGet(t *testing.T) {
+	ctx := context.Background()
+	store := newMapStore()
+	store.values["remote"] = 7
+	local, _ := NewLRU[string, int](10)
+	c := NewTiered[string, int](local, store, TieredOptions[string]{})
+
+	tests := []struct {
+		name     string
+		key      string
+		want     int
+		wantOk   bool
+		wantGets int
+	}{
+		{name: "success - read from the remote store", key: "remote", want: 7, wantOk: true, wantGets: 1},
+		{name: "success - then read from the local cache", key: "remote", want: 7, wantOk: true, wantGets: 1},
+		{name: "fail - missing", key: "missing", wantGets: 2},
+		{name: "fail - missing without negative caching", key: "missing", wantGets: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok, err := c.Get(ctx, tt.key)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Get() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+
+			if store.gets != tt.wantGets {
+				t.Errorf("remote reads = %v, want %v", store.gets, tt.wantGets)
+			}
+		})
+	}
+
+	store.err = errors.New("connection refused")
+	if _, _, err := c.Get(ctx, "other"); !errors.Is(err, store.err) {
+		t.Errorf("Get() error = %v, want %v", err, store.err)
+	}
+}
+
+func TestTieredNegativeCaching(t *testing.T) {
+	ctx := context.Background()
+	clock := timex.NewFakeClock(epoch)
+	store := newMapStore()
+	local, _ := NewLRU[string, int](10)
+	c := NewTiered[string, int](local, store, TieredOptions[string]{NegativeTTL: time.Minute, Clock: clock})
+
+	c.Get(ctx, "key")
+	c.Get(ctx, "key")
+	if store.gets != 1 {
+		t.Errorf("remote reads = %v, want %v", store.gets, 1)
+	}
+
+	clock.Advance(time.Minute)
+	c.Get(ctx, "key")
+	if store.gets != 2 {
+		t.Errorf("remote reads = %v after the negative TTL, want %v", store.gets, 2)
+	}
+
+	// setting the key forgets that it was missing
+	if err := c.Set(ctx, "key", 1); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	local.Delete("key")
+
+	waitFor(t, func() bool {
+		_, ok := store.lookup("key")
+		return ok
+	})
+
+	if got, ok, _ := c.Get(ctx, "key"); !ok || got != 1 {
+		t.Errorf("Get() = %v, %v, want 1, true", got, ok)
+	}
+}
+
+func TestTieredWrites(t *testing.T) {
+	ctx := context.Background()
+	store := newMapStore()
+	local, _ := NewLRU[string, int](10)
+	c := NewTiered[string, int](local, store, TieredOptions[string]{TTL: time.Hour, WriteThrough: true})
+
+	if err := c.Set(ctx, "key", 1); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	if got, ok := store.lookup("key"); !ok || got != 1 || store.ttls["key"] != time.Hour {
+		t.Errorf("remote value = %v, %v with ttl %v, want 1, true with ttl %v", got, ok, store.ttls["key"], time.Hour)
+	}
+
+	if err := c.Delete(ctx, "key"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	if _, ok := local.Get("key"); ok {
+		t.Errorf("Delete() kept the key in the local cache")
+	}
+
+	if _, ok := store.lookup("key"); ok {
+		t.Errorf("Delete() kept the key in the remote store")
+	}
+
+	store.err = errors.New("connection refused")
+	if err := c.Set(ctx, "key", 2); !errors.Is(err, store.err) {
+		t.Errorf("Set() error = %v, want %v", err, store.err)
+	}
+
+	// background writes report their errors
+	errs := make(chan error, 1)
+	c = NewTiered[string, int](local, store, TieredOptions[string]{OnError: func(key string, err error) { errs <- err }})
+	if err := c.Set(ctx, "key", 3); err != nil {
+		t.Errorf("Set() error = %v, want nil", err)
+	}
+
+	select {
+	case err := <-errs:
+		if !errors.Is(err, store.err) {
+			t.Errorf("OnError() error = %v, want %v", err, store.err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("OnError() not called")
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Package cache defines generic in-memory caches.
+*/
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+// TTLOptions contains options to configure a TTL cache.
+type TTLOptions[K comparable, V any] struct {
+	DefaultTTL      time.Duration        // Lifetime of the entries set without a TTL, no expiration if zero
+	CleanupInterval time.Duration        // Interval of the background removal of expired entries, only on access if zero
+	OnExpire        func(key K, value V) // Called when an expired entry is removed
+	Clock           timex.Clock          // Clock measuring the lifetimes, the real clock by default
+}
+
+// TTL is a cache whose entries expire after a lifetime. Expired entries are removed when accessed,
+// and periodically if a cleanup interval is set. It is safe for concurrent use.
+type TTL[K comparable, V any] struct {
+	stats   recorder[K] // must stay first, see recorder
+	opts    TTLOptions[K, V]
+	mu      sync.Mutex
+	entries map[K]*ttlEntry[V]
+	stop    chan struct{}
+	once    sync.Once
+}
+
+type ttlEntry[V any] struct {
+	value   V
+	ttl     time.Duration
+	expires time.Time // zero if the entry never expires
+}
+
+// NewTTL returns a TTL cache whose entries expire after defaultTTL.
+func NewTTL[K comparable, V any](defaultTTL time.Duration) *TTL[K, V] {
+	return NewTTLWithOptions(TTLOptions[K, V]{DefaultTTL: defaultTTL})
+}
+
+// NewTTLWithOptions returns a TTL cache configured with opts. If a cleanup interval is set,
+// Close must be called to stop the background cleanup.
+func NewTTLWithOptions[K comparable, V any](opts TTLOptions[K, V]) *TTL[K, V] {
+	if opts.Clock == nil {
+		opts.Clock = timex.RealClock{}
+	}
+
+	c := &TTL[K, V]{opts: opts, entries: make(map[K]*ttlEntry[V]), stop: make(chan struct{})}
+	if opts.CleanupInterval > 0 {
+		go c.janitor()
+	}
+
+	return c
+}
+
+// Get returns the value of key if it is present and not expired.
+func (c *TTL[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if ok && c.expired(entry, c.opts.Clock.Now()) {
+		delete(c.entries, key)
+		c.mu.Unlock()
+
+		c.expire(key, entry.value)
+		c.stats.lookup(key, false)
+
+		var zero V
+		return zero, false
+	}
+	c.mu.Unlock()
+
+	c.stats.lookup(key, ok)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	return entry.value, true
+}
+
+// Set stores value under key with the default TTL.
+func (c *TTL[K, V]) Set(key K, value V) {
+	c.SetWithTTL(key, value, c.opts.DefaultTTL)
+}
+
+// SetWithTTL stores value under key expiring after ttl, or never if ttl is zero.
+func (c *TTL[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &ttlEntry[V]{value: value, ttl: ttl}
+	if ttl > 0 {
+		entry.expires = c.opts.Clock.Now().Add(ttl)
+	}
+
+	c.entries[key] = entry
+}
+
+// Touch renews the lifetime of key with its TTL and reports whether it was present and not expired.
+func (c *TTL[K, V]) Touch(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	now := c.opts.Clock.Now()
+	if !ok || c.expired(entry, now) {
+		return false
+	}
+
+	if entry.ttl > 0 {
+		entry.expires = now.Add(entry.ttl)
+	}
+
+	return true
+}
+
+// Delete removes key.
+func (c *TTL[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+// Len returns the number of entries, including the expired ones not removed yet.
+func (c *TTL[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+// Stats returns a snapshot of the counters of the cache. Expired entries are counted when removed.
+func (c *TTL[K, V]) Stats() Stats {
+	return c.stats.snapshot(c.Len())
+}
+
+// SetObserver sets the observer notified of the events of the cache, or removes it if o is nil.
+func (c *TTL[K, V]) SetObserver(o Observer[K]) {
+	c.stats.setObserver(o)
+}
+
+// DeleteExpired removes every expired entry.
+func (c *TTL[K, V]) DeleteExpired() {
+	type expiredEntry struct {
+		key   K
+		value V
+	}
+
+	c.mu.Lock()
+	now := c.opts.Clock.Now()
+	expired := make([]expiredEntry, 0)
+	for key, entry := range c.entries {
+		if c.expired(entry, now) {
+			delete(c.entries, key)
+			expired = append(expired, expiredEntry{key, entry.value})
+		}
+	}
+	c.mu.Unlock()
+
+	for _, e := range expired {
+		c.expire(e.key, e.value)
+	}
+}
+
+// Close stops the background cleanup. It is safe to call Close more than once.
+func (c *TTL[K, V]) Close() {
+	c.once.Do(func() { close(c.stop) })
+}
+
+func (c *TTL[K, V]) janitor() {
+	ticker := c.opts.Clock.NewTicker(c.opts.CleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C():
+			c.DeleteExpired()
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+func (c *TTL[K, V]) expired(entry *ttlEntry[V], now time.Time) bool {
+	return !entry.expires.IsZero() && !now.Before(entry.expires)
+}
+
+func (c *TTL[K, V]) expire(key K, value V) {
+	c.stats.record(EventExpiration, key)
+
+	if c.opts.OnExpire != nil {
+		c.opts.OnExpire(key, value)
+	}
+}
@@ -0,0 +1,137 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+var epoch = time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+func TestTTLGetSet(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+	c := NewTTLWithOptions(TTLOptions[string, int]{DefaultTTL: time.Minute, Clock: clock})
+
+	c.Set("a", 1)
+	c.SetWithTTL("b", 2, time.Hour)
+	c.SetWithTTL("c", 3, 0)
+
+	if got, ok := c.Get("a"); !ok || got != 1 {
+		t.Errorf("Get(a) = %v, %v, want 1, true", got, ok)
+	}
+
+	clock.Advance(time.Minute)
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("Get(a) found an expired entry")
+	}
+
+	if got, ok := c.Get("b"); !ok || got != 2 {
+		t.Errorf("Get(b) = %v, %v, want 2, true", got, ok)
+	}
+
+	clock.Advance(24 * time.Hour)
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("Get(b) found an expired entry")
+	}
+
+	if got, ok := c.Get("c"); !ok || got != 3 {
+		t.Errorf("Get(c) = %v, %v, want an entry without expiration", got, ok)
+	}
+
+	c.Delete("c")
+	if _, ok := c.Get("c"); ok || c.Len() != 0 {
+		t.Errorf("Get(c) found a deleted entry")
+	}
+}
+
+func TestTTLTouch(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+	c := NewTTLWithOptions(TTLOptions[string, int]{DefaultTTL: time.Minute, Clock: clock})
+
+	c.Set("a", 1)
+	clock.Advance(50 * time.Second)
+
+	if !c.Touch("a") {
+		t.Errorf("Touch(a) = false for a live entry")
+	}
+
+	clock.Advance(50 * time.Second)
+	if _, ok := c.Get("a"); !ok {
+		t.Errorf("Get(a) = false, Touch() must renew the lifetime")
+	}
+
+	clock.Advance(time.Minute)
+	if c.Touch("a") {
+		t.Errorf("Touch(a) = true for an expired entry")
+	}
+
+	if c.Touch("missing") {
+		t.Errorf("Touch(missing) = true")
+	}
+}
+
+func TestTTLOnExpire(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+
+	var mu sync.Mutex
+	expired := make(map[string]int)
+	c := NewTTLWithOptions(TTLOptions[string, int]{
+		DefaultTTL: time.Minute,
+		Clock:      clock,
+		OnExpire: func(key string, value int) {
+			mu.Lock()
+			defer mu.Unlock()
+			expired[key] = value
+		},
+	})
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.SetWithTTL("c", 3, time.Hour)
+	clock.Advance(time.Minute)
+
+	_, _ = c.Get("a")
+	c.DeleteExpired()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(expired) != 2 || expired["a"] != 1 || expired["b"] != 2 {
+		t.Errorf("expired = %v, want a and b", expired)
+	}
+
+	if c.Len() != 1 {
+		t.Errorf("Len() = %v, want %v", c.Len(), 1)
+	}
+}
+
+func TestTTLJanitor(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+	c := NewTTLWithOptions(TTLOptions[string, int]{DefaultTTL: time.Minute, CleanupInterval: time.Minute, Clock: clock})
+	defer c.Close()
+
+	c.Set("a", 1)
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+
+	deadline := time.Now().Add(time.Second)
+	for c.Len() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("janitor did not remove the expired entry")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	c.Close()
+}
+
+func TestNewTTL(t *testing.T) {
+	c := NewTTL[int, string](time.Hour)
+	c.Set(1, "one")
+
+	if got, ok := c.Get(1); !ok || got != "one" {
+		t.Errorf("Get() = %v, %v, want one, true", got, ok)
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Package circuit defines a circuit breaker to stop calling failing dependencies.
+*/
+package circuit
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+// State is the state of a Breaker.
+type State int
+
+const (
+	StateClosed   State = iota // calls go through, failures are counted
+	StateOpen                  // calls are rejected until the open timeout elapses
+	StateHalfOpen              // a limited number of probe calls go through
+)
+
+// String returns the name of the state.
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+var (
+	// ErrOpen is returned when calls are rejected by an open breaker.
+	ErrOpen = errors.New("circuit breaker is open")
+	// ErrTooManyProbes is returned when calls are rejected by a half-open breaker already running its probes.
+	ErrTooManyProbes = errors.New("circuit breaker is half-open and running its probes")
+)
+
+// Default values of the options.
+const (
+	DefaultFailureThreshold = 5
+	DefaultOpenTimeout      = time.Minute
+	DefaultHalfOpenProbes   = 1
+)
+
+// Options contains options to configure a Breaker. Zero values use the defaults.
+type Options struct {
+	FailureThreshold int                  // Consecutive failures opening the breaker
+	OpenTimeout      time.Duration        // Time spent open before probing
+	HalfOpenProbes   int                  // Concurrent probes allowed, and successes needed to close again
+	IsFailure        func(err error) bool // Reports whether an error counts as a failure, any non-nil error by default
+	OnStateChange    func(from, to State) // Called after each transition, e.g. to record metrics
+	Clock            timex.Clock          // Clock measuring the open timeout, the real clock by default
+}
+
+// Breaker is a circuit breaker. It is safe for concurrent use.
+type Breaker struct {
+	mu         sync.Mutex
+	opts       Options
+	state      State
+	generation uint64 // incremented on each transition so late results of previous states are ignored
+	failures   int
+	successes  int
+	probes     int
+	openedAt   time.Time
+}
+
+// New returns a closed Breaker configured with opts.
+func New(opts Options) *Breaker {
+	if opts.FailureThreshold <= 0 {
+		opts.FailureThreshold = DefaultFailureThreshold
+	}
+
+	if opts.OpenTimeout <= 0 {
+		opts.OpenTimeout = DefaultOpenTimeout
+	}
+
+	if opts.HalfOpenProbes <= 0 {
+		opts.HalfOpenProbes = DefaultHalfOpenProbes
+	}
+
+	if opts.IsFailure == nil {
+		opts.IsFailure = func(err error) bool { return err != nil }
+	}
+
+	if opts.Clock == nil {
+		opts.Clock = timex.RealClock{}
+	}
+
+	return &Breaker{opts: opts}
+}
+
+// State returns the current state of the breaker.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	transition := b.refresh()
+	state := b.state
+	b.mu.Unlock()
+
+	b.notify(transition)
+
+	return state
+}
+
+// Execute calls fn if the breaker allows it and records its result. A panic in fn counts as a failure and is propagated.
+func (b *Breaker) Execute(fn func() error) error {
+	_, err := Execute(b, func() (struct{}, error) {
+		return struct{}{}, fn()
+	})
+
+	return err
+}
+
+// Execute calls fn through the breaker b and records its result, returning ErrOpen or ErrTooManyProbes
+// without calling fn when the breaker rejects the call.
+func Execute[T any](b *Breaker, fn func() (T, error)) (T, error) {
+	var zero T
+
+	generation, err := b.before()
+	if err != nil {
+		return zero, err
+	}
+
+	done := false
+	defer func() {
+		if !done {
+			b.after(generation, false)
+		}
+	}()
+
+	value, err := fn()
+	done = true
+	b.after(generation, !b.opts.IsFailure(err))
+
+	return value, err
+}
+
+// Reset closes the breaker and clears its counters.
+func (b *Breaker) Reset() {
+	b.mu.Lock()
+	transition := b.setState(StateClosed)
+	b.mu.Unlock()
+
+	b.notify(transition)
+}
+
+func (b *Breaker) before() (uint64, error) {
+	b.mu.Lock()
+	transition := b.refresh()
+
+	var err error
+	switch b.state {
+	case StateOpen:
+		err = ErrOpen
+	case StateHalfOpen:
+		if b.probes >= b.opts.HalfOpenProbes {
+			err = ErrTooManyProbes
+		} else {
+			b.probes++
+		}
+	}
+
+	generation := b.generation
+	b.mu.Unlock()
+
+	b.notify(transition)
+
+	return generation, err
+}
+
+func (b *Breaker) after(generation uint64, success bool) {
+	b.mu.Lock()
+	var transition *[2]State
+
+	if generation == b.generation {
+		switch b.state {
+		case StateClosed:
+			if success {
+				b.failures = 0
+			} else if b.failures++; b.failures >= b.opts.FailureThreshold {
+				transition = b.setState(StateOpen)
+			}
+		case StateHalfOpen:
+			if !success {
+				transition = b.setState(StateOpen)
+			} else if b.successes++; b.successes >= b.opts.HalfOpenProbes {
+				transition = b.setState(StateClosed)
+			}
+		}
+	}
+
+	b.mu.Unlock()
+
+	b.notify(transition)
+}
+
+// refresh moves an open breaker to half-open once the open timeout elapsed, the caller must hold b.mu.
+func (b *Breaker) refresh() *[2]State {
+	if b.state == StateOpen && !b.opts.Clock.Now().Before(b.openedAt.Add(b.opts.OpenTimeout)) {
+		return b.setState(StateHalfOpen)
+	}
+
+	return nil
+}
+
+// setState transitions to state and returns the transition to notify, the caller must hold b.mu.
+func (b *Breaker) setState(state State) *[2]State {
+	from := b.state
+
+	b.state = state
+	b.generation++
+	b.failures, b.successes, b.probes = 0, 0, 0
+	if state == StateOpen {
+		b.openedAt = b.opts.Clock.Now()
+	}
+
+	if from == state {
+		return nil
+	}
+
+	return &[2]State{from, state}
+}
+
+// notify calls the OnStateChange callback without holding b.mu, so it may use the breaker.
+func (b *Breaker) notify(transition *[2]State) {
+	if transition != nil && b.opts.OnStateChange != nil {
+		b.opts.OnStateChange(transition[0], transition[1])
+	}
+}
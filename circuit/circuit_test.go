@@ -0,0 +1,167 @@
+package circuit
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+var errTest = errors.New("test error")
+
+func fail() error    { return errTest }
+func succeed() error { return nil }
+
+func TestStateString(t *testing.T) {
+	tests := []struct {
+		state State
+		want  string
+	}{
+		{StateClosed, "closed"},
+		{StateOpen, "open"},
+		{StateHalfOpen, "half-open"},
+		{State(42), "unknown"},
+	}
+	for _, tt := range tests {
+		if got := tt.state.String(); got != tt.want {
+			t.Errorf("String() = %v, want %v", got, tt.want)
+		}
+	}
+}
+
+func TestBreakerTransitions(t *testing.T) {
+	clock := timex.NewFakeClock(time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC))
+
+	var mu sync.Mutex
+	var transitions []string
+	b := New(Options{
+		FailureThreshold: 2,
+		OpenTimeout:      time.Minute,
+		HalfOpenProbes:   2,
+		Clock:            clock,
+		OnStateChange: func(from, to State) {
+			mu.Lock()
+			defer mu.Unlock()
+			transitions = append(transitions, from.String()+">"+to.String())
+		},
+	})
+
+	// a success resets the consecutive failures
+	_ = b.Execute(fail)
+	_ = b.Execute(succeed)
+	_ = b.Execute(fail)
+	if got := b.State(); got != StateClosed {
+		t.Fatalf("State() = %v, want %v", got, StateClosed)
+	}
+
+	if err := b.Execute(fail); err != errTest {
+		t.Errorf("Execute() error = %v, want %v", err, errTest)
+	}
+
+	if got := b.State(); got != StateOpen {
+		t.Fatalf("State() = %v, want %v", got, StateOpen)
+	}
+
+	called := false
+	if err := b.Execute(func() error { called = true; return nil }); err != ErrOpen || called {
+		t.Errorf("Execute() = %v, called %v, want %v without calling", err, called, ErrOpen)
+	}
+
+	clock.Advance(time.Minute)
+	if got := b.State(); got != StateHalfOpen {
+		t.Fatalf("State() = %v, want %v", got, StateHalfOpen)
+	}
+
+	// a failed probe opens the breaker again
+	_ = b.Execute(fail)
+	if got := b.State(); got != StateOpen {
+		t.Fatalf("State() = %v, want %v", got, StateOpen)
+	}
+
+	clock.Advance(time.Minute)
+	_ = b.Execute(succeed)
+	if got := b.State(); got != StateHalfOpen {
+		t.Fatalf("State() = %v after one probe, want %v", got, StateHalfOpen)
+	}
+
+	_ = b.Execute(succeed)
+	if got := b.State(); got != StateClosed {
+		t.Fatalf("State() = %v after all probes, want %v", got, StateClosed)
+	}
+
+	want := []string{"closed>open", "open>half-open", "half-open>open", "open>half-open", "half-open>closed"}
+	mu.Lock()
+	defer mu.Unlock()
+	if !reflect.DeepEqual(transitions, want) {
+		t.Errorf("transitions = %v, want %v", transitions, want)
+	}
+}
+
+func TestBreakerProbeLimit(t *testing.T) {
+	clock := timex.NewFakeClock(time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC))
+	b := New(Options{FailureThreshold: 1, Clock: clock})
+
+	_ = b.Execute(fail)
+	clock.Advance(DefaultOpenTimeout)
+
+	started, release := make(chan struct{}), make(chan struct{})
+	go func() {
+		_ = b.Execute(func() error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+
+	<-started
+	if err := b.Execute(succeed); err != ErrTooManyProbes {
+		t.Errorf("Execute() error = %v, want %v", err, ErrTooManyProbes)
+	}
+
+	close(release)
+}
+
+func TestExecuteGeneric(t *testing.T) {
+	b := New(Options{})
+
+	got, err := Execute(b, func() (int, error) { return 42, nil })
+	if got != 42 || err != nil {
+		t.Errorf("Execute() = %v, %v, want 42, nil", got, err)
+	}
+}
+
+func TestBreakerIsFailure(t *testing.T) {
+	errNotFound := errors.New("not found")
+	b := New(Options{FailureThreshold: 1, IsFailure: func(err error) bool { return err != nil && err != errNotFound }})
+
+	_ = b.Execute(func() error { return errNotFound })
+	if got := b.State(); got != StateClosed {
+		t.Errorf("State() = %v, want %v", got, StateClosed)
+	}
+}
+
+func TestBreakerPanic(t *testing.T) {
+	b := New(Options{FailureThreshold: 1})
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Execute() did not propagate the panic")
+			}
+		}()
+
+		_ = b.Execute(func() error { panic("boom") })
+	}()
+
+	if got := b.State(); got != StateOpen {
+		t.Errorf("State() = %v, want %v", got, StateOpen)
+	}
+
+	b.Reset()
+	if got := b.State(); got != StateClosed {
+		t.Errorf("State() after Reset() = %v, want %v", got, StateClosed)
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Package cryptox defines misuse-resistant helpers over the standard cryptography packages.
+*/
+package cryptox
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// envelopeV1 is the version of the envelope: AES-GCM with a random 96-bit nonce.
+const envelopeV1 byte = 1
+
+// KeySize is the size of the keys generated by GenerateKey, selecting AES-256.
+const KeySize = 32
+
+var (
+	// ErrDecrypt is returned when a ciphertext cannot be authenticated: wrong key, wrong additional data or tampering.
+	ErrDecrypt = errors.New("failed to decrypt: message authentication failed")
+	// ErrUnsupportedVersion is returned when decrypting an envelope of an unknown version.
+	ErrUnsupportedVersion = errors.New("unsupported envelope version")
+)
+
+// GenerateKey returns a random key for Encrypt.
+func GenerateKey() ([]byte, error) {
+	return randomBytes(KeySize)
+}
+
+// randomBytes returns n bytes from crypto/rand for keys, nonces and salts. It deliberately ignores the
+// replaceable reader of the rand package of this module, which may be seeded to make shuffles reproducible.
+func randomBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return nil, fmt.Errorf("failed to read random bytes: %w", err)
+	}
+
+	return b, nil
+}
+
+// Encrypt encrypts plaintext with AES-GCM under key, which must be 16, 24 or 32 bytes long.
+// The output is an envelope holding a version byte, a random nonce and the authenticated ciphertext.
+func Encrypt(key, plaintext []byte) ([]byte, error) {
+	return EncryptWithAAD(key, plaintext, nil)
+}
+
+// EncryptWithAAD is like Encrypt, also authenticating additional data that is not encrypted nor stored
+// in the envelope. The same additional data must be passed to DecryptWithAAD, for instance a record ID
+// binding the ciphertext to its record.
+func EncryptWithAAD(key, plaintext, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := randomBytes(gcm.NonceSize())
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	envelope := make([]byte, 0, 1+len(nonce)+len(plaintext)+gcm.Overhead())
+	envelope = append(envelope, envelopeV1)
+	envelope = append(envelope, nonce...)
+
+	return gcm.Seal(envelope, nonce, plaintext, envelopeAAD(envelopeV1, aad)), nil
+}
+
+// Decrypt decrypts an envelope produced by Encrypt. It returns ErrDecrypt if the envelope was not
+// produced with key or was modified.
+func Decrypt(key, envelope []byte) ([]byte, error) {
+	return DecryptWithAAD(key, envelope, nil)
+}
+
+// DecryptWithAAD decrypts an envelope produced by EncryptWithAAD with the same additional data.
+func DecryptWithAAD(key, envelope, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(envelope) == 0 {
+		return nil, ErrDecrypt
+	}
+
+	if envelope[0] != envelopeV1 {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, envelope[0])
+	}
+
+	if len(envelope) < 1+gcm.NonceSize()+gcm.Overhead() {
+		return nil, ErrDecrypt
+	}
+
+	nonce, ciphertext := envelope[1:1+gcm.NonceSize()], envelope[1+gcm.NonceSize():]
+
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, envelopeAAD(envelopeV1, aad))
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	return gcm, nil
+}
+
+// envelopeAAD binds the version byte to the authenticated data, so it cannot be changed undetected.
+func envelopeAAD(version byte, aad []byte) []byte {
+	return append([]byte{version}, aad...)
+}
@@ -0,0 +1,118 @@
+package cryptox
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kashifkhan0771/utils/rand"
+)
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+
+	return len(p), nil
+}
+
+func TestRandomnessIgnoresRandReader(t *testing.T) {
+	original := rand.Reader
+	rand.Reader = zeroReader{}
+	defer func() { rand.Reader = original }()
+
+	key1, _ := GenerateKey()
+	key2, _ := GenerateKey()
+	if bytes.Equal(key1, key2) {
+		t.Errorf("GenerateKey() returned the same key twice with a deterministic rand.Reader")
+	}
+
+	c1, _ := Encrypt(key1, []byte("message"))
+	c2, _ := Encrypt(key1, []byte("message"))
+	if bytes.Equal(c1[:13], c2[:13]) {
+		t.Errorf("Encrypt() reused a nonce with a deterministic rand.Reader")
+	}
+
+	_, private1, _ := GenerateEd25519Key()
+	_, private2, _ := GenerateEd25519Key()
+	if bytes.Equal(private1, private2) {
+		t.Errorf("GenerateEd25519Key() returned the same key twice with a deterministic rand.Reader")
+	}
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	key, err := GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+
+	otherKey, _ := GenerateKey()
+	plaintext := []byte("card number 4111 1111 1111 1111")
+
+	envelope, err := EncryptWithAAD(key, plaintext, []byte("user-42"))
+	if err != nil {
+		t.Fatalf("EncryptWithAAD() error = %v", err)
+	}
+
+	tampered := append([]byte(nil), envelope...)
+	tampered[len(tampered)-1] ^= 1
+
+	versioned := append([]byte(nil), envelope...)
+	versioned[0] = 9
+
+	tests := []struct {
+		name     string
+		key      []byte
+		envelope []byte
+		aad      []byte
+		wantErr  error
+	}{
+		{name: "success - same key and data", key: key, envelope: envelope, aad: []byte("user-42")},
+		{name: "fail - wrong key", key: otherKey, envelope: envelope, aad: []byte("user-42"), wantErr: ErrDecrypt},
+		{name: "fail - wrong additional data", key: key, envelope: envelope, aad: []byte("user-43"), wantErr: ErrDecrypt},
+		{name: "fail - tampered", key: key, envelope: tampered, aad: []byte("user-42"), wantErr: ErrDecrypt},
+		{name: "fail - truncated", key: key, envelope: envelope[:10], aad: []byte("user-42"), wantErr: ErrDecrypt},
+		{name: "fail - empty", key: key, envelope: nil, wantErr: ErrDecrypt},
+		{name: "fail - unknown version", key: key, envelope: versioned, aad: []byte("user-42"), wantErr: ErrUnsupportedVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecryptWithAAD(tt.key, tt.envelope, tt.aad)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DecryptWithAAD() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil && !bytes.Equal(got, plaintext) {
+				t.Errorf("DecryptWithAAD() = %q, want %q", got, plaintext)
+			}
+		})
+	}
+}
+
+func TestEncryptNonces(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 16)
+
+	first, err := Encrypt(key, []byte("same"))
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+
+	second, _ := Encrypt(key, []byte("same"))
+	if bytes.Equal(first, second) {
+		t.Errorf("Encrypt() produced the same envelope twice, nonces must be random")
+	}
+
+	if got, err := Decrypt(key, second); err != nil || string(got) != "same" {
+		t.Errorf("Decrypt() = %q, %v, want same, nil", got, err)
+	}
+
+	if _, err := Encrypt([]byte("short"), []byte("data")); err == nil {
+		t.Errorf("Encrypt() expected error for an invalid key size")
+	}
+
+	if _, err := Decrypt([]byte("short"), first); err == nil {
+		t.Errorf("Decrypt() expected error for an invalid key size")
+	}
+}
@@ -0,0 +1,69 @@
+package cryptox
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// Algorithm is the name of a hash function supported by HashFile and HashReader.
+type Algorithm string
+
+// Supported hash algorithms.
+const (
+	SHA256  Algorithm = "sha256"
+	SHA512  Algorithm = "sha512"
+	BLAKE2b Algorithm = "blake2b" // BLAKE2b-256
+)
+
+// SHA256Hex returns the hex-encoded SHA-256 of s.
+func SHA256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// HashReader returns the hex-encoded hash of everything read from r using algo.
+// The input is streamed, so it is never held in memory at once.
+func HashReader(r io.Reader, algo Algorithm) (string, error) {
+	h, err := newHash(algo)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(h, r); err != nil {
+		return "", fmt.Errorf("failed to read input: %w", err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// HashFile returns the hex-encoded hash of the file at path using algo.
+func HashFile(path string, algo Algorithm) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	return HashReader(f, algo)
+}
+
+func newHash(algo Algorithm) (hash.Hash, error) {
+	switch algo {
+	case SHA256:
+		return sha256.New(), nil
+	case SHA512:
+		return sha512.New(), nil
+	case BLAKE2b:
+		return blake2b.New256(nil)
+	default:
+		return nil, fmt.Errorf("unsupported hash algorithm %q", algo)
+	}
+}
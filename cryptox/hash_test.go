@@ -0,0 +1,81 @@
+package cryptox
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSHA256Hex(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "success - empty", input: "", want: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+		{name: "success - abc", input: "abc", want: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SHA256Hex(tt.input); got != tt.want {
+				t.Errorf("SHA256Hex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHashReader(t *testing.T) {
+	tests := []struct {
+		name    string
+		algo    Algorithm
+		want    string
+		wantErr bool
+	}{
+		{name: "success - sha256", algo: SHA256, want: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+		{name: "success - sha512", algo: SHA512, want: "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f"},
+		{name: "success - blake2b", algo: BLAKE2b, want: "bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319"},
+		{name: "fail - unsupported algorithm", algo: "md5", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := HashReader(strings.NewReader("abc"), tt.algo)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("HashReader() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("HashReader() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, errors.New("disk on fire") }
+
+func TestHashReaderError(t *testing.T) {
+	if _, err := HashReader(failingReader{}, SHA256); err == nil {
+		t.Errorf("HashReader() expected error from the reader")
+	}
+}
+
+func TestHashFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.txt")
+	if err := os.WriteFile(path, []byte("abc"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := HashFile(path, SHA256)
+	if err != nil {
+		t.Fatalf("HashFile() error = %v", err)
+	}
+	if want := SHA256Hex("abc"); got != want {
+		t.Errorf("HashFile() = %v, want %v", got, want)
+	}
+
+	if _, err := HashFile(filepath.Join(t.TempDir(), "missing"), SHA256); err == nil {
+		t.Errorf("HashFile() expected error for a missing file")
+	}
+}
@@ -0,0 +1,143 @@
+package cryptox
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrNoKeys is returned when signing with an empty Keyring.
+var ErrNoKeys = errors.New("keyring has no keys")
+
+// Sign returns the HMAC-SHA256 of data under key.
+func Sign(key, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+
+	return mac.Sum(nil)
+}
+
+// Verify reports whether signature is the HMAC-SHA256 of data under key, in constant time.
+func Verify(key, data, signature []byte) bool {
+	return hmac.Equal(Sign(key, data), signature)
+}
+
+// Keyring holds versioned HMAC keys for rotation: signatures are made with the newest key and verified
+// with any key still in the keyring. Signatures have the form "v<version>:<hex HMAC-SHA256>".
+// The zero value is an empty keyring ready to use. It is safe for concurrent use.
+type Keyring struct {
+	mu     sync.RWMutex
+	keys   map[uint32][]byte
+	newest uint32
+}
+
+// NewKeyring creates an empty keyring.
+func NewKeyring() *Keyring {
+	return &Keyring{keys: make(map[uint32][]byte)}
+}
+
+// Add adds the key with its version. The key with the highest version signs. It returns an error if the
+// key is empty or the version is already used.
+func (k *Keyring) Add(version uint32, key []byte) error {
+	if len(key) == 0 {
+		return errors.New("key cannot be empty")
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.keys == nil {
+		k.keys = make(map[uint32][]byte)
+	}
+
+	if _, ok := k.keys[version]; ok {
+		return fmt.Errorf("key version %d already exists", version)
+	}
+
+	k.keys[version] = append([]byte(nil), key...)
+	if len(k.keys) == 1 || version > k.newest {
+		k.newest = version
+	}
+
+	return nil
+}
+
+// Remove removes the key of version, so its signatures stop being valid.
+func (k *Keyring) Remove(version uint32) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	delete(k.keys, version)
+	if version != k.newest {
+		return
+	}
+
+	k.newest = 0
+	for v := range k.keys {
+		if v > k.newest {
+			k.newest = v
+		}
+	}
+}
+
+// Len returns the number of keys.
+func (k *Keyring) Len() int {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	return len(k.keys)
+}
+
+// Sign signs data with the newest key.
+func (k *Keyring) Sign(data []byte) (string, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	key, ok := k.keys[k.newest]
+	if !ok {
+		return "", ErrNoKeys
+	}
+
+	return fmt.Sprintf("v%d:%s", k.newest, hex.EncodeToString(Sign(key, data))), nil
+}
+
+// Verify reports whether signature is a valid signature of data by a key of the keyring. A signature
+// without version prefix, as sent by some webhook providers, is checked against every key.
+func (k *Keyring) Verify(data []byte, signature string) bool {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if prefix, encoded, ok := strings.Cut(signature, ":"); ok && strings.HasPrefix(prefix, "v") {
+		version, err := strconv.ParseUint(prefix[1:], 10, 32)
+		if err != nil {
+			return false
+		}
+
+		key, ok := k.keys[uint32(version)]
+		if !ok {
+			return false
+		}
+
+		mac, err := hex.DecodeString(encoded)
+
+		return err == nil && Verify(key, data, mac)
+	}
+
+	mac, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	for _, key := range k.keys {
+		if Verify(key, data, mac) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,99 @@
+package cryptox
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSignVerify(t *testing.T) {
+	key := []byte("webhook secret")
+	signature := Sign(key, []byte(`{"event":"paid"}`))
+
+	tests := []struct {
+		name      string
+		key       []byte
+		data      string
+		signature []byte
+		want      bool
+	}{
+		{name: "success - valid signature", key: key, data: `{"event":"paid"}`, signature: signature, want: true},
+		{name: "fail - modified data", key: key, data: `{"event":"refunded"}`, signature: signature},
+		{name: "fail - wrong key", key: []byte("other"), data: `{"event":"paid"}`, signature: signature},
+		{name: "fail - truncated signature", key: key, data: `{"event":"paid"}`, signature: signature[:16]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Verify(tt.key, []byte(tt.data), tt.signature); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeyring(t *testing.T) {
+	var k Keyring
+	data := []byte("payload")
+
+	if _, err := k.Sign(data); !errors.Is(err, ErrNoKeys) {
+		t.Errorf("Sign() error = %v, want %v", err, ErrNoKeys)
+	}
+
+	if err := k.Add(1, []byte("old key")); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	oldSignature, _ := k.Sign(data)
+
+	if err := k.Add(2, []byte("new key")); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	if err := k.Add(2, []byte("again")); err == nil {
+		t.Errorf("Add() expected error for a duplicate version")
+	}
+
+	if err := k.Add(3, nil); err == nil {
+		t.Errorf("Add() expected error for an empty key")
+	}
+
+	newSignature, _ := k.Sign(data)
+	if !strings.HasPrefix(newSignature, "v2:") {
+		t.Errorf("Sign() = %v, want a signature by the newest key", newSignature)
+	}
+
+	rawSignature := hex.EncodeToString(Sign([]byte("old key"), data))
+
+	tests := []struct {
+		name      string
+		data      []byte
+		signature string
+		want      bool
+	}{
+		{name: "success - newest key", data: data, signature: newSignature, want: true},
+		{name: "success - rotated key", data: data, signature: oldSignature, want: true},
+		{name: "success - without version", data: data, signature: rawSignature, want: true},
+		{name: "fail - modified data", data: []byte("other"), signature: newSignature},
+		{name: "fail - unknown version", data: data, signature: strings.Replace(newSignature, "v2:", "v9:", 1)},
+		{name: "fail - bad version", data: data, signature: strings.Replace(newSignature, "v2:", "vx:", 1)},
+		{name: "fail - bad encoding", data: data, signature: "v2:zz"},
+		{name: "fail - empty", data: data, signature: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := k.Verify(tt.data, tt.signature); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	k.Remove(2)
+	if k.Verify(data, newSignature) || k.Len() != 1 {
+		t.Errorf("Remove() kept the key")
+	}
+
+	if got, _ := k.Sign(data); got != oldSignature {
+		t.Errorf("Sign() = %v after removing the newest key, want %v", got, oldSignature)
+	}
+}
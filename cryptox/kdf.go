@@ -0,0 +1,83 @@
+package cryptox
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// ScryptParams contains the cost parameters of scrypt.
+type ScryptParams struct {
+	N int // CPU/memory cost, a power of two greater than 1
+	R int // Block size
+	P int // Parallelism
+}
+
+// DefaultScryptParams are the parameters used by DeriveKeyScrypt, as recommended for interactive logins.
+var DefaultScryptParams = ScryptParams{N: 1 << 15, R: 8, P: 1}
+
+// DefaultPBKDF2Iterations is the number of PBKDF2-HMAC-SHA256 iterations used by DeriveKeyPBKDF2,
+// following OWASP recommendations.
+const DefaultPBKDF2Iterations = 600000
+
+// maxHKDFLength is the longest output HKDF-SHA256 can produce.
+const maxHKDFLength = 255 * sha256.Size
+
+// DeriveKey derives a sub-key of length bytes from a high-entropy secret using HKDF-SHA256.
+// The salt is optional; info binds the key to its purpose, so distinct info values give
+// independent keys from the same secret. Passwords should use DeriveKeyScrypt or DeriveKeyPBKDF2 instead.
+func DeriveKey(secret, salt, info []byte, length int) ([]byte, error) {
+	if length <= 0 || length > maxHKDFLength {
+		return nil, fmt.Errorf("invalid key length %d, must be between 1 and %d", length, maxHKDFLength)
+	}
+
+	key := make([]byte, length)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, info), key); err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	return key, nil
+}
+
+// DeriveKeyScrypt derives a key of length bytes from a password and salt using scrypt and DefaultScryptParams.
+func DeriveKeyScrypt(password, salt []byte, length int) ([]byte, error) {
+	return DeriveKeyScryptWithParams(password, salt, length, DefaultScryptParams)
+}
+
+// DeriveKeyScryptWithParams derives a key of length bytes from a password and salt using scrypt and params.
+func DeriveKeyScryptWithParams(password, salt []byte, length int, params ScryptParams) ([]byte, error) {
+	if length <= 0 {
+		return nil, fmt.Errorf("invalid key length %d", length)
+	}
+
+	key, err := scrypt.Key(password, salt, params.N, params.R, params.P, length)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	return key, nil
+}
+
+// DeriveKeyPBKDF2 derives a key of length bytes from a password and salt using PBKDF2-HMAC-SHA256
+// with DefaultPBKDF2Iterations.
+func DeriveKeyPBKDF2(password, salt []byte, length int) ([]byte, error) {
+	return DeriveKeyPBKDF2WithIterations(password, salt, length, DefaultPBKDF2Iterations)
+}
+
+// DeriveKeyPBKDF2WithIterations derives a key of length bytes from a password and salt using
+// PBKDF2-HMAC-SHA256 with the given number of iterations.
+func DeriveKeyPBKDF2WithIterations(password, salt []byte, length, iterations int) ([]byte, error) {
+	if length <= 0 {
+		return nil, fmt.Errorf("invalid key length %d", length)
+	}
+
+	if iterations <= 0 {
+		return nil, fmt.Errorf("invalid iterations %d", iterations)
+	}
+
+	return pbkdf2.Key(password, salt, iterations, length, sha256.New), nil
+}
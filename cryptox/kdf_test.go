@@ -0,0 +1,106 @@
+package cryptox
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestDeriveKey(t *testing.T) {
+	// RFC 5869 test case 1.
+	secret, _ := hex.DecodeString("0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b")
+	salt, _ := hex.DecodeString("000102030405060708090a0b0c")
+	info, _ := hex.DecodeString("f0f1f2f3f4f5f6f7f8f9")
+
+	tests := []struct {
+		name    string
+		length  int
+		want    string
+		wantErr bool
+	}{
+		{name: "success - rfc 5869 vector", length: 42, want: "3cb25f25faacd57a90434f64d0362f2a2d2d0a90cf1a5a4c5db02d56ecc4c5bf34007208d5b887185865"},
+		{name: "fail - zero length", length: 0, wantErr: true},
+		{name: "fail - too long", length: 255*32 + 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeriveKey(secret, salt, info, tt.length)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeriveKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && hex.EncodeToString(got) != tt.want {
+				t.Errorf("DeriveKey() = %x, want %v", got, tt.want)
+			}
+		})
+	}
+
+	encryption, _ := DeriveKey(secret, nil, []byte("encryption"), 32)
+	signing, _ := DeriveKey(secret, nil, []byte("signing"), 32)
+	if bytes.Equal(encryption, signing) {
+		t.Errorf("DeriveKey() gave the same key for different info")
+	}
+}
+
+func TestDeriveKeyScrypt(t *testing.T) {
+	tests := []struct {
+		name    string
+		length  int
+		params  ScryptParams
+		want    string
+		wantErr bool
+	}{
+		// RFC 7914 section 12, second vector.
+		{name: "success - rfc 7914 vector", length: 64, params: ScryptParams{N: 1024, R: 8, P: 16},
+			want: "fdbabe1c9d3472007856e7190d01e9fe7c6ad7cbc8237830e77376634b3731622eaf30d92e22a3886ff109279d9830dac727afb94a83ee6d8360cbdfa2cc0640"},
+		{name: "fail - zero length", length: 0, params: ScryptParams{N: 1024, R: 8, P: 16}, wantErr: true},
+		{name: "fail - invalid cost", length: 32, params: ScryptParams{N: 1000, R: 8, P: 1}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeriveKeyScryptWithParams([]byte("password"), []byte("NaCl"), tt.length, tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeriveKeyScryptWithParams() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && hex.EncodeToString(got) != tt.want {
+				t.Errorf("DeriveKeyScryptWithParams() = %x, want %v", got, tt.want)
+			}
+		})
+	}
+
+	key, err := DeriveKeyScrypt([]byte("password"), []byte("salt"), 32)
+	if err != nil || len(key) != 32 {
+		t.Errorf("DeriveKeyScrypt() = %x, %v, want a 32-byte key", key, err)
+	}
+}
+
+func TestDeriveKeyPBKDF2(t *testing.T) {
+	tests := []struct {
+		name       string
+		length     int
+		iterations int
+		want       string
+		wantErr    bool
+	}{
+		// RFC 7914 section 11, first vector.
+		{name: "success - rfc 7914 vector", length: 64, iterations: 1,
+			want: "55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783"},
+		{name: "fail - zero length", length: 0, iterations: 1, wantErr: true},
+		{name: "fail - zero iterations", length: 32, iterations: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeriveKeyPBKDF2WithIterations([]byte("passwd"), []byte("salt"), tt.length, tt.iterations)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeriveKeyPBKDF2WithIterations() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && hex.EncodeToString(got) != tt.want {
+				t.Errorf("DeriveKeyPBKDF2WithIterations() = %x, want %v", got, tt.want)
+			}
+		})
+	}
+
+	key, err := DeriveKeyPBKDF2([]byte("password"), []byte("salt"), 32)
+	if err != nil || len(key) != 32 {
+		t.Errorf("DeriveKeyPBKDF2() = %x, %v, want a 32-byte key", key, err)
+	}
+}
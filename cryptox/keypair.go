@@ -0,0 +1,207 @@
+package cryptox
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/curve25519"
+)
+
+// X25519PrivateKey is a 32-byte X25519 private key.
+type X25519PrivateKey []byte
+
+// X25519PublicKey is a 32-byte X25519 public key.
+type X25519PublicKey []byte
+
+// DER prefixes of PKCS #8 and PKIX encoded X25519 keys (RFC 8410), followed by the raw 32-byte key.
+var (
+	x25519PrivatePrefix = []byte{0x30, 0x2e, 0x02, 0x01, 0x00, 0x30, 0x05, 0x06, 0x03, 0x2b, 0x65, 0x6e, 0x04, 0x22, 0x04, 0x20}
+	x25519PublicPrefix  = []byte{0x30, 0x2a, 0x30, 0x05, 0x06, 0x03, 0x2b, 0x65, 0x6e, 0x03, 0x21, 0x00}
+)
+
+// ErrUnsupportedKey is returned when encoding or parsing a key that is neither Ed25519 nor X25519.
+var ErrUnsupportedKey = errors.New("unsupported key type")
+
+// GenerateEd25519Key returns a new Ed25519 key pair for signing.
+func GenerateEd25519Key() (ed25519.PublicKey, ed25519.PrivateKey, error) {
+	seed, err := randomBytes(ed25519.SeedSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	private := ed25519.NewKeyFromSeed(seed)
+
+	return private.Public().(ed25519.PublicKey), private, nil
+}
+
+// SignEd25519 returns the detached Ed25519 signature of message.
+func SignEd25519(private ed25519.PrivateKey, message []byte) []byte {
+	return ed25519.Sign(private, message)
+}
+
+// VerifyEd25519 reports whether signature is a valid detached Ed25519 signature of message by public.
+// Malformed keys and signatures never verify.
+func VerifyEd25519(public ed25519.PublicKey, message, signature []byte) bool {
+	if len(public) != ed25519.PublicKeySize {
+		return false
+	}
+
+	return ed25519.Verify(public, message, signature)
+}
+
+// GenerateX25519Key returns a new X25519 key pair for key agreement.
+func GenerateX25519Key() (X25519PublicKey, X25519PrivateKey, error) {
+	private, err := randomBytes(curve25519.ScalarSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	public, err := curve25519.X25519(private, curve25519.Basepoint)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return public, private, nil
+}
+
+// SharedSecret returns the X25519 shared secret of private and the peer public key. Both sides derive the same
+// secret, which should be passed through DeriveKey rather than used as a key directly.
+// Low-order peer keys, which would give an all-zero secret, are rejected.
+func SharedSecret(private X25519PrivateKey, peer X25519PublicKey) ([]byte, error) {
+	secret, err := curve25519.X25519(private, peer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute shared secret: %w", err)
+	}
+
+	return secret, nil
+}
+
+// MarshalPublicKeyPEM encodes an ed25519.PublicKey or X25519PublicKey as a PKIX "PUBLIC KEY" PEM block.
+func MarshalPublicKeyPEM(key crypto.PublicKey) ([]byte, error) {
+	var der []byte
+
+	switch k := key.(type) {
+	case ed25519.PublicKey:
+		var err error
+		if der, err = x509.MarshalPKIXPublicKey(k); err != nil {
+			return nil, err
+		}
+	case X25519PublicKey:
+		if len(k) != curve25519.PointSize {
+			return nil, fmt.Errorf("invalid X25519 public key length %d", len(k))
+		}
+
+		der = append(append([]byte{}, x25519PublicPrefix...), k...)
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKey, key)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
+// MarshalPrivateKeyPEM encodes an ed25519.PrivateKey or X25519PrivateKey as a PKCS #8 "PRIVATE KEY" PEM block.
+func MarshalPrivateKeyPEM(key crypto.PrivateKey) ([]byte, error) {
+	var der []byte
+
+	switch k := key.(type) {
+	case ed25519.PrivateKey:
+		var err error
+		if der, err = x509.MarshalPKCS8PrivateKey(k); err != nil {
+			return nil, err
+		}
+	case X25519PrivateKey:
+		if len(k) != curve25519.ScalarSize {
+			return nil, fmt.Errorf("invalid X25519 private key length %d", len(k))
+		}
+
+		der = append(append([]byte{}, x25519PrivatePrefix...), k...)
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKey, key)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// ParsePublicKeyPEM decodes a "PUBLIC KEY" PEM block into an ed25519.PublicKey or X25519PublicKey.
+func ParsePublicKeyPEM(data []byte) (crypto.PublicKey, error) {
+	der, err := decodePEM(data, "PUBLIC KEY")
+	if err != nil {
+		return nil, err
+	}
+
+	if raw, ok := trimPrefix(der, x25519PublicPrefix, curve25519.PointSize); ok {
+		return X25519PublicKey(raw), nil
+	}
+
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+
+	if k, ok := key.(ed25519.PublicKey); ok {
+		return k, nil
+	}
+
+	return nil, fmt.Errorf("%w: %T", ErrUnsupportedKey, key)
+}
+
+// ParsePrivateKeyPEM decodes a "PRIVATE KEY" PEM block into an ed25519.PrivateKey or X25519PrivateKey.
+func ParsePrivateKeyPEM(data []byte) (crypto.PrivateKey, error) {
+	der, err := decodePEM(data, "PRIVATE KEY")
+	if err != nil {
+		return nil, err
+	}
+
+	if raw, ok := trimPrefix(der, x25519PrivatePrefix, curve25519.ScalarSize); ok {
+		return X25519PrivateKey(raw), nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	if k, ok := key.(ed25519.PrivateKey); ok {
+		return k, nil
+	}
+
+	return nil, fmt.Errorf("%w: %T", ErrUnsupportedKey, key)
+}
+
+// EncodeKeyBase64 encodes a raw key with standard base64, for keys stored in environment variables or config.
+func EncodeKeyBase64(key []byte) string {
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+// DecodeKeyBase64 decodes a raw key encoded by EncodeKeyBase64.
+func DecodeKeyBase64(s string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode key: %w", err)
+	}
+
+	return key, nil
+}
+
+func decodePEM(data []byte, blockType string) ([]byte, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != blockType {
+		return nil, fmt.Errorf("no %s PEM block found", blockType)
+	}
+
+	return block.Bytes, nil
+}
+
+func trimPrefix(der, prefix []byte, size int) ([]byte, bool) {
+	if len(der) != len(prefix)+size || !bytes.HasPrefix(der, prefix) {
+		return nil, false
+	}
+
+	return append([]byte{}, der[len(prefix):]...), true
+}
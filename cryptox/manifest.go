@@ -0,0 +1,173 @@
+package cryptox
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// MismatchKind is the kind of difference between a manifest and a directory.
+type MismatchKind int
+
+const (
+	MismatchModified MismatchKind = iota // The file content does not match its checksum
+	MismatchMissing                      // The file is listed in the manifest but does not exist
+	MismatchExtra                        // The file exists but is not listed in the manifest
+)
+
+// String returns the name of the kind.
+func (k MismatchKind) String() string {
+	switch k {
+	case MismatchModified:
+		return "modified"
+	case MismatchMissing:
+		return "missing"
+	case MismatchExtra:
+		return "extra"
+	default:
+		return "unknown"
+	}
+}
+
+// Mismatch is a file of a directory that does not match its manifest.
+type Mismatch struct {
+	Kind MismatchKind
+	Path string // Slash separated path relative to the directory
+	Want string // Checksum in the manifest, empty for MismatchExtra
+	Got  string // Checksum of the file, empty for MismatchMissing
+}
+
+// WriteManifest writes a SHA256SUMS-style manifest of the regular files under dir to w: one
+// "<hex sha256>  <path>" line per file, with slash separated relative paths in lexical order.
+// The output can be checked with "sha256sum -c" from dir.
+func WriteManifest(dir string, w io.Writer) error {
+	sums, err := hashTree(dir)
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(sums))
+	for p := range sums {
+		paths = append(paths, p)
+	}
+
+	sort.Strings(paths)
+
+	bw := bufio.NewWriter(w)
+	for _, p := range paths {
+		fmt.Fprintf(bw, "%s  %s\n", sums[p], p)
+	}
+
+	return bw.Flush()
+}
+
+// VerifyManifest checks the regular files under dir against a manifest written by WriteManifest or sha256sum,
+// returning the mismatches ordered by path. An empty result means the directory matches the manifest.
+// Malformed manifests, including paths escaping dir, return an error.
+func VerifyManifest(dir string, manifest io.Reader) ([]Mismatch, error) {
+	want, err := parseManifest(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	got, err := hashTree(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var mismatches []Mismatch
+
+	for p, sum := range want {
+		switch actual, ok := got[p]; {
+		case !ok:
+			mismatches = append(mismatches, Mismatch{Kind: MismatchMissing, Path: p, Want: sum})
+		case actual != sum:
+			mismatches = append(mismatches, Mismatch{Kind: MismatchModified, Path: p, Want: sum, Got: actual})
+		}
+	}
+
+	for p, sum := range got {
+		if _, ok := want[p]; !ok {
+			mismatches = append(mismatches, Mismatch{Kind: MismatchExtra, Path: p, Got: sum})
+		}
+	}
+
+	sort.Slice(mismatches, func(i, j int) bool { return mismatches[i].Path < mismatches[j].Path })
+
+	return mismatches, nil
+}
+
+// hashTree returns the SHA-256 of every regular file under dir by slash separated relative path.
+func hashTree(dir string) (map[string]string, error) {
+	sums := make(map[string]string)
+
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+
+		sum, err := HashFile(p, SHA256)
+		if err != nil {
+			return err
+		}
+
+		sums[filepath.ToSlash(rel)] = sum
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash %s: %w", dir, err)
+	}
+
+	return sums, nil
+}
+
+func parseManifest(r io.Reader) (map[string]string, error) {
+	sums := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSuffix(scanner.Text(), "\r")
+		if text == "" {
+			continue
+		}
+
+		// sha256sum marks files hashed in binary mode with "*" instead of the second space.
+		sum, p, ok := strings.Cut(text, " ")
+		if !ok || len(p) < 2 || (p[0] != ' ' && p[0] != '*') {
+			return nil, fmt.Errorf("invalid manifest line %d", line)
+		}
+
+		p = strings.TrimPrefix(p[1:], "./")
+
+		if _, err := hex.DecodeString(sum); err != nil || len(sum) != 64 {
+			return nil, fmt.Errorf("invalid checksum on manifest line %d", line)
+		}
+
+		if !fs.ValidPath(p) || p == "." {
+			return nil, fmt.Errorf("invalid path %q on manifest line %d", p, line)
+		}
+
+		sums[p] = strings.ToLower(sum)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	return sums, nil
+}
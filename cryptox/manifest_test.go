@@ -0,0 +1,133 @@
+package cryptox
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeTree(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(p, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestWriteManifest(t *testing.T) {
+	dir := writeTree(t, map[string]string{"b.txt": "b", "a/c.bin": "c", "a.txt": "a"})
+
+	var buf bytes.Buffer
+	if err := WriteManifest(dir, &buf); err != nil {
+		t.Fatalf("WriteManifest() error = %v", err)
+	}
+
+	want := SHA256Hex("a") + "  a.txt\n" + SHA256Hex("c") + "  a/c.bin\n" + SHA256Hex("b") + "  b.txt\n"
+	if buf.String() != want {
+		t.Errorf("WriteManifest() = %q, want %q", buf.String(), want)
+	}
+
+	if err := WriteManifest(filepath.Join(dir, "missing"), &buf); err == nil {
+		t.Errorf("WriteManifest() expected error for a missing directory")
+	}
+}
+
+func TestVerifyManifest(t *testing.T) {
+	files := map[string]string{"app": "binary", "conf/app.yaml": "port: 80", "README": "docs"}
+	dir := writeTree(t, files)
+
+	var manifest bytes.Buffer
+	if err := WriteManifest(dir, &manifest); err != nil {
+		t.Fatal(err)
+	}
+
+	mismatches, err := VerifyManifest(dir, bytes.NewReader(manifest.Bytes()))
+	if err != nil || len(mismatches) != 0 {
+		t.Fatalf("VerifyManifest() = %v, %v, want no mismatches", mismatches, err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "conf", "app.yaml"), []byte("port: 81"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Remove(filepath.Join(dir, "README")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "backdoor"), []byte("x"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Mismatch{
+		{Kind: MismatchMissing, Path: "README", Want: SHA256Hex("docs")},
+		{Kind: MismatchExtra, Path: "backdoor", Got: SHA256Hex("x")},
+		{Kind: MismatchModified, Path: "conf/app.yaml", Want: SHA256Hex("port: 80"), Got: SHA256Hex("port: 81")},
+	}
+
+	mismatches, err = VerifyManifest(dir, bytes.NewReader(manifest.Bytes()))
+	if err != nil || !reflect.DeepEqual(mismatches, want) {
+		t.Errorf("VerifyManifest() = %+v, %v, want %+v", mismatches, err, want)
+	}
+}
+
+func TestVerifyManifestFormat(t *testing.T) {
+	dir := writeTree(t, map[string]string{"a.txt": "a"})
+	sum := SHA256Hex("a")
+
+	tests := []struct {
+		name     string
+		manifest string
+		wantErr  bool
+	}{
+		{name: "success - two spaces", manifest: sum + "  a.txt\n"},
+		{name: "success - binary marker", manifest: sum + " *a.txt\n"},
+		{name: "success - dot slash and crlf", manifest: strings.ToUpper(sum) + "  ./a.txt\r\n\n"},
+		{name: "fail - single space", manifest: sum + " a.txt\n", wantErr: true},
+		{name: "fail - short checksum", manifest: sum[:10] + "  a.txt\n", wantErr: true},
+		{name: "fail - not hex", manifest: strings.Repeat("z", 64) + "  a.txt\n", wantErr: true},
+		{name: "fail - parent path", manifest: sum + "  ../a.txt\n", wantErr: true},
+		{name: "fail - absolute path", manifest: sum + "  /etc/passwd\n", wantErr: true},
+		{name: "fail - missing path", manifest: sum + "\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mismatches, err := VerifyManifest(dir, strings.NewReader(tt.manifest))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("VerifyManifest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(mismatches) != 0 {
+				t.Errorf("VerifyManifest() = %+v, want no mismatches", mismatches)
+			}
+		})
+	}
+}
+
+func TestMismatchKindString(t *testing.T) {
+	tests := []struct {
+		kind MismatchKind
+		want string
+	}{
+		{kind: MismatchModified, want: "modified"},
+		{kind: MismatchMissing, want: "missing"},
+		{kind: MismatchExtra, want: "extra"},
+		{kind: MismatchKind(42), want: "unknown"},
+	}
+	for _, tt := range tests {
+		if got := tt.kind.String(); got != tt.want {
+			t.Errorf("String() = %v, want %v", got, tt.want)
+		}
+	}
+}
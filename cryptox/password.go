@@ -0,0 +1,124 @@
+package cryptox
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordParams contains the argon2id parameters of password hashes.
+type PasswordParams struct {
+	Memory      uint32 // Memory in KiB
+	Iterations  uint32 // Number of passes over the memory
+	Parallelism uint8  // Number of threads
+	SaltLength  uint32 // Length of the random salt in bytes
+	KeyLength   uint32 // Length of the hash in bytes
+}
+
+// DefaultPasswordParams are the parameters used by HashPassword, following RFC 9106 recommendations
+// for memory constrained environments.
+var DefaultPasswordParams = PasswordParams{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 4,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// HashPassword hashes the password with argon2id and DefaultPasswordParams. The result is a PHC string
+// such as "$argon2id$v=19$m=65536,t=3,p=4$<salt>$<hash>" embedding the salt and parameters.
+func HashPassword(password string) (string, error) {
+	return HashPasswordWithParams(password, DefaultPasswordParams)
+}
+
+// HashPasswordWithParams hashes the password with argon2id and params.
+func HashPasswordWithParams(password string, params PasswordParams) (string, error) {
+	if params.Memory == 0 || params.Iterations == 0 || params.Parallelism == 0 || params.SaltLength == 0 ||
+		params.KeyLength == 0 {
+		return "", fmt.Errorf("invalid password params %+v", params)
+	}
+
+	salt, err := randomBytes(int(params.SaltLength))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, params.Memory, params.Iterations,
+		params.Parallelism, base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// VerifyPassword reports whether the password matches the hash, an argon2id PHC string or a legacy bcrypt
+// hash. needsRehash is true when the password matches but the hash should be replaced by HashPassword,
+// because it is a bcrypt hash or its parameters differ from DefaultPasswordParams. Malformed hashes never match.
+func VerifyPassword(password, hash string) (ok, needsRehash bool) {
+	return VerifyPasswordWithParams(password, hash, DefaultPasswordParams)
+}
+
+// VerifyPasswordWithParams is like VerifyPassword, comparing the parameters of the hash with params.
+func VerifyPasswordWithParams(password, hash string, params PasswordParams) (ok, needsRehash bool) {
+	if isBcrypt(hash) {
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil, true
+	}
+
+	hashParams, salt, key, err := parseArgon2id(hash)
+	if err != nil {
+		return false, false
+	}
+
+	computed := argon2.IDKey([]byte(password), salt, hashParams.Iterations, hashParams.Memory,
+		hashParams.Parallelism, hashParams.KeyLength)
+	if subtle.ConstantTimeCompare(computed, key) != 1 {
+		return false, false
+	}
+
+	return true, hashParams != params
+}
+
+func isBcrypt(hash string) bool {
+	for _, prefix := range []string{"$2a$", "$2b$", "$2y$"} {
+		if strings.HasPrefix(hash, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseArgon2id decodes a PHC string of argon2id.
+func parseArgon2id(hash string) (params PasswordParams, salt, key []byte, err error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return params, nil, nil, fmt.Errorf("not an argon2id hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, fmt.Errorf("unsupported argon2 version %q", parts[2])
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, fmt.Errorf("invalid argon2 parameters %q: %w", parts[3], err)
+	}
+
+	if params.Memory == 0 || params.Iterations == 0 || params.Parallelism == 0 {
+		return params, nil, nil, fmt.Errorf("invalid argon2 parameters %q", parts[3])
+	}
+
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil || len(salt) == 0 {
+		return params, nil, nil, fmt.Errorf("invalid argon2 salt")
+	}
+
+	if key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(key) == 0 {
+		return params, nil, nil, fmt.Errorf("invalid argon2 hash")
+	}
+
+	params.SaltLength, params.KeyLength = uint32(len(salt)), uint32(len(key))
+
+	return params, salt, key, nil
+}
@@ -0,0 +1,74 @@
+package cryptox
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// fastParams keep the tests fast, production code should use DefaultPasswordParams.
+var fastParams = PasswordParams{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+func TestHashPassword(t *testing.T) {
+	hash, err := HashPassword("correct horse")
+	if err != nil {
+		t.Fatalf("HashPassword() error = %v", err)
+	}
+
+	if !strings.HasPrefix(hash, "$argon2id$v=19$m=65536,t=3,p=4$") {
+		t.Errorf("HashPassword() = %v, want a PHC string with the default parameters", hash)
+	}
+
+	if ok, needsRehash := VerifyPassword("correct horse", hash); !ok || needsRehash {
+		t.Errorf("VerifyPassword() = %v, %v, want true, false", ok, needsRehash)
+	}
+
+	other, _ := HashPassword("correct horse")
+	if other == hash {
+		t.Errorf("HashPassword() produced the same hash twice, salts must be random")
+	}
+
+	if _, err := HashPasswordWithParams("pw", PasswordParams{}); err == nil {
+		t.Errorf("HashPasswordWithParams() expected error for zero parameters")
+	}
+}
+
+func TestVerifyPassword(t *testing.T) {
+	argonHash, err := HashPasswordWithParams("s3cret", fastParams)
+	if err != nil {
+		t.Fatalf("HashPasswordWithParams() error = %v", err)
+	}
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("s3cret"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("bcrypt.GenerateFromPassword() error = %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		password        string
+		hash            string
+		params          PasswordParams
+		wantOk          bool
+		wantNeedsRehash bool
+	}{
+		{name: "success - current parameters", password: "s3cret", hash: argonHash, params: fastParams, wantOk: true},
+		{name: "success - outdated parameters", password: "s3cret", hash: argonHash, params: DefaultPasswordParams, wantOk: true, wantNeedsRehash: true},
+		{name: "success - legacy bcrypt", password: "s3cret", hash: string(bcryptHash), params: fastParams, wantOk: true, wantNeedsRehash: true},
+		{name: "fail - wrong password", password: "guess", hash: argonHash, params: fastParams},
+		{name: "fail - wrong bcrypt password", password: "guess", hash: string(bcryptHash), params: fastParams, wantNeedsRehash: true},
+		{name: "fail - unknown algorithm", password: "s3cret", hash: "$argon2i$v=19$m=1024,t=1,p=1$c2FsdA$aGFzaA", params: fastParams},
+		{name: "fail - bad version", password: "s3cret", hash: strings.Replace(argonHash, "v=19", "v=16", 1), params: fastParams},
+		{name: "fail - bad parameters", password: "s3cret", hash: strings.Replace(argonHash, "m=1024", "m=x", 1), params: fastParams},
+		{name: "fail - empty hash", password: "s3cret", hash: "", params: fastParams},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, needsRehash := VerifyPasswordWithParams(tt.password, tt.hash, tt.params)
+			if ok != tt.wantOk || needsRehash != tt.wantNeedsRehash {
+				t.Errorf("VerifyPasswordWithParams() = %v, %v, want %v, %v", ok, needsRehash, tt.wantOk, tt.wantNeedsRehash)
+			}
+		})
+	}
+}
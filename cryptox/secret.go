@@ -0,0 +1,100 @@
+package cryptox
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Redacted is the text printed in place of the value of a Secret.
+const Redacted = "[REDACTED]"
+
+// Secret holds a sensitive value, such as a token or password, that is redacted when printed with fmt,
+// or encoded as JSON or text, so it cannot leak into logs by accident. The value is only available
+// through Expose and ExposeBytes. The zero value is an empty secret.
+//
+// Secrets can be decoded from JSON or text, but encoding them always writes Redacted, so a struct with Secret
+// fields does not survive a round trip: encode the exposed values instead, as secrets.Seal requires.
+type Secret struct {
+	b []byte
+}
+
+// NewSecret returns a Secret holding s.
+func NewSecret(s string) Secret {
+	return Secret{b: []byte(s)}
+}
+
+// NewSecretBytes returns a Secret holding a copy of b. The caller may wipe b afterwards.
+func NewSecretBytes(b []byte) Secret {
+	return Secret{b: append([]byte(nil), b...)}
+}
+
+// Expose returns the value of the secret.
+func (s Secret) Expose() string {
+	return string(s.b)
+}
+
+// ExposeBytes returns the value of the secret without copying it. The slice must not be retained
+// after the secret is wiped.
+func (s Secret) ExposeBytes() []byte {
+	return s.b
+}
+
+// IsEmpty reports whether the secret holds an empty value.
+func (s Secret) IsEmpty() bool {
+	return len(s.b) == 0
+}
+
+// Wipe overwrites the value with zeros and empties the secret. Wiping is best effort: strings returned by
+// Expose and copies made by the runtime are not reached.
+func (s *Secret) Wipe() {
+	for i := range s.b {
+		s.b[i] = 0
+	}
+
+	s.b = nil
+}
+
+// String implements fmt.Stringer, returning Redacted.
+func (s Secret) String() string {
+	return Redacted
+}
+
+// GoString implements fmt.GoStringer, returning Redacted.
+func (s Secret) GoString() string {
+	return Redacted
+}
+
+// Format implements fmt.Formatter, printing Redacted for every verb.
+func (s Secret) Format(f fmt.State, _ rune) {
+	_, _ = io.WriteString(f, Redacted)
+}
+
+// MarshalJSON implements json.Marshaler, encoding Redacted. The value is lost, see Secret.
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(Redacted)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding Redacted.
+func (s Secret) MarshalText() ([]byte, error) {
+	return []byte(Redacted), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, so secrets can be loaded from configuration.
+func (s *Secret) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	s.b = []byte(value)
+
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Secret) UnmarshalText(text []byte) error {
+	s.b = append([]byte(nil), text...)
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package cryptox
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSecretRedaction(t *testing.T) {
+	secret := NewSecret("sk_live_123")
+
+	type request struct {
+		User  string
+		Token Secret
+	}
+
+	tests := []struct {
+		name   string
+		format string
+		value  interface{}
+	}{
+		{name: "success - %v", format: "%v", value: secret},
+		{name: "success - %s", format: "%s", value: secret},
+		{name: "success - %q", format: "%q", value: secret},
+		{name: "success - %x", format: "%x", value: secret},
+		{name: "success - %#v", format: "%#v", value: secret},
+		{name: "success - pointer", format: "%v", value: &secret},
+		{name: "success - struct field", format: "%+v", value: request{User: "ada", Token: secret}},
+		{name: "success - struct field go syntax", format: "%#v", value: request{User: "ada", Token: secret}},
+		{name: "success - slice", format: "%v", value: []Secret{secret}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fmt.Sprintf(tt.format, tt.value)
+			if strings.Contains(got, "sk_live") || !strings.Contains(got, Redacted) {
+				t.Errorf("Sprintf(%q) = %v, want it redacted", tt.format, got)
+			}
+		})
+	}
+}
+
+func TestSecretJSON(t *testing.T) {
+	type config struct {
+		Token Secret `json:"token"`
+	}
+
+	data, err := json.Marshal(config{Token: NewSecret("sk_live_123")})
+	if want := `{"token":"[REDACTED]"}`; err != nil || string(data) != want {
+		t.Errorf("MarshalJSON() = %s, %v, want %v", data, err, want)
+	}
+
+	var c config
+	if err := json.Unmarshal([]byte(`{"token":"sk_live_456"}`), &c); err != nil || c.Token.Expose() != "sk_live_456" {
+		t.Errorf("UnmarshalJSON() = %v, %v, want sk_live_456", c.Token.Expose(), err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"token":42}`), &c); err == nil {
+		t.Errorf("UnmarshalJSON() expected error for a number")
+	}
+
+	text, _ := c.Token.MarshalText()
+	if string(text) != Redacted {
+		t.Errorf("MarshalText() = %s, want %v", text, Redacted)
+	}
+
+	var s Secret
+	if err := s.UnmarshalText([]byte("hunter2")); err != nil || s.Expose() != "hunter2" {
+		t.Errorf("UnmarshalText() = %v, %v, want hunter2", s.Expose(), err)
+	}
+}
+
+func TestSecretWipe(t *testing.T) {
+	raw := []byte("hunter2")
+	secret := NewSecretBytes(raw)
+	raw[0] = 'X'
+
+	if secret.Expose() != "hunter2" {
+		t.Errorf("NewSecretBytes() = %v, want a copy of the input", secret.Expose())
+	}
+
+	exposed := secret.ExposeBytes()
+	secret.Wipe()
+
+	if !secret.IsEmpty() || secret.Expose() != "" {
+		t.Errorf("Wipe() left %q", secret.Expose())
+	}
+
+	for _, b := range exposed {
+		if b != 0 {
+			t.Fatalf("Wipe() left %q in memory", exposed)
+		}
+	}
+
+	var zero Secret
+	zero.Wipe()
+	if !zero.IsEmpty() {
+		t.Errorf("IsEmpty() = false for the zero value")
+	}
+}
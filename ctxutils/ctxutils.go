@@ -2,6 +2,7 @@ package ctxutils
 
 import (
 	"context"
+	"time"
 )
 
 type ContextKeyString struct {
@@ -29,3 +30,18 @@ func GetIntValue(ctx context.Context, key ContextKeyInt) (int, bool) {
 	value, ok := ctx.Value(key).(int)
 	return value, ok
 }
+
+// Detach returns a context keeping the values of ctx without its cancellation and deadline, for work that
+// must outlive the request that started it, such as a shared call or a background write.
+func Detach(ctx context.Context) context.Context {
+	return detached{parent: ctx}
+}
+
+type detached struct {
+	parent context.Context
+}
+
+func (detached) Deadline() (time.Time, bool)         { return time.Time{}, false }
+func (detached) Done() <-chan struct{}               { return nil }
+func (detached) Err() error                          { return nil }
+func (d detached) Value(key interface{}) interface{} { return d.parent.Value(key) }
@@ -3,6 +3,7 @@ package ctxutils
 import (
 	"context"
 	"testing"
+	"time"
 )
 
 func TestSetStringValueAndGetStringValue(t *testing.T) {
@@ -67,3 +68,27 @@ func TestSetIntValueWithWrongKey(t *testing.T) {
 		t.Errorf("Expected value not to be found, but it was.")
 	}
 }
+
+func TestDetach(t *testing.T) {
+	key := ContextKeyString{Key: "requestID"}
+
+	parent, cancel := context.WithTimeout(SetStringValue(context.Background(), key, "abc"), time.Minute)
+	cancel()
+
+	ctx := Detach(parent)
+	if err := ctx.Err(); err != nil {
+		t.Errorf("Err() = %v, want nil", err)
+	}
+
+	if ctx.Done() != nil {
+		t.Errorf("Done() = non-nil channel, want nil")
+	}
+
+	if _, ok := ctx.Deadline(); ok {
+		t.Errorf("Deadline() reported a deadline")
+	}
+
+	if got, ok := GetStringValue(ctx, key); !ok || got != "abc" {
+		t.Errorf("GetStringValue() = %q, %v, want %q", got, ok, "abc")
+	}
+}
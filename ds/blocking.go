@@ -0,0 +1,167 @@
+package ds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrClosed is returned when putting into a closed BlockingQueue, or taking from a closed and drained one.
+var ErrClosed = errors.New("queue is closed")
+
+// BlockingQueue is a bounded FIFO queue for producers and consumers, blocking Put when full and Take when
+// empty. It is safe for concurrent use.
+type BlockingQueue[T any] struct {
+	mu      sync.Mutex
+	items   *Deque[T]
+	closed  bool
+	changed chan struct{} // closed and replaced when elements are added or removed, or the queue closes
+}
+
+// NewBlockingQueue creates a queue holding up to capacity elements. It returns an error if capacity is not positive.
+func NewBlockingQueue[T any](capacity int) (*BlockingQueue[T], error) {
+	if capacity <= 0 {
+		return nil, fmt.Errorf("capacity must be positive, got %d", capacity)
+	}
+
+	return &BlockingQueue[T]{items: NewDeque[T](capacity), changed: make(chan struct{})}, nil
+}
+
+// Put adds the element at the back, waiting for room until ctx is done. It returns ErrClosed if the queue
+// is closed, or the context error.
+func (q *BlockingQueue[T]) Put(ctx context.Context, item T) error {
+	for {
+		q.mu.Lock()
+		if q.closed {
+			q.mu.Unlock()
+			return ErrClosed
+		}
+
+		if q.items.PushBack(item) == nil {
+			q.notify()
+			q.mu.Unlock()
+
+			return nil
+		}
+
+		changed := q.changed
+		q.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// TryPut adds the element at the back without waiting. It returns ErrFull if the queue is full
+// and ErrClosed if it is closed.
+func (q *BlockingQueue[T]) TryPut(item T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return ErrClosed
+	}
+
+	if err := q.items.PushBack(item); err != nil {
+		return err
+	}
+
+	q.notify()
+
+	return nil
+}
+
+// Take removes and returns the front element, waiting for one until ctx is done. Once the queue is closed,
+// the remaining elements are still returned, then ErrClosed. It also returns the context error.
+func (q *BlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	for {
+		q.mu.Lock()
+		if item, ok := q.items.PopFront(); ok {
+			q.notify()
+			q.mu.Unlock()
+
+			return item, nil
+		}
+
+		if q.closed {
+			q.mu.Unlock()
+
+			var zero T
+			return zero, ErrClosed
+		}
+
+		changed := q.changed
+		q.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// TryTake removes and returns the front element without waiting, or false if the queue is empty.
+func (q *BlockingQueue[T]) TryTake() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	item, ok := q.items.PopFront()
+	if ok {
+		q.notify()
+	}
+
+	return item, ok
+}
+
+// Peek returns the front element without removing it, or false if the queue is empty.
+func (q *BlockingQueue[T]) Peek() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.items.PeekFront()
+}
+
+// Len returns the number of elements.
+func (q *BlockingQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.items.Len()
+}
+
+// Cap returns the maximum number of elements.
+func (q *BlockingQueue[T]) Cap() int {
+	return q.items.Cap()
+}
+
+// Close closes the queue: Put fails while Take drains the remaining elements. It wakes up every waiting
+// caller and is safe to call more than once.
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if !q.closed {
+		q.closed = true
+		q.notify()
+	}
+}
+
+// Closed reports whether the queue is closed.
+func (q *BlockingQueue[T]) Closed() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.closed
+}
+
+// notify wakes up the waiting callers, the caller must hold q.mu.
+func (q *BlockingQueue[T]) notify() {
+	close(q.changed)
+	q.changed = make(chan struct{})
+}
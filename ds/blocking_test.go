@@ -0,0 +1,185 @@
+package ds
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewBlockingQueue(t *testing.T) {
+	if _, err := NewBlockingQueue[int](0); err == nil {
+		t.Errorf("NewBlockingQueue() expected error for zero capacity")
+	}
+}
+
+func TestBlockingQueueTry(t *testing.T) {
+	q, _ := NewBlockingQueue[int](2)
+
+	tests := []struct {
+		name    string
+		item    int
+		wantErr error
+	}{
+		{name: "success - first", item: 1},
+		{name: "success - second", item: 2},
+		{name: "fail - full", item: 3, wantErr: ErrFull},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := q.TryPut(tt.item); !errors.Is(err, tt.wantErr) {
+				t.Errorf("TryPut() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if got, _ := q.Peek(); got != 1 {
+		t.Errorf("Peek() = %v, want %v", got, 1)
+	}
+
+	if q.Len() != 2 || q.Cap() != 2 {
+		t.Errorf("Len(), Cap() = %v, %v, want 2, 2", q.Len(), q.Cap())
+	}
+
+	if got, ok := q.TryTake(); !ok || got != 1 {
+		t.Errorf("TryTake() = %v, %v, want 1, true", got, ok)
+	}
+}
+
+func TestBlockingQueueBlocking(t *testing.T) {
+	q, _ := NewBlockingQueue[int](1)
+	ctx := context.Background()
+
+	q.Put(ctx, 1)
+
+	// Put waits for room
+	done := make(chan error)
+	go func() { done <- q.Put(ctx, 2) }()
+
+	select {
+	case <-done:
+		t.Fatalf("Put() returned on a full queue")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	if got, _ := q.Take(ctx); got != 1 {
+		t.Errorf("Take() = %v, want %v", got, 1)
+	}
+
+	if err := <-done; err != nil {
+		t.Errorf("Put() error = %v", err)
+	}
+
+	if got, _ := q.Take(ctx); got != 2 {
+		t.Errorf("Take() = %v, want %v", got, 2)
+	}
+
+	// Take and Put stop with the context
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := q.Take(timeout); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Take() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	q.Put(ctx, 3)
+	if err := q.Put(timeout, 4); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Put() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestBlockingQueueClose(t *testing.T) {
+	q, _ := NewBlockingQueue[int](10)
+	ctx := context.Background()
+
+	q.Put(ctx, 1)
+	q.Put(ctx, 2)
+	q.Close()
+	q.Close()
+
+	if !q.Closed() {
+		t.Errorf("Closed() = false")
+	}
+
+	if err := q.Put(ctx, 3); !errors.Is(err, ErrClosed) {
+		t.Errorf("Put() error = %v, want %v", err, ErrClosed)
+	}
+
+	if err := q.TryPut(3); !errors.Is(err, ErrClosed) {
+		t.Errorf("TryPut() error = %v, want %v", err, ErrClosed)
+	}
+
+	for _, want := range []int{1, 2} {
+		if got, err := q.Take(ctx); err != nil || got != want {
+			t.Errorf("Take() = %v, %v, want %v, nil", got, err, want)
+		}
+	}
+
+	if _, err := q.Take(ctx); !errors.Is(err, ErrClosed) {
+		t.Errorf("Take() error = %v, want %v", err, ErrClosed)
+	}
+
+	// Close wakes up the waiting consumers
+	empty, _ := NewBlockingQueue[int](1)
+	done := make(chan error)
+	go func() {
+		_, err := empty.Take(ctx)
+		done <- err
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	empty.Close()
+
+	if err := <-done; !errors.Is(err, ErrClosed) {
+		t.Errorf("Take() error = %v, want %v", err, ErrClosed)
+	}
+}
+
+func TestBlockingQueueProducersConsumers(t *testing.T) {
+	q, _ := NewBlockingQueue[int](4)
+	ctx := context.Background()
+
+	var producers sync.WaitGroup
+	for p := 0; p < 4; p++ {
+		producers.Add(1)
+		go func(p int) {
+			defer producers.Done()
+			for i := 0; i < 250; i++ {
+				if err := q.Put(ctx, 1); err != nil {
+					t.Errorf("Put() error = %v", err)
+				}
+			}
+		}(p)
+	}
+
+	var (
+		mu        sync.Mutex
+		total     int
+		consumers sync.WaitGroup
+	)
+	for c := 0; c < 3; c++ {
+		consumers.Add(1)
+		go func() {
+			defer consumers.Done()
+			for {
+				item, err := q.Take(ctx)
+				if err != nil {
+					return
+				}
+
+				mu.Lock()
+				total += item
+				mu.Unlock()
+			}
+		}()
+	}
+
+	producers.Wait()
+	q.Close()
+	consumers.Wait()
+
+	if total != 1000 {
+		t.Errorf("consumed %v elements, want %v", total, 1000)
+	}
+}
@@ -0,0 +1,154 @@
+package ds
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+)
+
+const bloomVersion = 1
+
+// Bloom is a Bloom filter: a compact set membership test with no false negatives and a bounded rate
+// of false positives. Hashing is stable, so serialized filters can be loaded by other processes.
+// A Bloom is not safe for concurrent use.
+type Bloom struct {
+	bits  []uint64
+	m     uint64 // number of bits
+	k     uint64 // number of hash functions
+	count uint64 // number of additions, an upper bound of the distinct elements
+}
+
+// NewBloom creates a Bloom filter sized for expectedItems elements with a false positive rate of fpRate
+// once full. It returns an error if expectedItems is not positive or fpRate is not between 0 and 1.
+func NewBloom(expectedItems int, fpRate float64) (*Bloom, error) {
+	if expectedItems <= 0 {
+		return nil, fmt.Errorf("expected items must be positive, got %d", expectedItems)
+	}
+
+	if fpRate <= 0 || fpRate >= 1 {
+		return nil, fmt.Errorf("false positive rate must be between 0 and 1, got %v", fpRate)
+	}
+
+	n := float64(expectedItems)
+	m := math.Ceil(-n * math.Log(fpRate) / (math.Ln2 * math.Ln2))
+	k := math.Max(1, math.Round(m/n*math.Ln2))
+
+	return newBloom(uint64(m), uint64(k)), nil
+}
+
+func newBloom(m, k uint64) *Bloom {
+	return &Bloom{bits: make([]uint64, (m+63)/64), m: m, k: k}
+}
+
+// Add adds the element to the filter.
+func (b *Bloom) Add(data []byte) {
+	h1, h2 := bloomHash(data)
+	for i := uint64(0); i < b.k; i++ {
+		bit := (h1 + i*h2) % b.m
+		b.bits[bit/64] |= 1 << (bit % 64)
+	}
+
+	b.count++
+}
+
+// AddString adds the string to the filter.
+func (b *Bloom) AddString(s string) {
+	b.Add([]byte(s))
+}
+
+// MightContain reports whether the element may have been added. False means it was certainly not added.
+func (b *Bloom) MightContain(data []byte) bool {
+	h1, h2 := bloomHash(data)
+	for i := uint64(0); i < b.k; i++ {
+		bit := (h1 + i*h2) % b.m
+		if b.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MightContainString reports whether the string may have been added.
+func (b *Bloom) MightContainString(s string) bool {
+	return b.MightContain([]byte(s))
+}
+
+// Merge adds the elements of other to the filter. Both filters must have been created with the same parameters.
+func (b *Bloom) Merge(other *Bloom) error {
+	if b.m != other.m || b.k != other.k {
+		return fmt.Errorf("cannot merge filters of %d bits and %d hashes with %d bits and %d hashes",
+			b.m, b.k, other.m, other.k)
+	}
+
+	for i := range b.bits {
+		b.bits[i] |= other.bits[i]
+	}
+
+	b.count += other.count
+
+	return nil
+}
+
+// Count returns the number of additions, which counts the elements added more than once several times.
+func (b *Bloom) Count() uint64 {
+	return b.count
+}
+
+// FalsePositiveRate estimates the current false positive rate from the number of additions.
+func (b *Bloom) FalsePositiveRate() float64 {
+	return math.Pow(1-math.Exp(-float64(b.k)*float64(b.count)/float64(b.m)), float64(b.k))
+}
+
+// MarshalBinary encodes the filter, see UnmarshalBinary.
+func (b *Bloom) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 1+3*8+8*len(b.bits))
+	data[0] = bloomVersion
+	binary.BigEndian.PutUint64(data[1:], b.m)
+	binary.BigEndian.PutUint64(data[9:], b.k)
+	binary.BigEndian.PutUint64(data[17:], b.count)
+	for i, word := range b.bits {
+		binary.BigEndian.PutUint64(data[25+8*i:], word)
+	}
+
+	return data, nil
+}
+
+// UnmarshalBinary decodes a filter encoded by MarshalBinary, replacing the content of b.
+func (b *Bloom) UnmarshalBinary(data []byte) error {
+	if len(data) < 25 {
+		return errors.New("bloom filter data is too short")
+	}
+
+	if data[0] != bloomVersion {
+		return fmt.Errorf("unsupported bloom filter version %d", data[0])
+	}
+
+	m := binary.BigEndian.Uint64(data[1:])
+	k := binary.BigEndian.Uint64(data[9:])
+	words := uint64(len(data)-25) / 8
+	if k == 0 || len(data)%8 != 1 || m == 0 || m > words*64 || m <= (words-1)*64 {
+		return errors.New("invalid bloom filter data")
+	}
+
+	decoded := newBloom(m, k)
+	decoded.count = binary.BigEndian.Uint64(data[17:])
+	for i := range decoded.bits {
+		decoded.bits[i] = binary.BigEndian.Uint64(data[25+8*i:])
+	}
+
+	*b = *decoded
+
+	return nil
+}
+
+// bloomHash returns the two halves of the 128-bit FNV-1a hash of data, combined to derive the k hashes.
+func bloomHash(data []byte) (uint64, uint64) {
+	h := fnv.New128a()
+	h.Write(data)
+	sum := h.Sum(nil)
+
+	return binary.BigEndian.Uint64(sum[:8]), binary.BigEndian.Uint64(sum[8:]) | 1
+}
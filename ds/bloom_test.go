@@ -0,0 +1,115 @@
+package ds
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNewBloom(t *testing.T) {
+	tests := []struct {
+		name          string
+		expectedItems int
+		fpRate        float64
+		wantErr       bool
+	}{
+		{name: "success - valid parameters", expectedItems: 1000, fpRate: 0.01},
+		{name: "fail - no items", expectedItems: 0, fpRate: 0.01, wantErr: true},
+		{name: "fail - zero rate", expectedItems: 1000, fpRate: 0, wantErr: true},
+		{name: "fail - rate of one", expectedItems: 1000, fpRate: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBloom(tt.expectedItems, tt.fpRate)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewBloom() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBloom(t *testing.T) {
+	b, _ := NewBloom(10000, 0.01)
+	for i := 0; i < 10000; i++ {
+		b.AddString(fmt.Sprintf("user-%d", i))
+	}
+
+	for i := 0; i < 10000; i++ {
+		if !b.MightContainString(fmt.Sprintf("user-%d", i)) {
+			t.Fatalf("MightContainString(user-%d) = false for an added element", i)
+		}
+	}
+
+	falsePositives := 0
+	for i := 0; i < 10000; i++ {
+		if b.MightContain([]byte(fmt.Sprintf("other-%d", i))) {
+			falsePositives++
+		}
+	}
+
+	if rate := float64(falsePositives) / 10000; rate > 0.02 {
+		t.Errorf("false positive rate = %v, want about %v", rate, 0.01)
+	}
+
+	if got := b.FalsePositiveRate(); got < 0.005 || got > 0.015 {
+		t.Errorf("FalsePositiveRate() = %v, want about %v", got, 0.01)
+	}
+
+	if got := b.Count(); got != 10000 {
+		t.Errorf("Count() = %v, want %v", got, 10000)
+	}
+}
+
+func TestBloomMerge(t *testing.T) {
+	a, _ := NewBloom(100, 0.01)
+	b, _ := NewBloom(100, 0.01)
+	a.AddString("a")
+	b.AddString("b")
+
+	if err := a.Merge(b); err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+
+	if !a.MightContainString("a") || !a.MightContainString("b") {
+		t.Errorf("Merge() lost elements")
+	}
+
+	other, _ := NewBloom(1000, 0.01)
+	if err := a.Merge(other); err == nil {
+		t.Errorf("Merge() expected error for filters of different sizes")
+	}
+}
+
+func TestBloomBinary(t *testing.T) {
+	b, _ := NewBloom(100, 0.01)
+	b.AddString("persisted")
+
+	data, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error = %v", err)
+	}
+
+	var decoded Bloom
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary() error = %v", err)
+	}
+
+	if !decoded.MightContainString("persisted") || decoded.Count() != 1 {
+		t.Errorf("UnmarshalBinary() lost the content of the filter")
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "fail - too short", data: data[:10]},
+		{name: "fail - truncated bits", data: data[:len(data)-8]},
+		{name: "fail - unknown version", data: append([]byte{9}, data[1:]...)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := decoded.UnmarshalBinary(tt.data); err == nil {
+				t.Errorf("UnmarshalBinary() expected error")
+			}
+		})
+	}
+}
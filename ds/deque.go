@@ -0,0 +1,184 @@
+/*
+Package ds defines generic data structures.
+*/
+package ds
+
+import "errors"
+
+// ErrFull is returned when adding to a bounded collection at capacity.
+var ErrFull = errors.New("collection is full")
+
+const minDequeSize = 16
+
+// Deque is a double-ended queue backed by a growable ring buffer, with amortized O(1) operations at both ends.
+// The zero value is an empty unbounded deque ready to use. A Deque is not safe for concurrent use.
+type Deque[T any] struct {
+	buf      []T
+	head     int
+	size     int
+	capacity int // maximum number of elements, unbounded if zero
+}
+
+// NewDeque creates a deque holding up to capacity elements, or unbounded if capacity is zero or negative.
+func NewDeque[T any](capacity int) *Deque[T] {
+	if capacity < 0 {
+		capacity = 0
+	}
+
+	return &Deque[T]{capacity: capacity}
+}
+
+// PushBack adds the element at the back. It returns ErrFull if the deque is bounded and full.
+func (d *Deque[T]) PushBack(item T) error {
+	if err := d.reserve(); err != nil {
+		return err
+	}
+
+	d.buf[(d.head+d.size)%len(d.buf)] = item
+	d.size++
+
+	return nil
+}
+
+// PushFront adds the element at the front. It returns ErrFull if the deque is bounded and full.
+func (d *Deque[T]) PushFront(item T) error {
+	if err := d.reserve(); err != nil {
+		return err
+	}
+
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = item
+	d.size++
+
+	return nil
+}
+
+// PopFront removes and returns the front element, or false if the deque is empty.
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+
+	item := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = (d.head + 1) % len(d.buf)
+	d.size--
+	d.shrink()
+
+	return item, true
+}
+
+// PopBack removes and returns the back element, or false if the deque is empty.
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+
+	i := (d.head + d.size - 1) % len(d.buf)
+	item := d.buf[i]
+	d.buf[i] = zero
+	d.size--
+	d.shrink()
+
+	return item, true
+}
+
+// PeekFront returns the front element without removing it, or false if the deque is empty.
+func (d *Deque[T]) PeekFront() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return d.buf[d.head], true
+}
+
+// PeekBack returns the back element without removing it, or false if the deque is empty.
+func (d *Deque[T]) PeekBack() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return d.buf[(d.head+d.size-1)%len(d.buf)], true
+}
+
+// At returns the element at index i from the front, or false if i is out of range.
+func (d *Deque[T]) At(i int) (T, bool) {
+	if i < 0 || i >= d.size {
+		var zero T
+		return zero, false
+	}
+
+	return d.buf[(d.head+i)%len(d.buf)], true
+}
+
+// Len returns the number of elements.
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+// Cap returns the maximum number of elements, or zero if the deque is unbounded.
+func (d *Deque[T]) Cap() int {
+	return d.capacity
+}
+
+// Clear removes every element.
+func (d *Deque[T]) Clear() {
+	d.buf = nil
+	d.head = 0
+	d.size = 0
+}
+
+// Items returns the elements from front to back.
+func (d *Deque[T]) Items() []T {
+	items := make([]T, d.size)
+	for i := range items {
+		items[i] = d.buf[(d.head+i)%len(d.buf)]
+	}
+
+	return items
+}
+
+// reserve makes room for one more element.
+func (d *Deque[T]) reserve() error {
+	if d.capacity > 0 && d.size >= d.capacity {
+		return ErrFull
+	}
+
+	if d.size < len(d.buf) {
+		return nil
+	}
+
+	size := 2 * len(d.buf)
+	if size < minDequeSize {
+		size = minDequeSize
+	}
+
+	if d.capacity > 0 && size > d.capacity {
+		size = d.capacity
+	}
+
+	d.resize(size)
+
+	return nil
+}
+
+// shrink halves the buffer when it is mostly empty, so a deque that grew once does not hold its memory.
+func (d *Deque[T]) shrink() {
+	if len(d.buf) > minDequeSize && d.size <= len(d.buf)/4 {
+		d.resize(len(d.buf) / 2)
+	}
+}
+
+func (d *Deque[T]) resize(size int) {
+	buf := make([]T, size)
+	for i := 0; i < d.size; i++ {
+		buf[i] = d.buf[(d.head+i)%len(d.buf)]
+	}
+
+	d.buf = buf
+	d.head = 0
+}
@@ -0,0 +1,121 @@
+package ds
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDeque(t *testing.T) {
+	var d Deque[int]
+
+	if _, ok := d.PopFront(); ok {
+		t.Errorf("PopFront() on an empty deque = true")
+	}
+
+	if _, ok := d.PeekBack(); ok {
+		t.Errorf("PeekBack() on an empty deque = true")
+	}
+
+	for i := 1; i <= 3; i++ {
+		d.PushBack(i)
+	}
+	d.PushFront(0)
+
+	if got, want := d.Items(), []int{0, 1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Items() = %v, want %v", got, want)
+	}
+
+	if got, ok := d.At(2); !ok || got != 2 {
+		t.Errorf("At(2) = %v, %v, want 2, true", got, ok)
+	}
+
+	if _, ok := d.At(4); ok {
+		t.Errorf("At(4) = true, want out of range")
+	}
+
+	if got, _ := d.PeekFront(); got != 0 {
+		t.Errorf("PeekFront() = %v, want %v", got, 0)
+	}
+
+	if got, _ := d.PopBack(); got != 3 {
+		t.Errorf("PopBack() = %v, want %v", got, 3)
+	}
+
+	if got, _ := d.PopFront(); got != 0 {
+		t.Errorf("PopFront() = %v, want %v", got, 0)
+	}
+
+	if got := d.Len(); got != 2 {
+		t.Errorf("Len() = %v, want %v", got, 2)
+	}
+
+	d.Clear()
+	if got := d.Len(); got != 0 {
+		t.Errorf("Len() after Clear() = %v, want %v", got, 0)
+	}
+}
+
+func TestDequeGrowAndShrink(t *testing.T) {
+	d := NewDeque[int](0)
+
+	// wrap around the buffer from both ends while growing
+	for i := 0; i < 1000; i++ {
+		if i%2 == 0 {
+			d.PushBack(i)
+		} else {
+			d.PushFront(i)
+		}
+	}
+
+	if got := d.Len(); got != 1000 {
+		t.Fatalf("Len() = %v, want %v", got, 1000)
+	}
+
+	for i := 999; i >= 0; i-- {
+		var got int
+		if i%2 == 0 {
+			got, _ = d.PopBack()
+		} else {
+			got, _ = d.PopFront()
+		}
+
+		if got != i {
+			t.Fatalf("pop = %v, want %v", got, i)
+		}
+	}
+
+	if got := len(d.buf); got > minDequeSize {
+		t.Errorf("buffer size = %v after emptying, want at most %v", got, minDequeSize)
+	}
+}
+
+func TestDequeBounded(t *testing.T) {
+	d := NewDeque[string](2)
+
+	tests := []struct {
+		name    string
+		push    func(string) error
+		item    string
+		wantErr error
+	}{
+		{name: "success - push back", push: d.PushBack, item: "a"},
+		{name: "success - push front", push: d.PushFront, item: "b"},
+		{name: "fail - full", push: d.PushBack, item: "c", wantErr: ErrFull},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.push(tt.item); !errors.Is(err, tt.wantErr) {
+				t.Errorf("push() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if got, want := d.Items(), []string{"b", "a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Items() = %v, want %v", got, want)
+	}
+
+	if got := d.Cap(); got != 2 {
+		t.Errorf("Cap() = %v, want %v", got, 2)
+	}
+}
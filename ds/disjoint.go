@@ -0,0 +1,134 @@
+package ds
+
+// DisjointSet partitions elements into disjoint groups (union-find), with union by size and path
+// compression for nearly constant time operations. The zero value is empty and ready to use.
+// A DisjointSet is not safe for concurrent use.
+type DisjointSet[T comparable] struct {
+	parent map[T]T
+	size   map[T]int // size of the groups, by root
+	order  []T       // elements in insertion order
+}
+
+// NewDisjointSet creates a disjoint set where each element is in its own group.
+func NewDisjointSet[T comparable](items ...T) *DisjointSet[T] {
+	d := &DisjointSet[T]{}
+	for _, item := range items {
+		d.Add(item)
+	}
+
+	return d
+}
+
+// Add adds the element in its own group and reports whether it was missing.
+func (d *DisjointSet[T]) Add(item T) bool {
+	if d.parent == nil {
+		d.parent = make(map[T]T)
+		d.size = make(map[T]int)
+	}
+
+	if _, ok := d.parent[item]; ok {
+		return false
+	}
+
+	d.parent[item] = item
+	d.size[item] = 1
+	d.order = append(d.order, item)
+
+	return true
+}
+
+// Find returns the representative of the group of the element, or false if it was never added.
+func (d *DisjointSet[T]) Find(item T) (T, bool) {
+	if _, ok := d.parent[item]; !ok {
+		var zero T
+		return zero, false
+	}
+
+	return d.find(item), true
+}
+
+// Union merges the groups of a and b, adding them if missing, and reports whether they were in different groups.
+func (d *DisjointSet[T]) Union(a, b T) bool {
+	d.Add(a)
+	d.Add(b)
+
+	rootA, rootB := d.find(a), d.find(b)
+	if rootA == rootB {
+		return false
+	}
+
+	if d.size[rootA] < d.size[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+
+	d.parent[rootB] = rootA
+	d.size[rootA] += d.size[rootB]
+	delete(d.size, rootB)
+
+	return true
+}
+
+// Connected reports whether a and b are in the same group.
+func (d *DisjointSet[T]) Connected(a, b T) bool {
+	rootA, okA := d.Find(a)
+	rootB, okB := d.Find(b)
+
+	return okA && okB && rootA == rootB
+}
+
+// GroupSize returns the number of elements in the group of the element, or 0 if it was never added.
+func (d *DisjointSet[T]) GroupSize(item T) int {
+	root, ok := d.Find(item)
+	if !ok {
+		return 0
+	}
+
+	return d.size[root]
+}
+
+// Len returns the number of elements.
+func (d *DisjointSet[T]) Len() int {
+	return len(d.parent)
+}
+
+// Count returns the number of groups.
+func (d *DisjointSet[T]) Count() int {
+	return len(d.size)
+}
+
+// Groups returns the groups, ordered by their first added element, each one listing its elements
+// in the order they were added.
+func (d *DisjointSet[T]) Groups() [][]T {
+	index := make(map[T]int, len(d.size))
+	groups := make([][]T, 0, len(d.size))
+	for _, item := range d.order {
+		root := d.find(item)
+
+		i, ok := index[root]
+		if !ok {
+			i = len(groups)
+			index[root] = i
+			groups = append(groups, make([]T, 0, d.size[root]))
+		}
+
+		groups[i] = append(groups[i], item)
+	}
+
+	return groups
+}
+
+// find returns the root of the element, which must be present, compressing the path to it.
+func (d *DisjointSet[T]) find(item T) T {
+	root := item
+	for d.parent[root] != root {
+		root = d.parent[root]
+	}
+
+	for item != root {
+		next := d.parent[item]
+		d.parent[item] = root
+		item = next
+	}
+
+	return root
+}
@@ -0,0 +1,76 @@
+package ds
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDisjointSet(t *testing.T) {
+	d := NewDisjointSet("a", "b", "c", "d", "e")
+
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{name: "success - merge singletons", a: "a", b: "b", want: true},
+		{name: "success - merge groups", a: "c", b: "d", want: true},
+		{name: "success - merge larger groups", a: "b", b: "d", want: true},
+		{name: "success - adds missing elements", a: "f", b: "e", want: true},
+		{name: "fail - already connected", a: "a", b: "c", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := d.Union(tt.a, tt.b); got != tt.want {
+				t.Errorf("Union() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if !d.Connected("a", "d") || d.Connected("a", "e") || d.Connected("a", "missing") {
+		t.Errorf("Connected() returned wrong results")
+	}
+
+	rootA, _ := d.Find("a")
+	rootC, _ := d.Find("c")
+	if rootA != rootC {
+		t.Errorf("Find() = %v, %v, want the same representative", rootA, rootC)
+	}
+
+	if _, ok := d.Find("missing"); ok {
+		t.Errorf("Find() of a missing element = true")
+	}
+
+	if got := d.GroupSize("b"); got != 4 {
+		t.Errorf("GroupSize() = %v, want %v", got, 4)
+	}
+
+	if d.Len() != 6 || d.Count() != 2 {
+		t.Errorf("Len(), Count() = %v, %v, want 6, 2", d.Len(), d.Count())
+	}
+
+	want := [][]string{{"a", "b", "c", "d"}, {"e", "f"}}
+	if got := d.Groups(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Groups() = %v, want %v", got, want)
+	}
+}
+
+func TestDisjointSetZeroValue(t *testing.T) {
+	var d DisjointSet[int]
+
+	if got := d.Groups(); len(got) != 0 {
+		t.Errorf("Groups() = %v, want none", got)
+	}
+
+	if !d.Add(1) || d.Add(1) {
+		t.Errorf("Add() did not add the element once")
+	}
+
+	for i := 2; i <= 1000; i++ {
+		d.Union(i-1, i)
+	}
+
+	if d.Count() != 1 || d.GroupSize(500) != 1000 {
+		t.Errorf("Count(), GroupSize() = %v, %v, want 1, 1000", d.Count(), d.GroupSize(500))
+	}
+}
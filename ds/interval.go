@@ -0,0 +1,275 @@
+package ds
+
+import (
+	"fmt"
+
+	"github.com/kashifkhan0771/utils/slice"
+)
+
+// Interval is a half-open interval [Start, End) holding a value.
+type Interval[K slice.Ordered, V any] struct {
+	Start K
+	End   K
+	Value V
+}
+
+// IntervalTree stores intervals for overlap queries in O(log n + k), k being the number of matches.
+// It is a balanced tree keeping the maximum end of each subtree. The zero value is an empty tree ready to use.
+// An IntervalTree is not safe for concurrent use.
+type IntervalTree[K slice.Ordered, V any] struct {
+	root *intervalNode[K, V]
+	size int
+}
+
+type intervalNode[K slice.Ordered, V any] struct {
+	interval    Interval[K, V]
+	maxEnd      K
+	height      int
+	left, right *intervalNode[K, V]
+}
+
+// NewIntervalTree creates an empty interval tree.
+func NewIntervalTree[K slice.Ordered, V any]() *IntervalTree[K, V] {
+	return &IntervalTree[K, V]{}
+}
+
+// Insert adds the interval [start, end) with its value. It returns an error if the interval is empty.
+func (t *IntervalTree[K, V]) Insert(start, end K, value V) error {
+	if !(start < end) {
+		return fmt.Errorf("interval [%v, %v) is empty", start, end)
+	}
+
+	t.root = t.root.insert(Interval[K, V]{Start: start, End: end, Value: value})
+	t.size++
+
+	return nil
+}
+
+// Delete removes one interval with the given bounds and reports whether one was found.
+func (t *IntervalTree[K, V]) Delete(start, end K) bool {
+	var deleted bool
+	t.root, deleted = t.root.delete(start, end)
+	if deleted {
+		t.size--
+	}
+
+	return deleted
+}
+
+// Query returns the intervals containing point, sorted by start then insertion order.
+func (t *IntervalTree[K, V]) Query(point K) []Interval[K, V] {
+	result := make([]Interval[K, V], 0)
+	t.root.search(
+		func(maxEnd K) bool { return point < maxEnd },
+		func(start K) bool { return !(point < start) },
+		func(i Interval[K, V]) bool { return !(point < i.Start) && point < i.End },
+		&result,
+	)
+
+	return result
+}
+
+// QueryRange returns the intervals overlapping [start, end), sorted by start then insertion order.
+func (t *IntervalTree[K, V]) QueryRange(start, end K) []Interval[K, V] {
+	result := make([]Interval[K, V], 0)
+	t.root.search(
+		func(maxEnd K) bool { return start < maxEnd },
+		func(s K) bool { return s < end },
+		func(i Interval[K, V]) bool { return i.Start < end && start < i.End },
+		&result,
+	)
+
+	return result
+}
+
+// Overlaps reports whether any interval overlaps [start, end).
+func (t *IntervalTree[K, V]) Overlaps(start, end K) bool {
+	for n := t.root; n != nil; {
+		if n.interval.Start < end && start < n.interval.End {
+			return true
+		}
+
+		if n.left != nil && start < n.left.maxEnd {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+
+	return false
+}
+
+// Len returns the number of intervals.
+func (t *IntervalTree[K, V]) Len() int {
+	return t.size
+}
+
+// All returns every interval sorted by start.
+func (t *IntervalTree[K, V]) All() []Interval[K, V] {
+	result := make([]Interval[K, V], 0, t.size)
+	t.root.search(
+		func(K) bool { return true },
+		func(K) bool { return true },
+		func(Interval[K, V]) bool { return true },
+		&result,
+	)
+
+	return result
+}
+
+// search appends the matching intervals in order. A subtree is skipped when its maximum end fails endOK,
+// and the right side when the start of the node fails startOK, since starts only grow to the right.
+func (n *intervalNode[K, V]) search(endOK, startOK func(K) bool, match func(Interval[K, V]) bool,
+	result *[]Interval[K, V]) {
+	if n == nil || !endOK(n.maxEnd) {
+		return
+	}
+
+	n.left.search(endOK, startOK, match, result)
+
+	if !startOK(n.interval.Start) {
+		return
+	}
+
+	if match(n.interval) {
+		*result = append(*result, n.interval)
+	}
+
+	n.right.search(endOK, startOK, match, result)
+}
+
+func (n *intervalNode[K, V]) insert(interval Interval[K, V]) *intervalNode[K, V] {
+	if n == nil {
+		return &intervalNode[K, V]{interval: interval, maxEnd: interval.End, height: 1}
+	}
+
+	if interval.Start < n.interval.Start {
+		n.left = n.left.insert(interval)
+	} else {
+		n.right = n.right.insert(interval)
+	}
+
+	return n.balance()
+}
+
+func (n *intervalNode[K, V]) delete(start, end K) (*intervalNode[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var deleted bool
+	switch {
+	case start < n.interval.Start:
+		n.left, deleted = n.left.delete(start, end)
+	case n.interval.Start < start:
+		n.right, deleted = n.right.delete(start, end)
+	case n.interval.End != end:
+		// intervals with the same start may be on both sides after rotations
+		if n.left, deleted = n.left.delete(start, end); !deleted {
+			n.right, deleted = n.right.delete(start, end)
+		}
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+
+		if n.right == nil {
+			return n.left, true
+		}
+
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+
+		n.interval = successor.interval
+		n.right = n.right.deleteMin()
+		deleted = true
+	}
+
+	if !deleted {
+		return n, false
+	}
+
+	return n.balance(), true
+}
+
+func (n *intervalNode[K, V]) deleteMin() *intervalNode[K, V] {
+	if n.left == nil {
+		return n.right
+	}
+
+	n.left = n.left.deleteMin()
+
+	return n.balance()
+}
+
+// balance updates the height and maximum end of n and restores the AVL balance.
+func (n *intervalNode[K, V]) balance() *intervalNode[K, V] {
+	n.update()
+
+	switch factor := n.left.h() - n.right.h(); {
+	case factor > 1:
+		if n.left.left.h() < n.left.right.h() {
+			n.left = n.left.rotateLeft()
+		}
+
+		return n.rotateRight()
+	case factor < -1:
+		if n.right.right.h() < n.right.left.h() {
+			n.right = n.right.rotateRight()
+		}
+
+		return n.rotateLeft()
+	default:
+		return n
+	}
+}
+
+func (n *intervalNode[K, V]) rotateLeft() *intervalNode[K, V] {
+	pivot := n.right
+	n.right = pivot.left
+	pivot.left = n
+	n.update()
+	pivot.update()
+
+	return pivot
+}
+
+func (n *intervalNode[K, V]) rotateRight() *intervalNode[K, V] {
+	pivot := n.left
+	n.left = pivot.right
+	pivot.right = n
+	n.update()
+	pivot.update()
+
+	return pivot
+}
+
+func (n *intervalNode[K, V]) update() {
+	n.height = 1 + maxHeight(n.left.h(), n.right.h())
+	n.maxEnd = n.interval.End
+	if n.left != nil && n.maxEnd < n.left.maxEnd {
+		n.maxEnd = n.left.maxEnd
+	}
+
+	if n.right != nil && n.maxEnd < n.right.maxEnd {
+		n.maxEnd = n.right.maxEnd
+	}
+}
+
+func (n *intervalNode[K, V]) h() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.height
+}
+
+func maxHeight(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
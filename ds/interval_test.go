@@ -0,0 +1,125 @@
+package ds
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func intervalValues(intervals []Interval[int, string]) []string {
+	values := make([]string, 0, len(intervals))
+	for _, i := range intervals {
+		values = append(values, i.Value)
+	}
+
+	return values
+}
+
+func TestIntervalTree(t *testing.T) {
+	var tree IntervalTree[int, string]
+	for _, i := range []Interval[int, string]{
+		{9, 12, "standup"}, {10, 11, "review"}, {13, 15, "lunch"}, {14, 18, "workshop"}, {9, 17, "office"},
+	} {
+		if err := tree.Insert(i.Start, i.End, i.Value); err != nil {
+			t.Fatalf("Insert() error = %v", err)
+		}
+	}
+
+	if err := tree.Insert(5, 5, "empty"); err == nil {
+		t.Errorf("Insert() expected error for an empty interval")
+	}
+
+	tests := []struct {
+		name  string
+		query func() []Interval[int, string]
+		want  []string
+	}{
+		{name: "success - point", query: func() []Interval[int, string] { return tree.Query(10) }, want: []string{"standup", "office", "review"}},
+		{name: "success - point at an end", query: func() []Interval[int, string] { return tree.Query(12) }, want: []string{"office"}},
+		{name: "success - range", query: func() []Interval[int, string] { return tree.QueryRange(12, 14) }, want: []string{"office", "lunch"}},
+		{name: "success - range touching an end", query: func() []Interval[int, string] { return tree.QueryRange(18, 20) }, want: []string{}},
+		{name: "fail - point before all", query: func() []Interval[int, string] { return tree.Query(1) }, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := intervalValues(tt.query()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("query = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if !tree.Overlaps(16, 20) || tree.Overlaps(18, 20) {
+		t.Errorf("Overlaps() = %v, %v, want true, false", tree.Overlaps(16, 20), tree.Overlaps(18, 20))
+	}
+
+	if !tree.Delete(9, 17) || tree.Delete(9, 17) || tree.Delete(1, 2) {
+		t.Errorf("Delete() found missing intervals")
+	}
+
+	if got, want := intervalValues(tree.All()), []string{"standup", "review", "lunch", "workshop"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+
+	if got := tree.Len(); got != 4 {
+		t.Errorf("Len() = %v, want %v", got, 4)
+	}
+}
+
+func TestIntervalTreeRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tree := NewIntervalTree[int, int]()
+	var intervals []Interval[int, int]
+
+	for i := 0; i < 2000; i++ {
+		if len(intervals) > 0 && r.Intn(4) == 0 {
+			j := r.Intn(len(intervals))
+			if !tree.Delete(intervals[j].Start, intervals[j].End) {
+				t.Fatalf("Delete(%v, %v) = false", intervals[j].Start, intervals[j].End)
+			}
+			intervals = append(intervals[:j], intervals[j+1:]...)
+
+			continue
+		}
+
+		start := r.Intn(1000)
+		interval := Interval[int, int]{start, start + 1 + r.Intn(50), i}
+		tree.Insert(interval.Start, interval.End, interval.Value)
+		intervals = append(intervals, interval)
+	}
+
+	for q := 0; q < 200; q++ {
+		start := r.Intn(1100)
+		end := start + 1 + r.Intn(30)
+
+		var want []int
+		for _, i := range intervals {
+			if i.Start < end && start < i.End {
+				want = append(want, i.Start*100000+i.End)
+			}
+		}
+
+		var got []int
+		for _, i := range tree.QueryRange(start, end) {
+			got = append(got, i.Start*100000+i.End)
+		}
+
+		sort.Ints(want)
+		sort.Ints(got)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("QueryRange(%v, %v) = %v, want %v", start, end, got, want)
+		}
+
+		if tree.Overlaps(start, end) != (len(want) > 0) {
+			t.Fatalf("Overlaps(%v, %v) = %v, want %v", start, end, !(len(want) > 0), len(want) > 0)
+		}
+	}
+
+	if tree.Len() != len(intervals) {
+		t.Errorf("Len() = %v, want %v", tree.Len(), len(intervals))
+	}
+
+	if h := tree.root.h(); h > 15 {
+		t.Errorf("height = %v, the tree is not balanced", h)
+	}
+}
@@ -0,0 +1,235 @@
+package ds
+
+import "reflect"
+
+// Multimap maps each key to a list of values. The zero value is an empty multimap ready to use.
+// A Multimap is not safe for concurrent use.
+type Multimap[K comparable, V any] struct {
+	entries map[K][]V
+	size    int
+}
+
+// NewMultimap creates an empty multimap.
+func NewMultimap[K comparable, V any]() *Multimap[K, V] {
+	return &Multimap[K, V]{}
+}
+
+// Add appends the values to the list of key.
+func (m *Multimap[K, V]) Add(key K, values ...V) {
+	if len(values) == 0 {
+		return
+	}
+
+	if m.entries == nil {
+		m.entries = make(map[K][]V)
+	}
+
+	m.entries[key] = append(m.entries[key], values...)
+	m.size += len(values)
+}
+
+// Get returns a copy of the values of key, in the order they were added.
+func (m *Multimap[K, V]) Get(key K) []V {
+	values := make([]V, len(m.entries[key]))
+	copy(values, m.entries[key])
+
+	return values
+}
+
+// Has reports whether key has values.
+func (m *Multimap[K, V]) Has(key K) bool {
+	_, ok := m.entries[key]
+	return ok
+}
+
+// RemoveValue removes every occurrence of value from the list of key, compared with reflect.DeepEqual,
+// and reports whether one was found.
+func (m *Multimap[K, V]) RemoveValue(key K, value V) bool {
+	return m.RemoveFunc(key, func(v V) bool { return reflect.DeepEqual(v, value) }) > 0
+}
+
+// RemoveFunc removes the values of key for which fn returns true and returns how many were removed.
+func (m *Multimap[K, V]) RemoveFunc(key K, fn func(value V) bool) int {
+	values, ok := m.entries[key]
+	if !ok {
+		return 0
+	}
+
+	kept := values[:0]
+	for _, value := range values {
+		if !fn(value) {
+			kept = append(kept, value)
+		}
+	}
+
+	removed := len(values) - len(kept)
+	var zero V
+	for i := len(kept); i < len(values); i++ {
+		values[i] = zero
+	}
+
+	if len(kept) == 0 {
+		delete(m.entries, key)
+	} else {
+		m.entries[key] = kept
+	}
+
+	m.size -= removed
+
+	return removed
+}
+
+// Delete removes key with all its values.
+func (m *Multimap[K, V]) Delete(key K) {
+	m.size -= len(m.entries[key])
+	delete(m.entries, key)
+}
+
+// Keys returns the keys with values, in no particular order.
+func (m *Multimap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.entries))
+	for key := range m.entries {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// Len returns the number of keys.
+func (m *Multimap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+// Size returns the total number of values.
+func (m *Multimap[K, V]) Size() int {
+	return m.size
+}
+
+// SetMultimap maps each key to a set of values, ignoring duplicates. The zero value is an empty multimap
+// ready to use. A SetMultimap is not safe for concurrent use.
+type SetMultimap[K, V comparable] struct {
+	entries map[K]*valueSet[V]
+	size    int
+}
+
+// valueSet is a set of values keeping their insertion order.
+type valueSet[V comparable] struct {
+	index  map[V]int
+	values []V
+}
+
+// NewSetMultimap creates an empty set multimap.
+func NewSetMultimap[K, V comparable]() *SetMultimap[K, V] {
+	return &SetMultimap[K, V]{}
+}
+
+// Add adds the values missing from the set of key.
+func (m *SetMultimap[K, V]) Add(key K, values ...V) {
+	if len(values) == 0 {
+		return
+	}
+
+	if m.entries == nil {
+		m.entries = make(map[K]*valueSet[V])
+	}
+
+	set, ok := m.entries[key]
+	if !ok {
+		set = &valueSet[V]{index: make(map[V]int)}
+		m.entries[key] = set
+	}
+
+	for _, value := range values {
+		if _, ok := set.index[value]; !ok {
+			set.index[value] = len(set.values)
+			set.values = append(set.values, value)
+			m.size++
+		}
+	}
+}
+
+// Get returns a copy of the values of key, in the order they were first added.
+func (m *SetMultimap[K, V]) Get(key K) []V {
+	set, ok := m.entries[key]
+	if !ok {
+		return []V{}
+	}
+
+	values := make([]V, len(set.values))
+	copy(values, set.values)
+
+	return values
+}
+
+// Has reports whether key has values.
+func (m *SetMultimap[K, V]) Has(key K) bool {
+	_, ok := m.entries[key]
+	return ok
+}
+
+// Contains reports whether value is in the set of key.
+func (m *SetMultimap[K, V]) Contains(key K, value V) bool {
+	set, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+
+	_, ok = set.index[value]
+
+	return ok
+}
+
+// RemoveValue removes value from the set of key and reports whether it was found.
+func (m *SetMultimap[K, V]) RemoveValue(key K, value V) bool {
+	set, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+
+	i, ok := set.index[value]
+	if !ok {
+		return false
+	}
+
+	set.values = append(set.values[:i], set.values[i+1:]...)
+	delete(set.index, value)
+	for j := i; j < len(set.values); j++ {
+		set.index[set.values[j]] = j
+	}
+
+	if len(set.values) == 0 {
+		delete(m.entries, key)
+	}
+
+	m.size--
+
+	return true
+}
+
+// Delete removes key with all its values.
+func (m *SetMultimap[K, V]) Delete(key K) {
+	if set, ok := m.entries[key]; ok {
+		m.size -= len(set.values)
+		delete(m.entries, key)
+	}
+}
+
+// Keys returns the keys with values, in no particular order.
+func (m *SetMultimap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.entries))
+	for key := range m.entries {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// Len returns the number of keys.
+func (m *SetMultimap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+// Size returns the total number of values.
+func (m *SetMultimap[K, V]) Size() int {
+	return m.size
+}
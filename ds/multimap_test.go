@@ -0,0 +1,115 @@
+package ds
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestMultimap(t *testing.T) {
+	var m Multimap[string, []string]
+
+	m.Add("alice", []string{"admin"}, []string{"dev"})
+	m.Add("alice", []string{"admin"})
+	m.Add("bob", []string{"ops"})
+	m.Add("carol")
+
+	if m.Has("carol") {
+		t.Errorf("Add() without values added the key")
+	}
+
+	if m.Len() != 2 || m.Size() != 4 {
+		t.Errorf("Len(), Size() = %v, %v, want 2, 4", m.Len(), m.Size())
+	}
+
+	// Get returns a copy
+	values := m.Get("alice")
+	values[0] = nil
+	if got := m.Get("alice"); got[0] == nil {
+		t.Errorf("Get() returned the internal slice")
+	}
+
+	tests := []struct {
+		name  string
+		key   string
+		value []string
+		want  bool
+	}{
+		{name: "success - every occurrence", key: "alice", value: []string{"admin"}, want: true},
+		{name: "fail - missing value", key: "alice", value: []string{"admin"}, want: false},
+		{name: "fail - missing key", key: "dave", value: []string{"admin"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := m.RemoveValue(tt.key, tt.value); got != tt.want {
+				t.Errorf("RemoveValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got, want := m.Get("alice"), [][]string{{"dev"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Get() = %v, want %v", got, want)
+	}
+
+	if got := m.RemoveFunc("alice", func([]string) bool { return true }); got != 1 {
+		t.Errorf("RemoveFunc() = %v, want %v", got, 1)
+	}
+
+	if m.Has("alice") {
+		t.Errorf("RemoveFunc() kept a key without values")
+	}
+
+	m.Delete("bob")
+	if m.Len() != 0 || m.Size() != 0 || len(m.Get("bob")) != 0 {
+		t.Errorf("Delete() kept values")
+	}
+}
+
+func TestSetMultimap(t *testing.T) {
+	m := NewSetMultimap[string, string]()
+
+	m.Add("go", "cache", "sync", "cache")
+	m.Add("go", "sync", "ds")
+	m.Add("rust", "tokio")
+
+	if got, want := m.Get("go"), []string{"cache", "sync", "ds"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Get() = %v, want %v", got, want)
+	}
+
+	if !m.Contains("go", "ds") || m.Contains("go", "tokio") || m.Contains("java", "ds") {
+		t.Errorf("Contains() returned wrong results")
+	}
+
+	if m.Len() != 2 || m.Size() != 4 {
+		t.Errorf("Len(), Size() = %v, %v, want 2, 4", m.Len(), m.Size())
+	}
+
+	if !m.RemoveValue("go", "cache") || m.RemoveValue("go", "cache") || m.RemoveValue("java", "x") {
+		t.Errorf("RemoveValue() did not remove the value once")
+	}
+
+	if got, want := m.Get("go"), []string{"sync", "ds"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Get() = %v, want %v", got, want)
+	}
+
+	// the positions are kept consistent after removals
+	if !m.RemoveValue("go", "ds") || !m.Contains("go", "sync") {
+		t.Errorf("RemoveValue() broke the set")
+	}
+
+	m.RemoveValue("rust", "tokio")
+	keys := m.Keys()
+	sort.Strings(keys)
+	if want := []string{"go"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Keys() = %v, want %v", keys, want)
+	}
+
+	m.Delete("go")
+	if m.Len() != 0 || m.Size() != 0 || m.Has("go") {
+		t.Errorf("Delete() kept values")
+	}
+
+	if got := m.Get("go"); len(got) != 0 {
+		t.Errorf("Get() = %v, want none", got)
+	}
+}
@@ -0,0 +1,230 @@
+package ds
+
+// PriorityQueue is a binary heap returning its elements in the order defined by a less function,
+// the least element first. The zero value is not usable, see NewPriorityQueue. A PriorityQueue is not
+// safe for concurrent use.
+type PriorityQueue[T any] struct {
+	less  func(a, b T) bool
+	heaps [2][]*Handle[T] // least element first, then greatest element first on bounded queues only
+	limit int             // maximum number of elements, unbounded if zero
+}
+
+// Handle refers to an element of a PriorityQueue, to update or remove it.
+type Handle[T any] struct {
+	value T
+	index [2]int // position in each heap, -1 in the first once removed
+}
+
+// Indexes of the heaps of a PriorityQueue.
+const (
+	leastFirst    = 0
+	greatestFirst = 1
+)
+
+// Value returns the element.
+func (h *Handle[T]) Value() T {
+	return h.value
+}
+
+// NewPriorityQueue creates an unbounded priority queue ordered by less.
+func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{less: less}
+}
+
+// NewBoundedPriorityQueue creates a priority queue keeping only the limit least elements by less,
+// for instance the top N scores with a less ranking higher scores first. Pushing on a full queue
+// drops the greatest element, found in O(log n) with a second heap of the elements.
+func NewBoundedPriorityQueue[T any](less func(a, b T) bool, limit int) *PriorityQueue[T] {
+	if limit < 0 {
+		limit = 0
+	}
+
+	return &PriorityQueue[T]{less: less, limit: limit}
+}
+
+// Push adds the element and returns its handle. On a full bounded queue, the greatest element is dropped
+// to make room, or nil is returned if the element itself is not less than it. It runs in O(log n).
+func (q *PriorityQueue[T]) Push(value T) *Handle[T] {
+	if q.limit > 0 && len(q.heaps[leastFirst]) >= q.limit {
+		greatest := q.heaps[greatestFirst][0]
+		if !q.less(value, greatest.value) {
+			return nil
+		}
+
+		q.Remove(greatest)
+	}
+
+	h := &Handle[T]{value: value}
+	for k := 0; k < q.heapCount(); k++ {
+		h.index[k] = len(q.heaps[k])
+		q.heaps[k] = append(q.heaps[k], h)
+		q.up(k, h.index[k])
+	}
+
+	return h
+}
+
+// Pop removes and returns the least element, or false if the queue is empty.
+func (q *PriorityQueue[T]) Pop() (T, bool) {
+	if len(q.heaps[leastFirst]) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	h := q.heaps[leastFirst][0]
+	q.Remove(h)
+
+	return h.value, true
+}
+
+// Peek returns the least element without removing it, or false if the queue is empty.
+func (q *PriorityQueue[T]) Peek() (T, bool) {
+	if len(q.heaps[leastFirst]) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return q.heaps[leastFirst][0].value, true
+}
+
+// Update replaces the element of the handle and restores the order. It returns false if the element
+// was removed from the queue.
+func (q *PriorityQueue[T]) Update(h *Handle[T], value T) bool {
+	if !q.owns(h) {
+		return false
+	}
+
+	h.value = value
+	for k := 0; k < q.heapCount(); k++ {
+		q.fix(k, h.index[k])
+	}
+
+	return true
+}
+
+// Remove removes the element of the handle. It returns false if it was already removed.
+func (q *PriorityQueue[T]) Remove(h *Handle[T]) bool {
+	if !q.owns(h) {
+		return false
+	}
+
+	for k := 0; k < q.heapCount(); k++ {
+		i, last := h.index[k], len(q.heaps[k])-1
+		q.swap(k, i, last)
+		q.heaps[k][last] = nil
+		q.heaps[k] = q.heaps[k][:last]
+
+		if i < last {
+			q.fix(k, i)
+		}
+	}
+	h.index[leastFirst] = -1
+
+	return true
+}
+
+// Len returns the number of elements.
+func (q *PriorityQueue[T]) Len() int {
+	return len(q.heaps[leastFirst])
+}
+
+// Clear removes every element.
+func (q *PriorityQueue[T]) Clear() {
+	for _, h := range q.heaps[leastFirst] {
+		h.index[leastFirst] = -1
+	}
+
+	q.heaps = [2][]*Handle[T]{}
+}
+
+// Sorted returns the elements from the least to the greatest without removing them.
+func (q *PriorityQueue[T]) Sorted() []T {
+	clone := &PriorityQueue[T]{less: q.less}
+	clone.heaps[leastFirst] = make([]*Handle[T], len(q.heaps[leastFirst]))
+	for i, h := range q.heaps[leastFirst] {
+		clone.heaps[leastFirst][i] = &Handle[T]{value: h.value, index: [2]int{i}}
+	}
+
+	sorted := make([]T, 0, clone.Len())
+	for clone.Len() > 0 {
+		value, _ := clone.Pop()
+		sorted = append(sorted, value)
+	}
+
+	return sorted
+}
+
+func (q *PriorityQueue[T]) owns(h *Handle[T]) bool {
+	if h == nil {
+		return false
+	}
+
+	i := h.index[leastFirst]
+
+	return i >= 0 && i < len(q.heaps[leastFirst]) && q.heaps[leastFirst][i] == h
+}
+
+// heapCount returns the number of heaps kept by the queue, the second one only on bounded queues.
+func (q *PriorityQueue[T]) heapCount() int {
+	if q.limit > 0 {
+		return 2
+	}
+
+	return 1
+}
+
+// before reports whether a goes before b in the heap k.
+func (q *PriorityQueue[T]) before(k int, a, b *Handle[T]) bool {
+	if k == greatestFirst {
+		return q.less(b.value, a.value)
+	}
+
+	return q.less(a.value, b.value)
+}
+
+func (q *PriorityQueue[T]) fix(k, i int) {
+	if !q.down(k, i) {
+		q.up(k, i)
+	}
+}
+
+func (q *PriorityQueue[T]) up(k, i int) {
+	heap := q.heaps[k]
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.before(k, heap[i], heap[parent]) {
+			return
+		}
+
+		q.swap(k, i, parent)
+		i = parent
+	}
+}
+
+// down moves the element at i of the heap k down and reports whether it moved.
+func (q *PriorityQueue[T]) down(k, i int) bool {
+	heap := q.heaps[k]
+	start := i
+	for {
+		first := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(heap) && q.before(k, heap[child], heap[first]) {
+				first = child
+			}
+		}
+
+		if first == i {
+			return i != start
+		}
+
+		q.swap(k, i, first)
+		i = first
+	}
+}
+
+func (q *PriorityQueue[T]) swap(k, i, j int) {
+	heap := q.heaps[k]
+	heap[i], heap[j] = heap[j], heap[i]
+	heap[i].index[k] = i
+	heap[j].index[k] = j
+}
@@ -0,0 +1,176 @@
+package ds
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func intLess(a, b int) bool { return a < b }
+
+func TestPriorityQueue(t *testing.T) {
+	q := NewPriorityQueue(intLess)
+	if _, ok := q.Pop(); ok {
+		t.Errorf("Pop() on an empty queue = true")
+	}
+
+	r := rand.New(rand.NewSource(1))
+	values := make([]int, 200)
+	for i := range values {
+		values[i] = r.Intn(50)
+		q.Push(values[i])
+	}
+	sort.Ints(values)
+
+	if got, _ := q.Peek(); got != values[0] {
+		t.Errorf("Peek() = %v, want %v", got, values[0])
+	}
+
+	if got := q.Sorted(); !reflect.DeepEqual(got, values) {
+		t.Errorf("Sorted() = %v, want %v", got, values)
+	}
+
+	for _, want := range values {
+		if got, ok := q.Pop(); !ok || got != want {
+			t.Fatalf("Pop() = %v, %v, want %v, true", got, ok, want)
+		}
+	}
+}
+
+func TestPriorityQueueHandles(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+
+	q := NewPriorityQueue(func(a, b task) bool { return a.priority > b.priority })
+	low := q.Push(task{"low", 1})
+	mid := q.Push(task{"mid", 5})
+	q.Push(task{"high", 10})
+
+	if !q.Update(low, task{"low", 20}) {
+		t.Errorf("Update() = false")
+	}
+
+	if !q.Remove(mid) {
+		t.Errorf("Remove() = false")
+	}
+
+	if q.Remove(mid) || q.Update(mid, task{}) {
+		t.Errorf("Remove(), Update() of a removed handle = true")
+	}
+
+	if got := low.Value().priority; got != 20 {
+		t.Errorf("Value() = %v, want %v", got, 20)
+	}
+
+	var got []string
+	for q.Len() > 0 {
+		next, _ := q.Pop()
+		got = append(got, next.name)
+	}
+
+	if want := []string{"low", "high"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Pop() order = %v, want %v", got, want)
+	}
+
+	h := q.Push(task{"other", 1})
+	q.Clear()
+	if q.Len() != 0 || q.Remove(h) {
+		t.Errorf("Clear() kept elements")
+	}
+}
+
+func TestBoundedPriorityQueue(t *testing.T) {
+	// keep the 3 highest scores
+	q := NewBoundedPriorityQueue(func(a, b int) bool { return a > b }, 3)
+
+	tests := []struct {
+		name     string
+		score    int
+		wantKept bool
+	}{
+		{name: "success - room left", score: 5, wantKept: true},
+		{name: "success - room left again", score: 1, wantKept: true},
+		{name: "success - filled", score: 3, wantKept: true},
+		{name: "success - replaces the lowest", score: 9, wantKept: true},
+		{name: "fail - lower than all", score: 2, wantKept: false},
+		{name: "fail - equal to the lowest", score: 3, wantKept: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := q.Push(tt.score) != nil; got != tt.wantKept {
+				t.Errorf("Push() kept = %v, want %v", got, tt.wantKept)
+			}
+		})
+	}
+
+	if got, want := q.Sorted(), []int{9, 5, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sorted() = %v, want %v", got, want)
+	}
+}
+
+func TestBoundedPriorityQueueRandom(t *testing.T) {
+	const limit = 10
+
+	rng := rand.New(rand.NewSource(1))
+	q := NewBoundedPriorityQueue(intLess, limit)
+
+	// kept mirrors the queue: the handles of the least elements, sorted
+	var kept []*Handle[int]
+	for i := 0; i < 2000; i++ {
+		switch op := rng.Intn(10); {
+		case op < 7 || len(kept) == 0:
+			value := rng.Intn(500)
+			h := q.Push(value)
+
+			wantKept := len(kept) < limit || value < kept[len(kept)-1].Value()
+			if (h != nil) != wantKept {
+				t.Fatalf("Push(%v) kept = %v, want %v", value, h != nil, wantKept)
+			}
+
+			if h == nil {
+				continue
+			}
+
+			// among equal greatest elements, drop the one the queue dropped
+			for j, other := range kept {
+				if !q.owns(other) {
+					kept = append(kept[:j], kept[j+1:]...)
+					break
+				}
+			}
+			kept = append(kept, h)
+		case op < 9:
+			h := kept[rng.Intn(len(kept))]
+			q.Update(h, rng.Intn(500))
+		default:
+			h := kept[rng.Intn(len(kept))]
+			q.Remove(h)
+			kept = removeHandle(kept, h)
+		}
+
+		sort.SliceStable(kept, func(a, b int) bool { return kept[a].Value() < kept[b].Value() })
+
+		want := make([]int, len(kept))
+		for j, h := range kept {
+			want[j] = h.Value()
+		}
+
+		if got := q.Sorted(); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Sorted() = %v, want %v", got, want)
+		}
+	}
+}
+
+// removeHandle removes h from handles.
+func removeHandle(handles []*Handle[int], h *Handle[int]) []*Handle[int] {
+	for i, other := range handles {
+		if other == h {
+			return append(handles[:i], handles[i+1:]...)
+		}
+	}
+
+	return handles
+}
@@ -0,0 +1,47 @@
+package ds
+
+// Queue is a first-in first-out queue with amortized O(1) operations.
+// The zero value is an empty unbounded queue ready to use. A Queue is not safe for concurrent use.
+type Queue[T any] struct {
+	items Deque[T]
+}
+
+// NewQueue creates a queue holding up to capacity elements, or unbounded if capacity is zero or negative.
+func NewQueue[T any](capacity int) *Queue[T] {
+	return &Queue[T]{items: *NewDeque[T](capacity)}
+}
+
+// Push adds the element at the back. It returns ErrFull if the queue is bounded and full.
+func (q *Queue[T]) Push(item T) error {
+	return q.items.PushBack(item)
+}
+
+// Pop removes and returns the front element, or false if the queue is empty.
+func (q *Queue[T]) Pop() (T, bool) {
+	return q.items.PopFront()
+}
+
+// Peek returns the front element without removing it, or false if the queue is empty.
+func (q *Queue[T]) Peek() (T, bool) {
+	return q.items.PeekFront()
+}
+
+// Len returns the number of elements.
+func (q *Queue[T]) Len() int {
+	return q.items.Len()
+}
+
+// Cap returns the maximum number of elements, or zero if the queue is unbounded.
+func (q *Queue[T]) Cap() int {
+	return q.items.Cap()
+}
+
+// Clear removes every element.
+func (q *Queue[T]) Clear() {
+	q.items.Clear()
+}
+
+// Items returns the elements from front to back.
+func (q *Queue[T]) Items() []T {
+	return q.items.Items()
+}
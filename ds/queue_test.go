@@ -0,0 +1,57 @@
+package ds
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestQueue(t *testing.T) {
+	var q Queue[int]
+
+	for i := 0; i < 100; i++ {
+		q.Push(i)
+	}
+
+	if got, _ := q.Peek(); got != 0 {
+		t.Errorf("Peek() = %v, want %v", got, 0)
+	}
+
+	for i := 0; i < 100; i++ {
+		if got, ok := q.Pop(); !ok || got != i {
+			t.Fatalf("Pop() = %v, %v, want %v, true", got, ok, i)
+		}
+	}
+
+	if _, ok := q.Pop(); ok {
+		t.Errorf("Pop() on an empty queue = true")
+	}
+}
+
+func TestQueueBounded(t *testing.T) {
+	q := NewQueue[int](2)
+	q.Push(1)
+	q.Push(2)
+
+	if err := q.Push(3); !errors.Is(err, ErrFull) {
+		t.Errorf("Push() error = %v, want %v", err, ErrFull)
+	}
+
+	q.Pop()
+	if err := q.Push(3); err != nil {
+		t.Errorf("Push() error = %v after Pop()", err)
+	}
+
+	if got, want := q.Items(), []int{2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Items() = %v, want %v", got, want)
+	}
+
+	if q.Len() != 2 || q.Cap() != 2 {
+		t.Errorf("Len(), Cap() = %v, %v, want 2, 2", q.Len(), q.Cap())
+	}
+
+	q.Clear()
+	if q.Len() != 0 {
+		t.Errorf("Len() after Clear() = %v, want 0", q.Len())
+	}
+}
@@ -0,0 +1,183 @@
+package ds
+
+import (
+	"fmt"
+	"sync"
+)
+
+// RingMode is the behavior of a full Ring.
+type RingMode int
+
+const (
+	RingOverwrite RingMode = iota // pushing on a full ring drops the oldest element, the default
+	RingReject                    // pushing on a full ring returns ErrFull
+)
+
+// Ring is a fixed-size circular buffer, for instance to retain the last N log lines.
+// A Ring is not safe for concurrent use, see SyncRing.
+type Ring[T any] struct {
+	buf  []T
+	head int // index of the oldest element
+	size int
+	mode RingMode
+}
+
+// NewRing creates a ring holding up to capacity elements. It returns an error if capacity is not positive.
+func NewRing[T any](capacity int, mode RingMode) (*Ring[T], error) {
+	if capacity <= 0 {
+		return nil, fmt.Errorf("capacity must be positive, got %d", capacity)
+	}
+
+	return &Ring[T]{buf: make([]T, capacity), mode: mode}, nil
+}
+
+// Push adds the element as the newest. On a full ring, it drops the oldest element with RingOverwrite
+// and returns ErrFull with RingReject.
+func (r *Ring[T]) Push(item T) error {
+	if r.size == len(r.buf) {
+		if r.mode == RingReject {
+			return ErrFull
+		}
+
+		r.buf[r.head] = item
+		r.head = (r.head + 1) % len(r.buf)
+
+		return nil
+	}
+
+	r.buf[(r.head+r.size)%len(r.buf)] = item
+	r.size++
+
+	return nil
+}
+
+// Pop removes and returns the oldest element, or false if the ring is empty.
+func (r *Ring[T]) Pop() (T, bool) {
+	var zero T
+	if r.size == 0 {
+		return zero, false
+	}
+
+	item := r.buf[r.head]
+	r.buf[r.head] = zero
+	r.head = (r.head + 1) % len(r.buf)
+	r.size--
+
+	return item, true
+}
+
+// Peek returns the oldest element without removing it, or false if the ring is empty.
+func (r *Ring[T]) Peek() (T, bool) {
+	if r.size == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return r.buf[r.head], true
+}
+
+// Snapshot returns a copy of the elements from the oldest to the newest.
+func (r *Ring[T]) Snapshot() []T {
+	items := make([]T, r.size)
+	for i := range items {
+		items[i] = r.buf[(r.head+i)%len(r.buf)]
+	}
+
+	return items
+}
+
+// Len returns the number of elements.
+func (r *Ring[T]) Len() int {
+	return r.size
+}
+
+// Cap returns the maximum number of elements.
+func (r *Ring[T]) Cap() int {
+	return len(r.buf)
+}
+
+// IsFull reports whether the ring holds Cap elements.
+func (r *Ring[T]) IsFull() bool {
+	return r.size == len(r.buf)
+}
+
+// Clear removes every element.
+func (r *Ring[T]) Clear() {
+	var zero T
+	for i := range r.buf {
+		r.buf[i] = zero
+	}
+
+	r.head = 0
+	r.size = 0
+}
+
+// SyncRing is a Ring safe for concurrent use.
+type SyncRing[T any] struct {
+	mu   sync.Mutex
+	ring *Ring[T]
+}
+
+// NewSyncRing creates a ring safe for concurrent use holding up to capacity elements.
+// It returns an error if capacity is not positive.
+func NewSyncRing[T any](capacity int, mode RingMode) (*SyncRing[T], error) {
+	ring, err := NewRing[T](capacity, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SyncRing[T]{ring: ring}, nil
+}
+
+// Push adds the element as the newest, see Ring.Push.
+func (r *SyncRing[T]) Push(item T) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.ring.Push(item)
+}
+
+// Pop removes and returns the oldest element, or false if the ring is empty.
+func (r *SyncRing[T]) Pop() (T, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.ring.Pop()
+}
+
+// Peek returns the oldest element without removing it, or false if the ring is empty.
+func (r *SyncRing[T]) Peek() (T, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.ring.Peek()
+}
+
+// Snapshot returns a copy of the elements from the oldest to the newest.
+func (r *SyncRing[T]) Snapshot() []T {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.ring.Snapshot()
+}
+
+// Len returns the number of elements.
+func (r *SyncRing[T]) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.ring.Len()
+}
+
+// Cap returns the maximum number of elements.
+func (r *SyncRing[T]) Cap() int {
+	return r.ring.Cap()
+}
+
+// Clear removes every element.
+func (r *SyncRing[T]) Clear() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.ring.Clear()
+}
@@ -0,0 +1,123 @@
+package ds
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestNewRing(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		wantErr  bool
+	}{
+		{name: "success - positive capacity", capacity: 3},
+		{name: "fail - zero capacity", capacity: 0, wantErr: true},
+		{name: "fail - negative capacity", capacity: -1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRing[int](tt.capacity, RingOverwrite)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewRing() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRingOverwrite(t *testing.T) {
+	r, _ := NewRing[int](3, RingOverwrite)
+
+	for i := 1; i <= 5; i++ {
+		if err := r.Push(i); err != nil {
+			t.Fatalf("Push() error = %v", err)
+		}
+	}
+
+	if got, want := r.Snapshot(), []int{3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Snapshot() = %v, want %v", got, want)
+	}
+
+	if !r.IsFull() || r.Len() != 3 || r.Cap() != 3 {
+		t.Errorf("IsFull(), Len(), Cap() = %v, %v, %v, want true, 3, 3", r.IsFull(), r.Len(), r.Cap())
+	}
+
+	if got, _ := r.Peek(); got != 3 {
+		t.Errorf("Peek() = %v, want %v", got, 3)
+	}
+
+	if got, _ := r.Pop(); got != 3 {
+		t.Errorf("Pop() = %v, want %v", got, 3)
+	}
+
+	r.Push(6)
+	if got, want := r.Snapshot(), []int{4, 5, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Snapshot() = %v, want %v", got, want)
+	}
+
+	r.Clear()
+	if _, ok := r.Pop(); ok {
+		t.Errorf("Pop() after Clear() = true")
+	}
+}
+
+func TestRingReject(t *testing.T) {
+	r, _ := NewRing[string](2, RingReject)
+
+	tests := []struct {
+		name    string
+		item    string
+		wantErr error
+	}{
+		{name: "success - first", item: "a"},
+		{name: "success - second", item: "b"},
+		{name: "fail - full", item: "c", wantErr: ErrFull},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := r.Push(tt.item); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Push() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if got, want := r.Snapshot(), []string{"a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Snapshot() = %v, want %v", got, want)
+	}
+}
+
+func TestSyncRing(t *testing.T) {
+	if _, err := NewSyncRing[int](0, RingOverwrite); err == nil {
+		t.Errorf("NewSyncRing() expected error for zero capacity")
+	}
+
+	r, _ := NewSyncRing[int](100, RingOverwrite)
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				r.Push(i)
+				r.Snapshot()
+				if i%10 == 0 {
+					r.Pop()
+					r.Peek()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := r.Len(); got > r.Cap() {
+		t.Errorf("Len() = %v over the capacity", got)
+	}
+
+	r.Clear()
+	if got := r.Len(); got != 0 {
+		t.Errorf("Len() after Clear() = %v, want 0", got)
+	}
+}
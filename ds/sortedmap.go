@@ -0,0 +1,243 @@
+package ds
+
+import (
+	"github.com/kashifkhan0771/utils/slice"
+)
+
+const skipListMaxLevel = 32
+
+// SortedMap is a map keeping its keys sorted, backed by a skip list. Lookups, insertions and deletions
+// run in O(log n) on average. The zero value is an empty map ready to use. A SortedMap is not safe for
+// concurrent use.
+type SortedMap[K slice.Ordered, V any] struct {
+	head  skipNode[K, V] // sentinel before the first key
+	level int            // number of levels in use
+	size  int
+	seed  uint64 // state of the generator of node levels
+}
+
+type skipNode[K slice.Ordered, V any] struct {
+	key  K
+	val  V
+	next []*skipNode[K, V]
+}
+
+// NewSortedMap creates an empty sorted map.
+func NewSortedMap[K slice.Ordered, V any]() *SortedMap[K, V] {
+	return &SortedMap[K, V]{}
+}
+
+// Get returns the value of key and whether it was found.
+func (m *SortedMap[K, V]) Get(key K) (V, bool) {
+	if n := m.ceilingNode(key); n != nil && n.key == key {
+		return n.val, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Set maps key to value, replacing any previous value.
+func (m *SortedMap[K, V]) Set(key K, value V) {
+	m.init()
+
+	var update [skipListMaxLevel]*skipNode[K, V]
+	n := &m.head
+	for level := m.level - 1; level >= 0; level-- {
+		for n.next[level] != nil && n.next[level].key < key {
+			n = n.next[level]
+		}
+		update[level] = n
+	}
+
+	if next := n.next[0]; next != nil && next.key == key {
+		next.val = value
+		return
+	}
+
+	level := m.randomLevel()
+	for ; m.level < level; m.level++ {
+		update[m.level] = &m.head
+	}
+
+	node := &skipNode[K, V]{key: key, val: value, next: make([]*skipNode[K, V], level)}
+	for i := 0; i < level; i++ {
+		node.next[i] = update[i].next[i]
+		update[i].next[i] = node
+	}
+
+	m.size++
+}
+
+// Delete removes key and reports whether it was found.
+func (m *SortedMap[K, V]) Delete(key K) bool {
+	if m.size == 0 {
+		return false
+	}
+
+	var update [skipListMaxLevel]*skipNode[K, V]
+	n := &m.head
+	for level := m.level - 1; level >= 0; level-- {
+		for n.next[level] != nil && n.next[level].key < key {
+			n = n.next[level]
+		}
+		update[level] = n
+	}
+
+	target := n.next[0]
+	if target == nil || target.key != key {
+		return false
+	}
+
+	for i := range target.next {
+		update[i].next[i] = target.next[i]
+	}
+
+	for m.level > 1 && m.head.next[m.level-1] == nil {
+		m.level--
+	}
+
+	m.size--
+
+	return true
+}
+
+// Len returns the number of keys.
+func (m *SortedMap[K, V]) Len() int {
+	return m.size
+}
+
+// Min returns the least key and its value, or false if the map is empty.
+func (m *SortedMap[K, V]) Min() (K, V, bool) {
+	if m.size == 0 {
+		return entryOf[K, V](nil)
+	}
+
+	return entryOf(m.head.next[0])
+}
+
+// Max returns the greatest key and its value, or false if the map is empty.
+func (m *SortedMap[K, V]) Max() (K, V, bool) {
+	if m.size == 0 {
+		return entryOf[K, V](nil)
+	}
+
+	n := &m.head
+	for level := m.level - 1; level >= 0; level-- {
+		for n.next[level] != nil {
+			n = n.next[level]
+		}
+	}
+
+	return entryOf(n)
+}
+
+// Floor returns the greatest key less than or equal to key, with its value, or false if there is none.
+func (m *SortedMap[K, V]) Floor(key K) (K, V, bool) {
+	if m.size == 0 {
+		return entryOf[K, V](nil)
+	}
+
+	n := &m.head
+	for level := m.level - 1; level >= 0; level-- {
+		for n.next[level] != nil && !(key < n.next[level].key) {
+			n = n.next[level]
+		}
+	}
+
+	if n == &m.head {
+		return entryOf[K, V](nil)
+	}
+
+	return entryOf(n)
+}
+
+// Ceiling returns the least key greater than or equal to key, with its value, or false if there is none.
+func (m *SortedMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return entryOf(m.ceilingNode(key))
+}
+
+// Range calls fn for every key in [from, to) in ascending order, until fn returns false.
+func (m *SortedMap[K, V]) Range(from, to K, fn func(key K, value V) bool) {
+	for n := m.ceilingNode(from); n != nil && n.key < to; n = n.next[0] {
+		if !fn(n.key, n.val) {
+			return
+		}
+	}
+}
+
+// Each calls fn for every key in ascending order, until fn returns false.
+func (m *SortedMap[K, V]) Each(fn func(key K, value V) bool) {
+	if m.size == 0 {
+		return
+	}
+
+	for n := m.head.next[0]; n != nil; n = n.next[0] {
+		if !fn(n.key, n.val) {
+			return
+		}
+	}
+}
+
+// Keys returns the keys in ascending order.
+func (m *SortedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.size)
+	m.Each(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+
+	return keys
+}
+
+// ceilingNode returns the node of the least key greater than or equal to key, or nil.
+func (m *SortedMap[K, V]) ceilingNode(key K) *skipNode[K, V] {
+	if m.size == 0 {
+		return nil
+	}
+
+	n := &m.head
+	for level := m.level - 1; level >= 0; level-- {
+		for n.next[level] != nil && n.next[level].key < key {
+			n = n.next[level]
+		}
+	}
+
+	return n.next[0]
+}
+
+func (m *SortedMap[K, V]) init() {
+	if m.head.next == nil {
+		m.head.next = make([]*skipNode[K, V], skipListMaxLevel)
+		m.level = 1
+		m.seed = 0x9E3779B97F4A7C15
+	}
+}
+
+// randomLevel returns a level with probability 1/4 of growing, generated with xorshift.
+func (m *SortedMap[K, V]) randomLevel() int {
+	m.seed ^= m.seed << 13
+	m.seed ^= m.seed >> 7
+	m.seed ^= m.seed << 17
+
+	level, bits := 1, m.seed
+	for level < skipListMaxLevel && bits&3 == 0 {
+		level++
+		bits >>= 2
+	}
+
+	return level
+}
+
+func entryOf[K slice.Ordered, V any](n *skipNode[K, V]) (K, V, bool) {
+	if n == nil {
+		var (
+			key   K
+			value V
+		)
+
+		return key, value, false
+	}
+
+	return n.key, n.val, true
+}
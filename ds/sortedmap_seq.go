@@ -0,0 +1,19 @@
+//go:build go1.23
+
+package ds
+
+import "iter"
+
+// All returns a sequence yielding the keys and values in ascending order of keys.
+func (m *SortedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.Each(yield)
+	}
+}
+
+// Between returns a sequence yielding the keys in [from, to) and their values in ascending order.
+func (m *SortedMap[K, V]) Between(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.Range(from, to, yield)
+	}
+}
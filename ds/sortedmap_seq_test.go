@@ -0,0 +1,33 @@
+//go:build go1.23
+
+package ds
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortedMapSeq(t *testing.T) {
+	var m SortedMap[int, string]
+	for _, key := range []int{5, 1, 3} {
+		m.Set(key, "v")
+	}
+
+	var all []int
+	for key := range m.All() {
+		all = append(all, key)
+	}
+
+	if want := []int{1, 3, 5}; !reflect.DeepEqual(all, want) {
+		t.Errorf("All() = %v, want %v", all, want)
+	}
+
+	var between []int
+	for key := range m.Between(2, 5) {
+		between = append(between, key)
+	}
+
+	if want := []int{3}; !reflect.DeepEqual(between, want) {
+		t.Errorf("Between() = %v, want %v", between, want)
+	}
+}
@@ -0,0 +1,131 @@
+package ds
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSortedMap(t *testing.T) {
+	var m SortedMap[int, string]
+
+	if _, _, ok := m.Min(); ok {
+		t.Errorf("Min() on an empty map = true")
+	}
+
+	if _, _, ok := m.Floor(3); ok {
+		t.Errorf("Floor() on an empty map = true")
+	}
+
+	if m.Delete(1) {
+		t.Errorf("Delete() on an empty map = true")
+	}
+
+	for _, key := range []int{30, 10, 20, 40} {
+		m.Set(key, "v")
+	}
+	m.Set(20, "twenty")
+
+	if got, ok := m.Get(20); !ok || got != "twenty" {
+		t.Errorf("Get(20) = %v, %v, want twenty, true", got, ok)
+	}
+
+	if _, ok := m.Get(25); ok {
+		t.Errorf("Get(25) = true")
+	}
+
+	tests := []struct {
+		name    string
+		query   func(int) (int, string, bool)
+		key     int
+		wantKey int
+		wantOk  bool
+	}{
+		{name: "success - floor of a key", query: m.Floor, key: 20, wantKey: 20, wantOk: true},
+		{name: "success - floor between keys", query: m.Floor, key: 25, wantKey: 20, wantOk: true},
+		{name: "success - floor after all", query: m.Floor, key: 99, wantKey: 40, wantOk: true},
+		{name: "fail - floor before all", query: m.Floor, key: 5},
+		{name: "success - ceiling between keys", query: m.Ceiling, key: 25, wantKey: 30, wantOk: true},
+		{name: "success - ceiling before all", query: m.Ceiling, key: 5, wantKey: 10, wantOk: true},
+		{name: "fail - ceiling after all", query: m.Ceiling, key: 41},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, _, ok := tt.query(tt.key)
+			if key != tt.wantKey || ok != tt.wantOk {
+				t.Errorf("query(%v) = %v, %v, want %v, %v", tt.key, key, ok, tt.wantKey, tt.wantOk)
+			}
+		})
+	}
+
+	if key, _, _ := m.Min(); key != 10 {
+		t.Errorf("Min() = %v, want %v", key, 10)
+	}
+
+	if key, _, _ := m.Max(); key != 40 {
+		t.Errorf("Max() = %v, want %v", key, 40)
+	}
+
+	var keys []int
+	m.Range(15, 40, func(key int, _ string) bool {
+		keys = append(keys, key)
+		return true
+	})
+
+	if want := []int{20, 30}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Range() = %v, want %v", keys, want)
+	}
+
+	if !m.Delete(20) || m.Delete(20) {
+		t.Errorf("Delete() did not remove the key once")
+	}
+
+	if got, want := m.Keys(), []int{10, 30, 40}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Keys() = %v, want %v", got, want)
+	}
+}
+
+func TestSortedMapRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	m := NewSortedMap[int, int]()
+	want := make(map[int]int)
+
+	for i := 0; i < 20000; i++ {
+		key := r.Intn(2000)
+		if r.Intn(3) == 0 {
+			if m.Delete(key) != hasKey(want, key) {
+				t.Fatalf("Delete(%v) disagrees with the map", key)
+			}
+			delete(want, key)
+		} else {
+			m.Set(key, i)
+			want[key] = i
+		}
+	}
+
+	keys := make([]int, 0, len(want))
+	for key := range want {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+
+	if got := m.Keys(); !reflect.DeepEqual(got, keys) {
+		t.Fatalf("Keys() differ from the sorted keys of the map")
+	}
+
+	for _, key := range keys {
+		if got, _ := m.Get(key); got != want[key] {
+			t.Fatalf("Get(%v) = %v, want %v", key, got, want[key])
+		}
+	}
+
+	if m.Len() != len(want) {
+		t.Errorf("Len() = %v, want %v", m.Len(), len(want))
+	}
+}
+
+func hasKey(m map[int]int, key int) bool {
+	_, ok := m[key]
+	return ok
+}
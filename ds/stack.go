@@ -0,0 +1,76 @@
+package ds
+
+// Stack is a last-in first-out stack with amortized O(1) operations.
+// The zero value is an empty unbounded stack ready to use. A Stack is not safe for concurrent use.
+type Stack[T any] struct {
+	items    []T
+	capacity int // maximum number of elements, unbounded if zero
+}
+
+// NewStack creates a stack holding up to capacity elements, or unbounded if capacity is zero or negative.
+func NewStack[T any](capacity int) *Stack[T] {
+	if capacity < 0 {
+		capacity = 0
+	}
+
+	return &Stack[T]{capacity: capacity}
+}
+
+// Push adds the element on top. It returns ErrFull if the stack is bounded and full.
+func (s *Stack[T]) Push(item T) error {
+	if s.capacity > 0 && len(s.items) >= s.capacity {
+		return ErrFull
+	}
+
+	s.items = append(s.items, item)
+
+	return nil
+}
+
+// Pop removes and returns the top element, or false if the stack is empty.
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, false
+	}
+
+	last := len(s.items) - 1
+	item := s.items[last]
+	s.items[last] = zero
+	s.items = s.items[:last]
+
+	return item, true
+}
+
+// Peek returns the top element without removing it, or false if the stack is empty.
+func (s *Stack[T]) Peek() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return s.items[len(s.items)-1], true
+}
+
+// Len returns the number of elements.
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+// Cap returns the maximum number of elements, or zero if the stack is unbounded.
+func (s *Stack[T]) Cap() int {
+	return s.capacity
+}
+
+// Clear removes every element.
+func (s *Stack[T]) Clear() {
+	s.items = nil
+}
+
+// Items returns the elements from bottom to top.
+func (s *Stack[T]) Items() []T {
+	items := make([]T, len(s.items))
+	copy(items, s.items)
+
+	return items
+}
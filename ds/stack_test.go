@@ -0,0 +1,66 @@
+package ds
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestStack(t *testing.T) {
+	var s Stack[string]
+
+	if _, ok := s.Pop(); ok {
+		t.Errorf("Pop() on an empty stack = true")
+	}
+
+	s.Push("a")
+	s.Push("b")
+	s.Push("c")
+
+	if got, want := s.Items(), []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Items() = %v, want %v", got, want)
+	}
+
+	if got, _ := s.Peek(); got != "c" {
+		t.Errorf("Peek() = %v, want %v", got, "c")
+	}
+
+	for _, want := range []string{"c", "b", "a"} {
+		if got, ok := s.Pop(); !ok || got != want {
+			t.Errorf("Pop() = %v, %v, want %v, true", got, ok, want)
+		}
+	}
+
+	if got := s.Len(); got != 0 {
+		t.Errorf("Len() = %v, want %v", got, 0)
+	}
+}
+
+func TestStackBounded(t *testing.T) {
+	s := NewStack[int](1)
+
+	tests := []struct {
+		name    string
+		item    int
+		wantErr error
+	}{
+		{name: "success - push", item: 1},
+		{name: "fail - full", item: 2, wantErr: ErrFull},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := s.Push(tt.item); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Push() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if s.Cap() != 1 {
+		t.Errorf("Cap() = %v, want 1", s.Cap())
+	}
+
+	s.Clear()
+	if err := s.Push(3); err != nil {
+		t.Errorf("Push() error = %v after Clear()", err)
+	}
+}
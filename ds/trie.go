@@ -0,0 +1,244 @@
+package ds
+
+import (
+	"sort"
+	"strings"
+)
+
+// Trie maps string keys to values for prefix lookups, such as routing URL paths or phone number prefixes.
+// Keys are split byte-wise. The zero value is an empty trie with one node per byte, ready to use.
+// A Trie is not safe for concurrent use.
+type Trie[V any] struct {
+	root  trieNode[V]
+	radix bool // whether single-child chains are compressed into one node
+	size  int
+}
+
+type trieNode[V any] struct {
+	prefix   string         // bytes of the edge from the parent, a single byte unless radix
+	children []*trieNode[V] // sorted by the first byte of their prefix
+	value    V
+	hasValue bool
+}
+
+// NewTrie creates an empty trie with one node per byte of the keys.
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{}
+}
+
+// NewRadixTrie creates an empty radix trie, compressing the chains of nodes with a single child into
+// one node. It uses less memory than NewTrie for long keys sharing few prefixes.
+func NewRadixTrie[V any]() *Trie[V] {
+	return &Trie[V]{radix: true}
+}
+
+// Insert maps key to value, replacing any previous value.
+func (t *Trie[V]) Insert(key string, value V) {
+	n, rest := &t.root, key
+	for rest != "" {
+		i, ok := n.find(rest[0])
+		if !ok {
+			n.children = append(n.children, nil)
+			copy(n.children[i+1:], n.children[i:])
+			n.children[i] = t.chain(rest, value)
+			t.size++
+
+			return
+		}
+
+		child := n.children[i]
+		common := commonPrefixLen(child.prefix, rest)
+		if common < len(child.prefix) {
+			// only radix edges are longer than one byte and can be split
+			split := &trieNode[V]{prefix: child.prefix[:common], children: []*trieNode[V]{child}}
+			child.prefix = child.prefix[common:]
+			n.children[i] = split
+			child = split
+		}
+
+		n, rest = child, rest[common:]
+	}
+
+	if !n.hasValue {
+		t.size++
+	}
+
+	n.value, n.hasValue = value, true
+}
+
+// Get returns the value of key and whether it was found.
+func (t *Trie[V]) Get(key string) (V, bool) {
+	n, rest := &t.root, key
+	for rest != "" {
+		child, ok := n.next(rest)
+		if !ok {
+			var zero V
+			return zero, false
+		}
+
+		n, rest = child, rest[len(child.prefix):]
+	}
+
+	return n.value, n.hasValue
+}
+
+// Delete removes key and reports whether it was found.
+func (t *Trie[V]) Delete(key string) bool {
+	path := []*trieNode[V]{&t.root}
+	indexes := []int{-1}
+
+	n, rest := &t.root, key
+	for rest != "" {
+		i, ok := n.find(rest[0])
+		if !ok || !strings.HasPrefix(rest, n.children[i].prefix) {
+			return false
+		}
+
+		n, rest = n.children[i], rest[len(n.children[i].prefix):]
+		path = append(path, n)
+		indexes = append(indexes, i)
+	}
+
+	if !n.hasValue {
+		return false
+	}
+
+	var zero V
+	n.value, n.hasValue = zero, false
+	t.size--
+
+	// prune the nodes left without values and children, then merge a remaining single child chain
+	for i := len(path) - 1; i > 0; i-- {
+		node, parent := path[i], path[i-1]
+		if node.hasValue || len(node.children) > 0 {
+			if t.radix && !node.hasValue && len(node.children) == 1 {
+				child := node.children[0]
+				node.prefix += child.prefix
+				node.children = child.children
+				node.value, node.hasValue = child.value, child.hasValue
+			}
+
+			break
+		}
+
+		parent.children = append(parent.children[:indexes[i]], parent.children[indexes[i]+1:]...)
+	}
+
+	return true
+}
+
+// LongestPrefix returns the longest key that is a prefix of s, with its value. It returns false if no key
+// is a prefix of s.
+func (t *Trie[V]) LongestPrefix(s string) (string, V, bool) {
+	var (
+		key      string
+		value    V
+		found    bool
+		consumed int
+	)
+
+	n := &t.root
+	for {
+		if n.hasValue {
+			key, value, found = s[:consumed], n.value, true
+		}
+
+		if consumed == len(s) {
+			break
+		}
+
+		child, ok := n.next(s[consumed:])
+		if !ok {
+			break
+		}
+
+		n, consumed = child, consumed+len(child.prefix)
+	}
+
+	return key, value, found
+}
+
+// WalkPrefix calls fn for every key starting with prefix in lexicographic byte order, until fn returns false.
+func (t *Trie[V]) WalkPrefix(prefix string, fn func(key string, value V) bool) {
+	n, rest, path := &t.root, prefix, ""
+	for rest != "" {
+		i, ok := n.find(rest[0])
+		if !ok {
+			return
+		}
+
+		child := n.children[i]
+		switch {
+		case strings.HasPrefix(rest, child.prefix):
+			rest = rest[len(child.prefix):]
+		case strings.HasPrefix(child.prefix, rest):
+			// the prefix ends inside a radix edge
+			rest = ""
+		default:
+			return
+		}
+
+		n, path = child, path+child.prefix
+	}
+
+	n.walk(path, fn)
+}
+
+// Len returns the number of keys.
+func (t *Trie[V]) Len() int {
+	return t.size
+}
+
+// chain returns the nodes holding the remaining bytes of a new key, one per byte unless radix.
+func (t *Trie[V]) chain(rest string, value V) *trieNode[V] {
+	if t.radix {
+		return &trieNode[V]{prefix: rest, value: value, hasValue: true}
+	}
+
+	leaf := &trieNode[V]{prefix: rest[len(rest)-1:], value: value, hasValue: true}
+	for i := len(rest) - 2; i >= 0; i-- {
+		leaf = &trieNode[V]{prefix: rest[i : i+1], children: []*trieNode[V]{leaf}}
+	}
+
+	return leaf
+}
+
+// find returns the index of the child whose prefix starts with b, or where it would be inserted.
+func (n *trieNode[V]) find(b byte) (int, bool) {
+	i := sort.Search(len(n.children), func(i int) bool { return n.children[i].prefix[0] >= b })
+
+	return i, i < len(n.children) && n.children[i].prefix[0] == b
+}
+
+// next returns the child whose whole prefix starts rest.
+func (n *trieNode[V]) next(rest string) (*trieNode[V], bool) {
+	i, ok := n.find(rest[0])
+	if !ok || !strings.HasPrefix(rest, n.children[i].prefix) {
+		return nil, false
+	}
+
+	return n.children[i], true
+}
+
+func (n *trieNode[V]) walk(key string, fn func(key string, value V) bool) bool {
+	if n.hasValue && !fn(key, n.value) {
+		return false
+	}
+
+	for _, child := range n.children {
+		if !child.walk(key+child.prefix, fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func commonPrefixLen(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+
+	return i
+}
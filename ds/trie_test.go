@@ -0,0 +1,209 @@
+package ds
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func newTries() map[string]*Trie[int] {
+	return map[string]*Trie[int]{"byte-wise": NewTrie[int](), "radix": NewRadixTrie[int]()}
+}
+
+func TestTrieGet(t *testing.T) {
+	for mode, trie := range newTries() {
+		t.Run(mode, func(t *testing.T) {
+			trie.Insert("/api", 1)
+			trie.Insert("/api/users", 2)
+			trie.Insert("/apple", 3)
+			trie.Insert("/api", 4)
+
+			tests := []struct {
+				name   string
+				key    string
+				want   int
+				wantOk bool
+			}{
+				{name: "success - replaced key", key: "/api", want: 4, wantOk: true},
+				{name: "success - longer key", key: "/api/users", want: 2, wantOk: true},
+				{name: "success - sibling key", key: "/apple", want: 3, wantOk: true},
+				{name: "fail - inner node", key: "/ap"},
+				{name: "fail - missing", key: "/api/orders"},
+				{name: "fail - empty key", key: ""},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					got, ok := trie.Get(tt.key)
+					if got != tt.want || ok != tt.wantOk {
+						t.Errorf("Get() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+					}
+				})
+			}
+
+			if got := trie.Len(); got != 3 {
+				t.Errorf("Len() = %v, want %v", got, 3)
+			}
+		})
+	}
+}
+
+func TestTrieLongestPrefix(t *testing.T) {
+	for mode, trie := range newTries() {
+		t.Run(mode, func(t *testing.T) {
+			trie.Insert("+1", 1)
+			trie.Insert("+1212", 2)
+			trie.Insert("+44", 3)
+
+			tests := []struct {
+				name    string
+				s       string
+				wantKey string
+				want    int
+				wantOk  bool
+			}{
+				{name: "success - longest match", s: "+12125550100", wantKey: "+1212", want: 2, wantOk: true},
+				{name: "success - shorter match", s: "+13105550100", wantKey: "+1", want: 1, wantOk: true},
+				{name: "success - exact match", s: "+44", wantKey: "+44", want: 3, wantOk: true},
+				{name: "success - match inside an edge", s: "+121", wantKey: "+1", want: 1, wantOk: true},
+				{name: "fail - no match", s: "+33123", wantOk: false},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					key, got, ok := trie.LongestPrefix(tt.s)
+					if key != tt.wantKey || got != tt.want || ok != tt.wantOk {
+						t.Errorf("LongestPrefix() = %v, %v, %v, want %v, %v, %v", key, got, ok, tt.wantKey, tt.want, tt.wantOk)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestTrieWalkPrefix(t *testing.T) {
+	for mode, trie := range newTries() {
+		t.Run(mode, func(t *testing.T) {
+			for i, key := range []string{"tea", "ten", "to", "ted", "i", "inn", "team"} {
+				trie.Insert(key, i)
+			}
+
+			tests := []struct {
+				name   string
+				prefix string
+				want   []string
+			}{
+				{name: "success - all keys", prefix: "", want: []string{"i", "inn", "tea", "team", "ted", "ten", "to"}},
+				{name: "success - inner prefix", prefix: "te", want: []string{"tea", "team", "ted", "ten"}},
+				{name: "success - prefix is a key", prefix: "tea", want: []string{"tea", "team"}},
+				{name: "success - prefix inside an edge", prefix: "in", want: []string{"inn"}},
+				{name: "fail - no key", prefix: "x", want: nil},
+				{name: "fail - diverging prefix", prefix: "teb", want: nil},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					var got []string
+					trie.WalkPrefix(tt.prefix, func(key string, value int) bool {
+						got = append(got, key)
+						return true
+					})
+
+					if !reflect.DeepEqual(got, tt.want) {
+						t.Errorf("WalkPrefix() = %v, want %v", got, tt.want)
+					}
+				})
+			}
+
+			count := 0
+			trie.WalkPrefix("t", func(string, int) bool {
+				count++
+				return false
+			})
+			if count != 1 {
+				t.Errorf("WalkPrefix() called fn %v times after it returned false, want 1", count)
+			}
+		})
+	}
+}
+
+func TestTrieDelete(t *testing.T) {
+	for mode, trie := range newTries() {
+		t.Run(mode, func(t *testing.T) {
+			trie.Insert("romane", 1)
+			trie.Insert("romanus", 2)
+			trie.Insert("romulus", 3)
+			trie.Insert("rom", 4)
+
+			if trie.Delete("roman") || trie.Delete("romanes") {
+				t.Errorf("Delete() of a missing key = true")
+			}
+
+			if !trie.Delete("romane") {
+				t.Errorf("Delete() = false")
+			}
+
+			if !trie.Delete("rom") {
+				t.Errorf("Delete() = false")
+			}
+
+			if _, ok := trie.Get("romane"); ok {
+				t.Errorf("Get() found a deleted key")
+			}
+
+			for key, want := range map[string]int{"romanus": 2, "romulus": 3} {
+				if got, ok := trie.Get(key); !ok || got != want {
+					t.Errorf("Get(%v) = %v, %v, want %v, true", key, got, ok, want)
+				}
+			}
+
+			if got := trie.Len(); got != 2 {
+				t.Errorf("Len() = %v, want %v", got, 2)
+			}
+		})
+	}
+}
+
+func TestTrieRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for mode, trie := range newTries() {
+		t.Run(mode, func(t *testing.T) {
+			want := make(map[string]int)
+			for i := 0; i < 5000; i++ {
+				key := fmt.Sprintf("%03x", r.Intn(4096))[:1+r.Intn(3)]
+				if r.Intn(3) == 0 {
+					delete(want, key)
+					trie.Delete(key)
+				} else {
+					want[key] = i
+					trie.Insert(key, i)
+				}
+			}
+
+			if trie.Len() != len(want) {
+				t.Fatalf("Len() = %v, want %v", trie.Len(), len(want))
+			}
+
+			var keys []string
+			trie.WalkPrefix("", func(key string, value int) bool {
+				keys = append(keys, key)
+				if want[key] != value {
+					t.Errorf("value of %v = %v, want %v", key, value, want[key])
+				}
+				return true
+			})
+
+			if !sort.StringsAreSorted(keys) || len(keys) != len(want) {
+				t.Errorf("WalkPrefix() = %v keys sorted %v, want %v", len(keys), sort.StringsAreSorted(keys), len(want))
+			}
+
+			for key := range want {
+				prefix, _, ok := trie.LongestPrefix(key + "zz")
+				if !ok || prefix != key || !strings.HasPrefix(key+"zz", prefix) {
+					t.Errorf("LongestPrefix(%v) = %v, %v", key+"zz", prefix, ok)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package encode
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// binaryMagic is the first byte of the payloads of MarshalBinary, followed by the version as an uvarint
+// and the gob encoding of the value.
+const binaryMagic = 0xb1
+
+var (
+	// ErrBinaryFormat is returned when decoding data that was not produced by MarshalBinary.
+	ErrBinaryFormat = errors.New("invalid binary payload")
+	// ErrBinaryVersion is returned when a payload cannot be brought to the requested version, either because it
+	// is newer, or because a migration is missing.
+	ErrBinaryVersion = errors.New("unsupported binary payload version")
+)
+
+// migration upgrades a value of type old at version from to the next version.
+type migration struct {
+	old reflect.Type
+	fn  func(reflect.Value) (reflect.Value, error)
+}
+
+type migrationKey struct {
+	target reflect.Type
+	from   uint32
+}
+
+var (
+	migrationsMu sync.RWMutex
+	migrations   = make(map[migrationKey]migration)
+)
+
+// MarshalBinary returns the gob encoding of v prefixed with a header holding its schema version, so that payloads
+// stored in caches or queues can be decoded, and migrated, by later versions of the program.
+func MarshalBinary(v interface{}, version uint32) ([]byte, error) {
+	buf := bytes.NewBuffer([]byte{binaryMagic})
+
+	var header [binary.MaxVarintLen32]byte
+	buf.Write(header[:binary.PutUvarint(header[:], uint64(version))])
+
+	if err := gob.NewEncoder(buf).Encode(v); err != nil {
+		return nil, fmt.Errorf("encode: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// BinaryVersion returns the schema version of a payload of MarshalBinary.
+func BinaryVersion(data []byte) (uint32, error) {
+	version, _, err := splitBinary(data)
+
+	return version, err
+}
+
+// UnmarshalBinary decodes a payload of MarshalBinary into the value v points to, whose type is at the given
+// schema version. Older payloads are upgraded one version at a time by the migrations registered for the type
+// of v with RegisterMigration. Payloads newer than version return ErrBinaryVersion.
+func UnmarshalBinary(data []byte, v interface{}, version uint32) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("encode: %T is not a non-nil pointer", v)
+	}
+
+	from, payload, err := splitBinary(data)
+	if err != nil {
+		return err
+	}
+
+	if from > version {
+		return fmt.Errorf("%w: %d is newer than %d", ErrBinaryVersion, from, version)
+	}
+
+	dec := gob.NewDecoder(bytes.NewReader(payload))
+	if from == version {
+		if err := dec.Decode(v); err != nil {
+			return fmt.Errorf("encode: %w", err)
+		}
+
+		return nil
+	}
+
+	target := rv.Elem().Type()
+
+	var value reflect.Value
+	for n := from; n < version; n++ {
+		migrationsMu.RLock()
+		m, ok := migrations[migrationKey{target: target, from: n}]
+		migrationsMu.RUnlock()
+
+		if !ok {
+			return fmt.Errorf("%w: no migration of %s from version %d", ErrBinaryVersion, target, n)
+		}
+
+		if n == from {
+			value = reflect.New(m.old)
+			if err := dec.Decode(value.Interface()); err != nil {
+				return fmt.Errorf("encode: version %d: %w", from, err)
+			}
+
+			value = value.Elem()
+		} else if value.Type() != m.old {
+			return fmt.Errorf("encode: migration of %s from version %d takes %s, got %s", target, n, m.old, value.Type())
+		}
+
+		if value, err = m.fn(value); err != nil {
+			return fmt.Errorf("encode: migration of %s from version %d: %w", target, n, err)
+		}
+	}
+
+	if value.Type() != target {
+		return fmt.Errorf("encode: migration of %s to version %d returns %s", target, version, value.Type())
+	}
+
+	rv.Elem().Set(value)
+
+	return nil
+}
+
+// RegisterMigration registers fn to upgrade values of type T encoded at version from to version from+1, replacing
+// any previous migration of T from that version. Old is the type of T at version from and New its type at the next
+// version, which is T itself for the last migration. T must be given explicitly, as in:
+//
+//	encode.RegisterMigration[UserV3](1, func(u UserV1) (UserV2, error) { ... })
+//	encode.RegisterMigration[UserV3](2, func(u UserV2) (UserV3, error) { ... })
+func RegisterMigration[T, Old, New any](from uint32, fn func(Old) (New, error)) {
+	key := migrationKey{target: reflect.TypeOf((*T)(nil)).Elem(), from: from}
+
+	migrationsMu.Lock()
+	defer migrationsMu.Unlock()
+
+	migrations[key] = migration{
+		old: reflect.TypeOf((*Old)(nil)).Elem(),
+		fn: func(v reflect.Value) (reflect.Value, error) {
+			n, err := fn(v.Interface().(Old))
+
+			return reflect.ValueOf(&n).Elem(), err
+		},
+	}
+}
+
+// splitBinary returns the version and gob payload of data.
+func splitBinary(data []byte) (uint32, []byte, error) {
+	if len(data) == 0 || data[0] != binaryMagic {
+		return 0, nil, ErrBinaryFormat
+	}
+
+	version, n := binary.Uvarint(data[1:])
+	if n <= 0 || version > 1<<32-1 {
+		return 0, nil, ErrBinaryFormat
+	}
+
+	return uint32(version), data[1+n:], nil
+}
@@ -0,0 +1,159 @@
+package encode
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type userV1 struct {
+	Name string
+}
+
+type userV2 struct {
+	First, Last string
+}
+
+type userV3 struct {
+	First, Last string
+	Admin       bool
+}
+
+func init() {
+	RegisterMigration[userV3](1, func(u userV1) (userV2, error) {
+		first, last, _ := strings.Cut(u.Name, " ")
+		if first == "" {
+			return userV2{}, errors.New("empty name")
+		}
+
+		return userV2{First: first, Last: last}, nil
+	})
+	RegisterMigration[userV3](2, func(u userV2) (userV3, error) {
+		return userV3{First: u.First, Last: u.Last}, nil
+	})
+}
+
+func TestMarshalBinary(t *testing.T) {
+	data, err := MarshalBinary(userV3{First: "Ana", Last: "Silva", Admin: true}, 3)
+	if err != nil {
+		t.Fatalf("MarshalBinary() error = %v", err)
+	}
+
+	if data[0] != binaryMagic || data[1] != 3 {
+		t.Errorf("MarshalBinary() header = %x, want %x03", data[:2], binaryMagic)
+	}
+
+	version, err := BinaryVersion(data)
+	if err != nil || version != 3 {
+		t.Errorf("BinaryVersion() = %d, %v, want 3", version, err)
+	}
+
+	var got userV3
+	if err := UnmarshalBinary(data, &got, 3); err != nil {
+		t.Fatalf("UnmarshalBinary() error = %v", err)
+	}
+
+	if want := (userV3{First: "Ana", Last: "Silva", Admin: true}); got != want {
+		t.Errorf("UnmarshalBinary() = %+v, want %+v", got, want)
+	}
+
+	if _, err := MarshalBinary(func() {}, 1); err == nil {
+		t.Errorf("MarshalBinary() error = nil, want an error for a function")
+	}
+}
+
+func TestUnmarshalBinaryMigrations(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		version uint32
+		want    userV3
+		wantErr string
+	}{
+		{name: "success - from version 1", value: userV1{Name: "Ana Silva"}, version: 1, want: userV3{First: "Ana", Last: "Silva"}},
+		{name: "success - from version 2", value: userV2{First: "Bob"}, version: 2, want: userV3{First: "Bob"}},
+		{name: "fail - migration error", value: userV1{}, version: 1, wantErr: "from version 1: empty name"},
+		{name: "fail - newer version", value: userV3{}, version: 4, wantErr: "4 is newer than 3"},
+		{name: "fail - missing migration", value: userV1{Name: "a"}, version: 0, wantErr: "no migration"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := MarshalBinary(tt.value, tt.version)
+			if err != nil {
+				t.Fatalf("MarshalBinary() error = %v", err)
+			}
+
+			var got userV3
+			err = UnmarshalBinary(data, &got, 3)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("UnmarshalBinary() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("UnmarshalBinary() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalBinary() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalBinaryErrors(t *testing.T) {
+	valid, _ := MarshalBinary(map[string]int{"a": 1}, 300)
+
+	tests := []struct {
+		name    string
+		data    []byte
+		target  interface{}
+		version uint32
+		wantErr error
+	}{
+		{name: "success - multi byte version", data: valid, target: new(map[string]int), version: 300},
+		{name: "fail - empty", data: nil, target: new(userV3), wantErr: ErrBinaryFormat},
+		{name: "fail - no magic", data: []byte{0x01, 0x02}, target: new(userV3), wantErr: ErrBinaryFormat},
+		{name: "fail - truncated version", data: []byte{binaryMagic, 0x80}, target: new(userV3), wantErr: ErrBinaryFormat},
+		{name: "fail - version overflow", data: []byte{binaryMagic, 0xff, 0xff, 0xff, 0xff, 0x7f}, target: new(userV3), wantErr: ErrBinaryFormat},
+		{name: "fail - older without migrations", data: valid, target: new(map[string]int), version: 301, wantErr: ErrBinaryVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := UnmarshalBinary(tt.data, tt.target, tt.version)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UnmarshalBinary() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := UnmarshalBinary(valid, map[string]int{}, 300); err == nil {
+		t.Errorf("UnmarshalBinary() error = nil, want an error for a non pointer")
+	}
+}
+
+func TestRegisterMigrationTypes(t *testing.T) {
+	type target struct{ N int }
+
+	RegisterMigration[target](1, func(n int) (string, error) { return "x", nil })
+	RegisterMigration[target](2, func(n int) (target, error) { return target{N: n}, nil })
+	RegisterMigration[target](5, func(n int) (int, error) { return n, nil })
+
+	v1, _ := MarshalBinary(7, 1)
+	v5, _ := MarshalBinary(7, 5)
+
+	var got target
+	if err := UnmarshalBinary(v1, &got, 3); err == nil || !strings.Contains(err.Error(), "takes int, got string") {
+		t.Errorf("UnmarshalBinary() error = %v, want a type mismatch", err)
+	}
+
+	if err := UnmarshalBinary(v5, &got, 6); err == nil || !strings.Contains(err.Error(), "returns int") {
+		t.Errorf("UnmarshalBinary() error = %v, want a wrong result type", err)
+	}
+
+	if !reflect.DeepEqual(got, target{}) {
+		t.Errorf("UnmarshalBinary() modified the target on error: %+v", got)
+	}
+}
@@ -0,0 +1,262 @@
+package encode
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Format is a configuration file format.
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+	FormatTOML Format = "toml"
+)
+
+// ConfigError reports a configuration that could not be decoded, with the position of the error when known.
+type ConfigError struct {
+	Path   string // Path of the file, empty for DecodeConfigData
+	Line   int    // Line of the error starting at 1, or 0 when unknown
+	Column int    // Column of the error starting at 1, or 0 when unknown
+	Err    error
+}
+
+func (e *ConfigError) Error() string {
+	var pos []string
+	if e.Path != "" {
+		pos = append(pos, e.Path)
+	}
+
+	if e.Line > 0 {
+		pos = append(pos, strconv.Itoa(e.Line))
+
+		if e.Column > 0 {
+			pos = append(pos, strconv.Itoa(e.Column))
+		}
+	}
+
+	if len(pos) == 0 {
+		return "encode: " + e.Err.Error()
+	}
+
+	return "encode: " + strings.Join(pos, ":") + ": " + e.Err.Error()
+}
+
+func (e *ConfigError) Unwrap() error {
+	return e.Err
+}
+
+/*
+DecodeConfig decodes the JSON, YAML or TOML file at path into the struct v points to. The format is detected
+by DetectFormat.
+
+The three formats are decoded with the same rules, those of encoding/json: keys match the `json` tags of the
+fields, or their names ignoring case, so a single set of tags serves every format. Keys without matching field
+are rejected to catch typos. Errors are reported as *ConfigError, with the line of syntax errors and the path
+of the field for type errors, such as:
+
+	encode: config.yaml: json: cannot unmarshal string into Go struct field Config.server.port of type int
+*/
+func DecodeConfig(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("encode: %w", err)
+	}
+
+	err = DecodeConfigData(data, DetectFormat(path, data), v)
+
+	var cfgErr *ConfigError
+	if errors.As(err, &cfgErr) {
+		cfgErr.Path = path
+	}
+
+	return err
+}
+
+// DecodeConfigData decodes data in format into the struct v points to, like DecodeConfig.
+func DecodeConfigData(data []byte, format Format, v interface{}) error {
+	switch format {
+	case FormatJSON:
+		return decodeJSONConfig(data, v)
+	case FormatYAML, FormatTOML:
+		generic, err := decodeGeneric(data, format)
+		if err != nil {
+			return err
+		}
+
+		b, err := json.Marshal(generic)
+		if err != nil {
+			return &ConfigError{Err: err}
+		}
+
+		if err := decodeJSONConfig(b, v); err != nil {
+			var cfgErr *ConfigError
+			if errors.As(err, &cfgErr) {
+				cfgErr.Line, cfgErr.Column = 0, 0 // Positions of the intermediate JSON are meaningless
+			}
+
+			return err
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("encode: unsupported config format %q", format)
+	}
+}
+
+/*
+DetectFormat returns the format of a configuration file from the extension of path: .json, .yaml, .yml or .toml.
+For other extensions it inspects data: documents starting with '{' are JSON, documents whose first line is a
+`[table]` header or a `key = value` pair are TOML, and anything else is YAML.
+*/
+func DetectFormat(path string, data []byte) Format {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return FormatJSON
+	case ".yaml", ".yml":
+		return FormatYAML
+	case ".toml":
+		return FormatTOML
+	}
+
+	trimmed := bytes.TrimSpace(bytes.TrimPrefix(data, []byte("\ufeff")))
+	if bytes.HasPrefix(trimmed, []byte("{")) {
+		return FormatJSON
+	}
+
+	for _, line := range strings.Split(string(trimmed), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' || line == "---" {
+			continue
+		}
+
+		if tomlLine.MatchString(line) {
+			return FormatTOML
+		}
+
+		break
+	}
+
+	return FormatYAML
+}
+
+var (
+	// tomlLine matches the table headers and the key/value pairs of TOML, with bare, quoted or dotted keys.
+	tomlLine = regexp.MustCompile(`^(\[\[?\s*` + tomlKey + `\s*\]\]?\s*(#.*)?|` + tomlKey + `\s*=.*)$`)
+	// yamlLine and tomlLinePrefix match the position in the errors of the yaml and toml packages.
+	yamlLine       = regexp.MustCompile(`^yaml: line (\d+): `)
+	tomlLinePrefix = regexp.MustCompile(`^toml: line \d+( \(last key "[^"]*"\))?: `)
+)
+
+const tomlKey = `(?:[\w-]+|"[^"]*"|'[^']*')(?:\s*\.\s*(?:[\w-]+|"[^"]*"|'[^']*'))*`
+
+func decodeJSONConfig(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	err := dec.Decode(v)
+	if err == nil {
+		if _, err = dec.Token(); err == io.EOF {
+			return nil
+		}
+
+		err = errors.New("unexpected data after the value")
+	}
+
+	cfgErr := &ConfigError{Err: err}
+
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		cfgErr.Line, cfgErr.Column = position(data, syntaxErr.Offset-1) // Offset is after the invalid byte
+	case errors.As(err, &typeErr):
+		cfgErr.Line, cfgErr.Column = position(data, typeErr.Offset)
+	}
+
+	return cfgErr
+}
+
+// decodeGeneric decodes YAML or TOML data into generic values that can be encoded as JSON.
+func decodeGeneric(data []byte, format Format) (interface{}, error) {
+	if format == FormatTOML {
+		var m map[string]interface{}
+		if err := toml.Unmarshal(data, &m); err != nil {
+			var parseErr toml.ParseError
+			if errors.As(err, &parseErr) {
+				msg := tomlLinePrefix.ReplaceAllString(parseErr.Error(), "")
+
+				return nil, &ConfigError{Line: parseErr.Position.Line, Err: errors.New(msg)}
+			}
+
+			return nil, &ConfigError{Err: err}
+		}
+
+		return m, nil
+	}
+
+	var v interface{}
+	if err := yaml.Unmarshal(data, &v); err != nil {
+		if m := yamlLine.FindStringSubmatch(err.Error()); m != nil {
+			line, _ := strconv.Atoi(m[1])
+
+			return nil, &ConfigError{Line: line, Err: errors.New(strings.TrimPrefix(err.Error(), m[0]))}
+		}
+
+		return nil, &ConfigError{Err: err}
+	}
+
+	return normalizeYAML(v), nil
+}
+
+// normalizeYAML converts the maps with non string keys decoded by yaml, which JSON cannot encode.
+func normalizeYAML(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = normalizeYAML(e)
+		}
+
+		return v
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[fmt.Sprint(k)] = normalizeYAML(e)
+		}
+
+		return m
+	case []interface{}:
+		for i, e := range v {
+			v[i] = normalizeYAML(e)
+		}
+
+		return v
+	default:
+		return v
+	}
+}
+
+// position returns the line and column of the byte at offset.
+func position(data []byte, offset int64) (line, column int) {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+
+	before := data[:offset]
+	line = bytes.Count(before, []byte("\n")) + 1
+	column = len(before) - bytes.LastIndexByte(before, '\n')
+
+	return line, column
+}
@@ -0,0 +1,204 @@
+package encode
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type Server struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+}
+
+type Config struct {
+	Name     string            `json:"name"`
+	Debug    bool              `json:"debug"`
+	Ratio    float64           `json:"ratio"`
+	Servers  []Server          `json:"servers"`
+	Labels   map[string]string `json:"labels"`
+	Started  time.Time         `json:"started"`
+	LogLevel string            // Matched by its name, ignoring case
+}
+
+var wantConfig = Config{
+	Name:     "api",
+	Debug:    true,
+	Ratio:    0.5,
+	Servers:  []Server{{Host: "a.example.com", Port: 8080}, {Host: "b.example.com", Port: 8081}},
+	Labels:   map[string]string{"team": "core", "1": "one"},
+	Started:  time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC),
+	LogLevel: "info",
+}
+
+const (
+	jsonConfig = `{
+  "name": "api",
+  "debug": true,
+  "ratio": 0.5,
+  "servers": [{"host": "a.example.com", "port": 8080}, {"host": "b.example.com", "port": 8081}],
+  "labels": {"team": "core", "1": "one"},
+  "started": "2024-03-15T10:30:00Z",
+  "loglevel": "info"
+}`
+
+	yamlConfig = `# service
+name: api
+debug: true
+ratio: 0.5
+servers:
+  - host: a.example.com
+    port: 8080
+  - host: b.example.com
+    port: 8081
+labels:
+  team: core
+  1: one
+started: 2024-03-15T10:30:00Z
+logLevel: info
+`
+
+	tomlConfig = `# service
+name = "api"
+debug = true
+ratio = 0.5
+started = 2024-03-15T10:30:00Z
+LOGLEVEL = "info"
+
+[labels]
+team = "core"
+1 = "one"
+
+[[servers]]
+host = "a.example.com"
+port = 8080
+
+[[servers]]
+host = "b.example.com"
+port = 8081
+`
+)
+
+func TestDecodeConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name string
+		file string
+		data string
+	}{
+		{name: "success - json", file: "config.json", data: jsonConfig},
+		{name: "success - yaml", file: "config.yaml", data: yamlConfig},
+		{name: "success - yml", file: "config.yml", data: yamlConfig},
+		{name: "success - toml", file: "config.toml", data: tomlConfig},
+		{name: "success - detected json", file: "config", data: jsonConfig},
+		{name: "success - detected yaml", file: "config.conf", data: yamlConfig},
+		{name: "success - detected toml", file: "config.cfg", data: tomlConfig},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.file)
+			if err := os.WriteFile(path, []byte(tt.data), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			var got Config
+			if err := DecodeConfig(path, &got); err != nil {
+				t.Fatalf("DecodeConfig() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, wantConfig) {
+				t.Errorf("DecodeConfig() = %+v, want %+v", got, wantConfig)
+			}
+		})
+	}
+}
+
+func TestDecodeConfigErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   Format
+		data     string
+		wantLine int
+		wantErr  string
+	}{
+		{name: "fail - json syntax", format: FormatJSON, data: "{\n  \"name\": \"api\",\n  \"port\" 80\n}", wantLine: 3, wantErr: "encode: 3:10: invalid character '8'"},
+		{name: "fail - json type", format: FormatJSON, data: "{\n  \"servers\": [{\"port\": \"80\"}]\n}", wantLine: 2, wantErr: "port of type int"},
+		{name: "fail - json unknown field", format: FormatJSON, data: `{"nmae": "api"}`, wantErr: `unknown field "nmae"`},
+		{name: "fail - json trailing data", format: FormatJSON, data: `{} {}`, wantErr: "after the value"},
+		{name: "fail - yaml syntax", format: FormatYAML, data: "name: api\nservers:\n  - host: a\n port: 80\n", wantLine: 3, wantErr: "encode: 3: did not find expected key"},
+		{name: "fail - yaml type", format: FormatYAML, data: "servers:\n  - port: eighty\n", wantErr: "port of type int"},
+		{name: "fail - yaml unknown field", format: FormatYAML, data: "name: api\nnmae: api\n", wantErr: `unknown field "nmae"`},
+		{name: "fail - toml syntax", format: FormatTOML, data: "name = \"api\"\nport = 8o\n", wantLine: 2, wantErr: "encode: 2: "},
+		{name: "fail - toml type", format: FormatTOML, data: "debug = \"yes\"\n", wantErr: "Config.debug of type bool"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Config
+			err := DecodeConfigData([]byte(tt.data), tt.format, &got)
+
+			var cfgErr *ConfigError
+			if !errors.As(err, &cfgErr) {
+				t.Fatalf("DecodeConfigData() error = %v, want a *ConfigError", err)
+			}
+			if cfgErr.Line != tt.wantLine {
+				t.Errorf("DecodeConfigData() line = %d, want %d", cfgErr.Line, tt.wantLine)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("DecodeConfigData() error = %q, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecodeConfigPath(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.yaml")
+	if err := os.WriteFile(path, []byte("debug: maybe\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var got Config
+	err := DecodeConfig(path, &got)
+	if err == nil || !strings.HasPrefix(err.Error(), "encode: "+path+": ") {
+		t.Errorf("DecodeConfig() error = %v, want the path of the file", err)
+	}
+
+	if err := DecodeConfig(filepath.Join(t.TempDir(), "missing.json"), &got); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("DecodeConfig() error = %v, want %v", err, os.ErrNotExist)
+	}
+
+	if err := DecodeConfigData([]byte("a: 1"), Format("ini"), &got); err == nil {
+		t.Errorf("DecodeConfigData() error = nil, want an error for an unsupported format")
+	}
+}
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		data string
+		want Format
+	}{
+		{name: "success - json extension", path: "a.JSON", data: "name: x", want: FormatJSON},
+		{name: "success - yaml extension", path: "a.yml", want: FormatYAML},
+		{name: "success - toml extension", path: "/etc/app/a.toml", want: FormatTOML},
+		{name: "success - json content", path: "a", data: " \n{\"a\": 1}", want: FormatJSON},
+		{name: "success - toml table", path: "a", data: "# comment\n\n[server]\nport = 80", want: FormatTOML},
+		{name: "success - toml array of tables", path: "a", data: "[[servers]]\nport = 80", want: FormatTOML},
+		{name: "success - toml pair", path: "a", data: "name = \"x\"", want: FormatTOML},
+		{name: "success - toml quoted key", path: "a", data: "\"my key\" = 1", want: FormatTOML},
+		{name: "success - yaml mapping", path: "a", data: "---\nname: x = y", want: FormatYAML},
+		{name: "success - yaml sequence", path: "a", data: "- a\n- b", want: FormatYAML},
+		{name: "success - empty", path: "a", want: FormatYAML},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectFormat(tt.path, []byte(tt.data)); got != tt.want {
+				t.Errorf("DetectFormat() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
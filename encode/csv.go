@@ -0,0 +1,318 @@
+package encode
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+type csvConfig struct {
+	delimiter rune
+}
+
+// CSVOption configures ReadCSV, ReadCSVFunc, WriteCSV and NewCSVWriter.
+type CSVOption func(*csvConfig)
+
+// WithDelimiter sets the field delimiter, a comma by default, such as ';' or '\t'.
+func WithDelimiter(r rune) CSVOption {
+	return func(c *csvConfig) { c.delimiter = r }
+}
+
+func newCSVConfig(opts []CSVOption) csvConfig {
+	c := csvConfig{delimiter: ','}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return c
+}
+
+// RowError reports a row that could not be read or written.
+type RowError struct {
+	Line   int    // Line of the row, counting the header as line 1
+	Column string // Header of the column, empty for errors about the whole row
+	Err    error
+}
+
+func (e *RowError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("encode: csv line %d: %v", e.Line, e.Err)
+	}
+
+	return fmt.Sprintf("encode: csv line %d, column %q: %v", e.Line, e.Column, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// csvField is a column of a struct type.
+type csvField struct {
+	name  string
+	index []int
+	tag   fieldTag
+}
+
+/*
+ReadCSV reads the rows of r into structs of type T, matching the columns of the header row with `csv` tags:
+
+  - `csv:"name"`: the header of the field, the Go field name by default
+  - `csv:"-"`: the field is skipped
+  - `csv:"created,unix"` or `csv:"day,layout=2006-01-02"`: a time.Time is parsed as Unix seconds or with the
+    layout, which takes the rest of the tag, instead of RFC 3339
+
+Fields support the types of MarshalQuery except slices. Empty cells leave fields at their zero value, columns
+without field are ignored and embedded structs are flattened. A byte order mark before the header is skipped.
+Invalid values are reported as *RowError, along with the rows read before them. ReadCSVFunc reads large files
+without loading every row in memory.
+*/
+func ReadCSV[T any](r io.Reader, opts ...CSVOption) ([]T, error) {
+	var rows []T
+
+	err := ReadCSVFunc(r, func(row T) error {
+		rows = append(rows, row)
+
+		return nil
+	}, opts...)
+
+	return rows, err
+}
+
+// ReadCSVFunc reads r like ReadCSV, calling fn with each row in order. It stops at the first error,
+// including the errors returned by fn, which are wrapped in a *RowError.
+func ReadCSVFunc[T any](r io.Reader, fn func(row T) error, opts ...CSVOption) error {
+	cfg := newCSVConfig(opts)
+
+	fields, err := csvFields(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return err
+	}
+
+	cr := csv.NewReader(r)
+	cr.Comma = cfg.delimiter
+	cr.ReuseRecord = true
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("encode: %w", err)
+	}
+
+	byName := make(map[string]*csvField, len(fields))
+	for i := range fields {
+		byName[fields[i].name] = &fields[i]
+	}
+
+	columns := make([]*csvField, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+
+		columns[i] = byName[strings.TrimSpace(name)]
+	}
+
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("encode: %w", err)
+		}
+
+		line, _ := cr.FieldPos(0)
+
+		var row T
+		rv := reflect.ValueOf(&row).Elem()
+
+		for i, value := range record {
+			f := columns[i]
+			if f == nil || value == "" {
+				continue
+			}
+
+			if err := parseValue(fieldByIndex(rv, f.index), value, f.tag); err != nil {
+				return &RowError{Line: line, Column: f.name, Err: err}
+			}
+		}
+
+		if err := fn(row); err != nil {
+			return &RowError{Line: line, Err: err}
+		}
+	}
+}
+
+// WriteCSV writes a header row and rows to w, following the tags described in ReadCSV. Fields tagged
+// omitempty are written as empty cells when they have their zero value, and nil pointers are always empty.
+func WriteCSV[T any](w io.Writer, rows []T, opts ...CSVOption) error {
+	cw, err := NewCSVWriter[T](w, opts...)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	return cw.Flush()
+}
+
+// CSVWriter writes structs of type T as rows, for files too large to hold in memory.
+type CSVWriter[T any] struct {
+	w      *csv.Writer
+	fields []csvField
+	record []string
+	line   int
+}
+
+// NewCSVWriter returns a writer of rows of type T to w, after writing the header row.
+// Rows are buffered until Flush is called.
+func NewCSVWriter[T any](w io.Writer, opts ...CSVOption) (*CSVWriter[T], error) {
+	cfg := newCSVConfig(opts)
+
+	fields, err := csvFields(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+
+	cw := &CSVWriter[T]{w: csv.NewWriter(w), fields: fields, record: make([]string, len(fields)), line: 1}
+	cw.w.Comma = cfg.delimiter
+
+	for i, f := range fields {
+		cw.record[i] = f.name
+	}
+
+	if err := cw.w.Write(cw.record); err != nil {
+		return nil, fmt.Errorf("encode: %w", err)
+	}
+
+	return cw, nil
+}
+
+// Write writes row, returning a *RowError when a field cannot be formatted.
+func (w *CSVWriter[T]) Write(row T) error {
+	w.line++
+
+	rv := reflect.ValueOf(row)
+	for i, f := range w.fields {
+		v := indirect(fieldByIndexNoAlloc(rv, f.index))
+		if !v.IsValid() || (f.tag.omitEmpty && isEmpty(v)) {
+			w.record[i] = ""
+
+			continue
+		}
+
+		s, err := formatValue(v, f.tag)
+		if err != nil {
+			return &RowError{Line: w.line, Column: f.name, Err: err}
+		}
+
+		w.record[i] = s
+	}
+
+	if err := w.w.Write(w.record); err != nil {
+		return fmt.Errorf("encode: %w", err)
+	}
+
+	return nil
+}
+
+// Flush writes the buffered rows to the underlying writer.
+func (w *CSVWriter[T]) Flush() error {
+	w.w.Flush()
+
+	if err := w.w.Error(); err != nil {
+		return fmt.Errorf("encode: %w", err)
+	}
+
+	return nil
+}
+
+// csvFields returns the columns of the struct type t.
+func csvFields(t reflect.Type) ([]csvField, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("encode: %s is not a struct", t)
+	}
+
+	var fields []csvField
+
+	var walk func(t reflect.Type, index []int)
+	walk = func(t reflect.Type, index []int) {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			fieldIndex := append(append([]int(nil), index...), i)
+			if isEmbeddedStruct(field, "csv") {
+				walk(indirectType(field.Type), fieldIndex)
+
+				continue
+			}
+
+			if tag, ok := parseTag(field, "csv"); ok {
+				fields = append(fields, csvField{name: tag.name, index: fieldIndex, tag: tag})
+			}
+		}
+	}
+	walk(t, nil)
+
+	return fields, nil
+}
+
+// fieldByIndex returns the nested field of v, allocating the nil embedded pointers on its path.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for _, i := range index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+
+			v = v.Elem()
+		}
+
+		v = v.Field(i)
+	}
+
+	return v
+}
+
+// fieldByIndexNoAlloc returns the nested field of v, or the zero Value when an embedded pointer on its path is nil.
+func fieldByIndexNoAlloc(v reflect.Value, index []int) reflect.Value {
+	for _, i := range index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+
+			v = v.Elem()
+		}
+
+		v = v.Field(i)
+	}
+
+	return v
+}
+
+// indirect dereferences pointers, returning the zero Value for nil ones.
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+
+		v = v.Elem()
+	}
+
+	return v
+}
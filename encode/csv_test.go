@@ -0,0 +1,197 @@
+package encode
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type Audit struct {
+	CreatedBy string `csv:"created_by"`
+}
+
+type User struct {
+	ID      int       `csv:"id"`
+	Name    string    `csv:"name"`
+	Email   *string   `csv:"email"`
+	Admin   bool      `csv:"admin"`
+	Balance float64   `csv:"balance,omitempty"`
+	Joined  time.Time `csv:"joined,layout=2006-01-02"`
+	Notes   string    `csv:"-"`
+	*Audit
+}
+
+func TestReadCSV(t *testing.T) {
+	email := "ana@example.com"
+	joined := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		input   string
+		opts    []CSVOption
+		want    []User
+		wantErr string
+	}{
+		{
+			name:  "success - header order and extra columns",
+			input: "\ufeffname,id,unknown,email,admin,balance,joined,created_by\nAna,1,x,ana@example.com,true,10.5,2024-01-31,root\nBob,2,,,,,,\n",
+			want: []User{
+				{ID: 1, Name: "Ana", Email: &email, Admin: true, Balance: 10.5, Joined: joined, Audit: &Audit{CreatedBy: "root"}},
+				{ID: 2, Name: "Bob"},
+			},
+		},
+		{
+			name:  "success - delimiter and quotes",
+			input: "id;name\n3;\"Silva; Ana\"\n",
+			opts:  []CSVOption{WithDelimiter(';')},
+			want:  []User{{ID: 3, Name: "Silva; Ana"}},
+		},
+		{name: "success - empty input", input: ""},
+		{name: "success - header only", input: "id,name\n"},
+		{name: "fail - invalid number", input: "id,name\n1,Ana\nx,Bob\n", wantErr: `encode: csv line 3, column "id": strconv.ParseInt: parsing "x": invalid syntax`},
+		{name: "fail - invalid time", input: "id,joined\n1,31/01/2024\n", wantErr: `csv line 2, column "joined"`},
+		{name: "fail - wrong number of fields", input: "id,name\n1\n", wantErr: "wrong number of fields"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadCSV[User](strings.NewReader(tt.input), tt.opts...)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ReadCSV() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReadCSV() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadCSV() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadCSVRowError(t *testing.T) {
+	_, err := ReadCSV[User](strings.NewReader("id,admin\n1,true\n2,maybe\n"))
+
+	var rowErr *RowError
+	if !errors.As(err, &rowErr) {
+		t.Fatalf("ReadCSV() error = %v, want a *RowError", err)
+	}
+
+	if rowErr.Line != 3 || rowErr.Column != "admin" {
+		t.Errorf("RowError = line %d, column %q, want line 3, column %q", rowErr.Line, rowErr.Column, "admin")
+	}
+}
+
+func TestReadCSVFunc(t *testing.T) {
+	errStop := errors.New("stop")
+
+	var ids []int
+	err := ReadCSVFunc(strings.NewReader("id\n1\n2\n3\n"), func(u User) error {
+		if u.ID == 3 {
+			return errStop
+		}
+
+		ids = append(ids, u.ID)
+
+		return nil
+	})
+
+	if !errors.Is(err, errStop) {
+		t.Fatalf("ReadCSVFunc() error = %v, want %v", err, errStop)
+	}
+
+	if !reflect.DeepEqual(ids, []int{1, 2}) {
+		t.Errorf("ReadCSVFunc() rows = %v, want [1 2]", ids)
+	}
+
+	if err := ReadCSVFunc(strings.NewReader("a\n"), func(int) error { return nil }); err == nil {
+		t.Errorf("ReadCSVFunc() error = nil, want an error for a non struct type")
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	email := "ana@example.com"
+	rows := []User{
+		{ID: 1, Name: "Ana", Email: &email, Admin: true, Balance: 10.5, Joined: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), Notes: "x", Audit: &Audit{CreatedBy: "root"}},
+		{ID: 2, Name: "Silva, Bob"},
+	}
+
+	tests := []struct {
+		name string
+		opts []CSVOption
+		want string
+	}{
+		{
+			name: "success - comma",
+			want: "id,name,email,admin,balance,joined,created_by\n" +
+				"1,Ana,ana@example.com,true,10.5,2024-01-31,root\n" +
+				"2,\"Silva, Bob\",,false,,0001-01-01,\n",
+		},
+		{
+			name: "success - tab",
+			opts: []CSVOption{WithDelimiter('\t')},
+			want: "id\tname\temail\tadmin\tbalance\tjoined\tcreated_by\n" +
+				"1\tAna\tana@example.com\ttrue\t10.5\t2024-01-31\troot\n" +
+				"2\tSilva, Bob\t\tfalse\t\t0001-01-01\t\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteCSV(&buf, rows, tt.opts...); err != nil {
+				t.Fatalf("WriteCSV() error = %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("WriteCSV() = %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	type row struct {
+		Name  string
+		Codes []int `csv:"codes"`
+	}
+
+	var buf bytes.Buffer
+
+	w, err := NewCSVWriter[row](&buf)
+	if err != nil {
+		t.Fatalf("NewCSVWriter() error = %v", err)
+	}
+
+	if err := w.Write(row{Name: "a"}); err == nil {
+		t.Fatalf("Write() error = nil, want an error for a slice field")
+	} else if rowErr := (*RowError)(nil); !errors.As(err, &rowErr) || rowErr.Line != 2 || rowErr.Column != "codes" {
+		t.Errorf("Write() error = %v, want a *RowError on line 2, column codes", err)
+	}
+
+	if _, err := NewCSVWriter[string](&buf); err == nil {
+		t.Errorf("NewCSVWriter() error = nil, want an error for a non struct type")
+	}
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	in := []User{{ID: 1, Name: "Ana\nMaria", Joined: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), Audit: &Audit{CreatedBy: "a"}}}
+
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, in); err != nil {
+		t.Fatalf("WriteCSV() error = %v", err)
+	}
+
+	out, err := ReadCSV[User](&buf)
+	if err != nil {
+		t.Fatalf("ReadCSV() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("round trip = %+v, want %+v", out, in)
+	}
+}
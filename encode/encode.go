@@ -0,0 +1,162 @@
+/*
+Package encode defines compact text encodings for byte slices and integers, such as Base58 and Base62.
+*/
+package encode
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidCharacter is returned when decoding a string with a character outside of the alphabet.
+	ErrInvalidCharacter = errors.New("invalid character")
+	// ErrOverflow is returned when decoding a number that does not fit in an uint64.
+	ErrOverflow = errors.New("value overflows uint64")
+)
+
+// Encoding is a positional radix encoding over an alphabet, such as Base58 or Base62.
+// Byte slices are encoded as big-endian numbers, with each leading zero byte encoded as
+// the first character of the alphabet so that they survive a round trip.
+type Encoding struct {
+	alphabet string
+	index    [256]int8
+}
+
+var (
+	// Base58 is the Bitcoin alphabet, without the look-alike characters 0, O, I and l.
+	Base58 = newEncoding("123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz")
+	// Base62 is the alphanumeric alphabet, in ASCII order so that encoded numbers of the same length
+	// sort like the numbers themselves.
+	Base62 = newEncoding("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz")
+)
+
+func newEncoding(alphabet string) *Encoding {
+	e := &Encoding{alphabet: alphabet}
+	for i := range e.index {
+		e.index[i] = -1
+	}
+
+	for i := 0; i < len(alphabet); i++ {
+		e.index[alphabet[i]] = int8(i)
+	}
+
+	return e
+}
+
+// Encode returns the encoding of b.
+func (e *Encoding) Encode(b []byte) string {
+	radix := len(e.alphabet)
+
+	zeros := 0
+	for zeros < len(b) && b[zeros] == 0 {
+		zeros++
+	}
+
+	// digits holds the number in the target radix, least significant first
+	digits := make([]byte, 0, len(b)*138/100+1)
+	for _, c := range b[zeros:] {
+		carry := int(c)
+		for i := range digits {
+			carry += int(digits[i]) << 8
+			digits[i] = byte(carry % radix)
+			carry /= radix
+		}
+
+		for carry > 0 {
+			digits = append(digits, byte(carry%radix))
+			carry /= radix
+		}
+	}
+
+	out := make([]byte, zeros+len(digits))
+	for i := 0; i < zeros; i++ {
+		out[i] = e.alphabet[0]
+	}
+
+	for i, d := range digits {
+		out[len(out)-1-i] = e.alphabet[d]
+	}
+
+	return string(out)
+}
+
+// Decode returns the bytes encoded by s. The empty string decodes to an empty slice.
+func (e *Encoding) Decode(s string) ([]byte, error) {
+	radix := len(e.alphabet)
+
+	zeros := 0
+	for zeros < len(s) && s[zeros] == e.alphabet[0] {
+		zeros++
+	}
+
+	// digits holds the number in base 256, least significant first
+	digits := make([]byte, 0, len(s)*733/1000+1)
+	for i := zeros; i < len(s); i++ {
+		v := e.index[s[i]]
+		if v < 0 {
+			return nil, fmt.Errorf("%w %q at offset %d", ErrInvalidCharacter, s[i], i)
+		}
+
+		carry := int(v)
+		for j := range digits {
+			carry += int(digits[j]) * radix
+			digits[j] = byte(carry)
+			carry >>= 8
+		}
+
+		for carry > 0 {
+			digits = append(digits, byte(carry))
+			carry >>= 8
+		}
+	}
+
+	out := make([]byte, zeros+len(digits))
+	for i, d := range digits {
+		out[len(out)-1-i] = d
+	}
+
+	return out, nil
+}
+
+// EncodeUint64 returns the encoding of n, without padding. Zero is encoded as the first character of the alphabet.
+func (e *Encoding) EncodeUint64(n uint64) string {
+	radix := uint64(len(e.alphabet))
+
+	var buf [64]byte
+	i := len(buf)
+	for {
+		i--
+		buf[i] = e.alphabet[n%radix]
+		n /= radix
+
+		if n == 0 {
+			return string(buf[i:])
+		}
+	}
+}
+
+// DecodeUint64 returns the number encoded by s, as produced by EncodeUint64. Leading zero characters are allowed.
+func (e *Encoding) DecodeUint64(s string) (uint64, error) {
+	if s == "" {
+		return 0, fmt.Errorf("%w: empty string", ErrInvalidCharacter)
+	}
+
+	radix := uint64(len(e.alphabet))
+
+	var n uint64
+	for i := 0; i < len(s); i++ {
+		v := e.index[s[i]]
+		if v < 0 {
+			return 0, fmt.Errorf("%w %q at offset %d", ErrInvalidCharacter, s[i], i)
+		}
+
+		if n > (1<<64-1-uint64(v))/radix {
+			return 0, fmt.Errorf("%w: %q", ErrOverflow, s)
+		}
+
+		n = n*radix + uint64(v)
+	}
+
+	return n, nil
+}
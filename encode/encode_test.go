@@ -0,0 +1,142 @@
+package encode
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/kashifkhan0771/utils/rand"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding *Encoding
+		input    []byte
+		want     string
+	}{
+		{name: "success - base58 empty", encoding: Base58, input: []byte{}, want: ""},
+		{name: "success - base58 text", encoding: Base58, input: []byte("Hello World!"), want: "2NEpo7TZRRrLZSi2U"},
+		{name: "success - base58 leading zeros", encoding: Base58, input: []byte{0, 0, 0x28, 0x7f, 0xb4, 0xcd}, want: "11233QC4"},
+		{name: "success - base58 only zeros", encoding: Base58, input: []byte{0, 0}, want: "11"},
+		{name: "success - base62 text", encoding: Base62, input: []byte("Hello World!"), want: "T8dgcjRGkZ3aysdN"},
+		{name: "success - base62 leading zero", encoding: Base62, input: []byte{0, 0xff, 0xff}, want: "0H31"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.encoding.Encode(tt.input); got != tt.want {
+				t.Errorf("Encode() = %q, want %q", got, tt.want)
+			}
+
+			got, err := tt.encoding.Decode(tt.want)
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if !bytes.Equal(got, tt.input) {
+				t.Errorf("Decode() = %v, want %v", got, tt.input)
+			}
+		})
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding *Encoding
+		input    string
+	}{
+		{name: "fail - base58 zero", encoding: Base58, input: "2N0po"},
+		{name: "fail - base58 capital o", encoding: Base58, input: "O"},
+		{name: "fail - base62 symbol", encoding: Base62, input: "abc-def"},
+		{name: "fail - non ascii", encoding: Base62, input: "abcé"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.encoding.Decode(tt.input); !errors.Is(err, ErrInvalidCharacter) {
+				t.Errorf("Decode() error = %v, want %v", err, ErrInvalidCharacter)
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, e := range []*Encoding{Base58, Base62} {
+		for n := 0; n < 64; n++ {
+			b, err := rand.Bytes(n)
+			if err != nil {
+				t.Fatalf("rand.Bytes() error = %v", err)
+			}
+			if n > 2 {
+				b[0] = 0
+			}
+
+			got, err := e.Decode(e.Encode(b))
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if !bytes.Equal(got, b) {
+				t.Errorf("Decode(Encode(%x)) = %x", b, got)
+			}
+		}
+	}
+}
+
+func TestEncodeUint64(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding *Encoding
+		input    uint64
+		want     string
+	}{
+		{name: "success - base58 zero", encoding: Base58, input: 0, want: "1"},
+		{name: "success - base58 radix", encoding: Base58, input: 58, want: "21"},
+		{name: "success - base58 max", encoding: Base58, input: math.MaxUint64, want: "jpXCZedGfVQ"},
+		{name: "success - base62 zero", encoding: Base62, input: 0, want: "0"},
+		{name: "success - base62 last digit", encoding: Base62, input: 61, want: "z"},
+		{name: "success - base62 radix", encoding: Base62, input: 62, want: "10"},
+		{name: "success - base62 max", encoding: Base62, input: math.MaxUint64, want: "LygHa16AHYF"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.encoding.EncodeUint64(tt.input); got != tt.want {
+				t.Errorf("EncodeUint64() = %q, want %q", got, tt.want)
+			}
+
+			got, err := tt.encoding.DecodeUint64(tt.want)
+			if err != nil {
+				t.Fatalf("DecodeUint64() error = %v", err)
+			}
+			if got != tt.input {
+				t.Errorf("DecodeUint64() = %d, want %d", got, tt.input)
+			}
+		})
+	}
+}
+
+func TestDecodeUint64(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding *Encoding
+		input    string
+		want     uint64
+		wantErr  error
+	}{
+		{name: "success - leading zeros", encoding: Base62, input: "0010", want: 62},
+		{name: "fail - empty", encoding: Base62, input: "", wantErr: ErrInvalidCharacter},
+		{name: "fail - invalid character", encoding: Base58, input: "1l", wantErr: ErrInvalidCharacter},
+		{name: "fail - overflow by one", encoding: Base62, input: "LygHa16AHYG", wantErr: ErrOverflow},
+		{name: "fail - overflow by length", encoding: Base58, input: "111jpXCZedGfVQ1", wantErr: ErrOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.encoding.DecodeUint64(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DecodeUint64() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DecodeUint64() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package encode
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	// dumpWidth is the number of bytes per line of HexDump and DiffBytes.
+	dumpWidth = 16
+	// maxDiffRows bounds the number of differing lines shown by DiffBytes.
+	maxDiffRows = 8
+)
+
+// HexDump returns b in the format of xxd: lines of 16 bytes made of the offset, the bytes in hexadecimal
+// grouped by two, and the printable ASCII characters, with dots for the others.
+//
+//	00000000: 4865 6c6c 6f20 576f 726c 6421 0a00 01ff  Hello World!....
+func HexDump(b []byte) string {
+	var sb strings.Builder
+	for offset := 0; offset < len(b); offset += dumpWidth {
+		writeDumpLine(&sb, dumpRow(b, offset), offset)
+	}
+
+	return sb.String()
+}
+
+/*
+DiffBytes returns a description of the differences between a and b, or the empty string when they are equal.
+It starts with the first differing offset, then dumps the lines holding differences in the format of HexDump,
+the line of a prefixed with "-" and the line of b with "+", followed by "^^" markers under each differing byte.
+Equal lines around them are shown once for context, and at most 8 differing lines are shown. Bytes present
+in only one slice count as differences.
+
+	first difference at offset 11 (0xb), 1 differing bytes, len(a) = 13, len(b) = 13
+	- 00000000: 4865 6c6c 6f20 576f 726c 6421 0a         Hello World!.
+	+ 00000000: 4865 6c6c 6f20 576f 726c 643f 0a         Hello World?.
+	                                       ^^
+*/
+func DiffBytes(a, b []byte) string {
+	if bytes.Equal(a, b) {
+		return ""
+	}
+
+	n := len(a)
+	if len(b) > n {
+		n = len(b)
+	}
+
+	differs := func(i int) bool {
+		return i >= len(a) || i >= len(b) || a[i] != b[i]
+	}
+
+	first, count := -1, 0
+	diffRows := make(map[int]bool)
+
+	var shown []int
+	for i := 0; i < n; i++ {
+		if !differs(i) {
+			continue
+		}
+
+		if first < 0 {
+			first = i
+		}
+
+		count++
+
+		if row := i / dumpWidth; !diffRows[row] {
+			diffRows[row] = true
+			shown = append(shown, row)
+		}
+	}
+
+	hidden := 0
+	if len(shown) > maxDiffRows {
+		hidden, shown = len(shown)-maxDiffRows, shown[:maxDiffRows]
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "first difference at offset %d (0x%x), %d differing bytes, len(a) = %d, len(b) = %d\n",
+		first, first, count, len(a), len(b))
+
+	last := -1
+	for _, row := range shown {
+		for r := row - 1; r <= row+1; r++ {
+			if r <= last || r < 0 || r*dumpWidth >= n || (r != row && diffRows[r]) {
+				continue // Already written, out of range, or a differing line beyond maxDiffRows
+			}
+
+			if last >= 0 && r > last+1 {
+				sb.WriteString("...\n")
+			}
+
+			last = r
+			offset := r * dumpWidth
+
+			if r != row {
+				sb.WriteString("  ")
+				writeDumpLine(&sb, dumpRow(a, offset), offset)
+
+				continue
+			}
+
+			sb.WriteString("- ")
+			writeDumpLine(&sb, dumpRow(a, offset), offset)
+			sb.WriteString("+ ")
+			writeDumpLine(&sb, dumpRow(b, offset), offset)
+
+			markers := []byte(strings.Repeat(" ", len("  00000000: ")))
+			for i := 0; i < dumpWidth; i++ {
+				if offset+i < n && differs(offset+i) {
+					markers = append(markers, '^', '^')
+				} else {
+					markers = append(markers, ' ', ' ')
+				}
+
+				if i%2 == 1 {
+					markers = append(markers, ' ')
+				}
+			}
+
+			sb.Write(bytes.TrimRight(markers, " "))
+			sb.WriteByte('\n')
+		}
+	}
+
+	if hidden > 0 {
+		fmt.Fprintf(&sb, "... %d more differing lines\n", hidden)
+	}
+
+	return sb.String()
+}
+
+// dumpRow returns the bytes of b on the line starting at offset, empty when b is shorter.
+func dumpRow(b []byte, offset int) []byte {
+	if offset >= len(b) {
+		return nil
+	}
+
+	end := offset + dumpWidth
+	if end > len(b) {
+		end = len(b)
+	}
+
+	return b[offset:end]
+}
+
+func writeDumpLine(sb *strings.Builder, row []byte, offset int) {
+	fmt.Fprintf(sb, "%08x: ", offset)
+
+	for i := 0; i < dumpWidth; i++ {
+		if i < len(row) {
+			fmt.Fprintf(sb, "%02x", row[i])
+		} else {
+			sb.WriteString("  ")
+		}
+
+		if i%2 == 1 && i < dumpWidth-1 {
+			sb.WriteByte(' ')
+		}
+	}
+
+	sb.WriteString("  ")
+
+	for _, c := range row {
+		if c < 0x20 || c > 0x7e {
+			c = '.'
+		}
+
+		sb.WriteByte(c)
+	}
+
+	sb.WriteByte('\n')
+}
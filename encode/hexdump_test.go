@@ -0,0 +1,116 @@
+package encode
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHexDump(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		want  string
+	}{
+		{name: "success - empty", input: nil, want: ""},
+		{name: "success - partial line", input: []byte("Hello"), want: "00000000: 4865 6c6c 6f                             Hello\n"},
+		{
+			name:  "success - full lines",
+			input: []byte("Hello World!\n\x00\x01\xffabcdefghijklmnop"),
+			want: "00000000: 4865 6c6c 6f20 576f 726c 6421 0a00 01ff  Hello World!....\n" +
+				"00000010: 6162 6364 6566 6768 696a 6b6c 6d6e 6f70  abcdefghijklmnop\n",
+		},
+		{name: "success - odd length", input: []byte{0x7e, 0x7f, 0x20}, want: "00000000: 7e7f 20                                  ~. \n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HexDump(tt.input); got != tt.want {
+				t.Errorf("HexDump() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffBytes(t *testing.T) {
+	zeros := make([]byte, 100)
+
+	changed := make([]byte, 98)
+	changed[40], changed[70] = 1, 2
+
+	tests := []struct {
+		name string
+		a, b []byte
+		want string
+	}{
+		{name: "success - equal", a: []byte("abc"), b: []byte("abc"), want: ""},
+		{name: "success - both empty", a: nil, b: []byte{}, want: ""},
+		{
+			name: "success - one byte",
+			a:    []byte("Hello World!\n"),
+			b:    []byte("Hello World?\n"),
+			want: "first difference at offset 11 (0xb), 1 differing bytes, len(a) = 13, len(b) = 13\n" +
+				"- 00000000: 4865 6c6c 6f20 576f 726c 6421 0a         Hello World!.\n" +
+				"+ 00000000: 4865 6c6c 6f20 576f 726c 643f 0a         Hello World?.\n" +
+				"                                       ^^\n",
+		},
+		{
+			name: "success - empty against bytes",
+			a:    nil,
+			b:    []byte{1, 2},
+			want: "first difference at offset 0 (0x0), 2 differing bytes, len(a) = 0, len(b) = 2\n" +
+				"- 00000000:                                          \n" +
+				"+ 00000000: 0102                                     ..\n" +
+				"            ^^^^\n",
+		},
+		{
+			name: "success - context and length",
+			a:    zeros,
+			b:    changed,
+			want: "first difference at offset 40 (0x28), 4 differing bytes, len(a) = 100, len(b) = 98\n" +
+				"  00000010: 0000 0000 0000 0000 0000 0000 0000 0000  ................\n" +
+				"- 00000020: 0000 0000 0000 0000 0000 0000 0000 0000  ................\n" +
+				"+ 00000020: 0000 0000 0000 0000 0100 0000 0000 0000  ................\n" +
+				"                                ^^\n" +
+				"  00000030: 0000 0000 0000 0000 0000 0000 0000 0000  ................\n" +
+				"- 00000040: 0000 0000 0000 0000 0000 0000 0000 0000  ................\n" +
+				"+ 00000040: 0000 0000 0000 0200 0000 0000 0000 0000  ................\n" +
+				"                           ^^\n" +
+				"  00000050: 0000 0000 0000 0000 0000 0000 0000 0000  ................\n" +
+				"- 00000060: 0000 0000                                ....\n" +
+				"+ 00000060: 0000                                     ..\n" +
+				"                 ^^^^\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DiffBytes(tt.a, tt.b); got != tt.want {
+				t.Errorf("DiffBytes() = \n%s, want \n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffBytesGaps(t *testing.T) {
+	a := make([]byte, 16*40)
+	b := make([]byte, len(a))
+	for row := 0; row < 40; row += 4 {
+		b[row*16] = 0xff
+	}
+
+	got := DiffBytes(a, b)
+
+	if !strings.Contains(got, "10 differing bytes") {
+		t.Errorf("DiffBytes() header = %q, want 10 differing bytes", strings.SplitN(got, "\n", 2)[0])
+	}
+
+	if n := strings.Count(got, "\n- "); n != maxDiffRows {
+		t.Errorf("DiffBytes() shows %d differing lines, want %d", n, maxDiffRows)
+	}
+
+	if !strings.Contains(got, "\n...\n") || !strings.HasSuffix(got, "... 2 more differing lines\n") {
+		t.Errorf("DiffBytes() = %s, want gaps and a truncation line", got)
+	}
+
+	if strings.Contains(got, "  00000200:") {
+		t.Errorf("DiffBytes() shows a hidden differing line as context:\n%s", got)
+	}
+}
@@ -0,0 +1,430 @@
+package encode
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	durationType        = reflect.TypeOf(time.Duration(0))
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// fieldTag holds the options of a `query` or `csv` struct tag.
+type fieldTag struct {
+	name      string
+	omitEmpty bool
+	unix      bool
+	layout    string
+}
+
+/*
+MarshalQuery encodes the exported fields of the struct v, or of the struct v points to, as URL query values.
+Fields are configured with `query` tags:
+
+  - `query:"name"`: the key of the field, the Go field name by default
+  - `query:"-"`: the field is skipped
+  - `query:"name,omitempty"`: the field is skipped when it has its zero value or is an empty slice
+  - `query:"since,unix"`: a time.Time is encoded as Unix seconds instead of RFC 3339
+  - `query:"day,layout=2006-01-02"`: a time.Time is encoded with the layout, which takes the rest of the tag
+
+Strings, booleans, numbers, time.Duration, encoding.TextMarshaler implementations and pointers to them are
+supported. Nil pointers are skipped, slices and arrays repeat the key for each element, and embedded structs
+are flattened.
+*/
+func MarshalQuery(v interface{}) (url.Values, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("encode: %T is not a struct", v)
+	}
+
+	values := url.Values{}
+	if err := marshalStruct(rv, values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// UnmarshalQuery decodes values into the struct v points to, following the tags described in MarshalQuery.
+// Fields without values are left unchanged and keys without fields are ignored. Fields that are not slices
+// or arrays take the first value of their key, and nil pointers are allocated as needed.
+func UnmarshalQuery(values url.Values, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("encode: %T is not a non-nil pointer to a struct", v)
+	}
+
+	return unmarshalStruct(rv.Elem(), values)
+}
+
+func marshalStruct(rv reflect.Value, values url.Values) error {
+	t := rv.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fv := rv.Field(i)
+		if isEmbeddedStruct(field, "query") {
+			if fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					continue
+				}
+
+				fv = fv.Elem()
+			}
+
+			if err := marshalStruct(fv, values); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		tag, ok := parseTag(field, "query")
+		if !ok || (tag.omitEmpty && isEmpty(fv)) {
+			continue
+		}
+
+		if err := marshalValue(fv, tag, values); err != nil {
+			return fmt.Errorf("encode: query field %s: %w", field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func marshalValue(v reflect.Value, tag fieldTag, values url.Values) error {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+
+		v = v.Elem()
+	}
+
+	if !isList(v.Type()) {
+		s, err := formatValue(v, tag)
+		if err != nil {
+			return err
+		}
+
+		values.Add(tag.name, s)
+
+		return nil
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		elem := v.Index(i)
+		for elem.Kind() == reflect.Ptr && !elem.IsNil() {
+			elem = elem.Elem()
+		}
+
+		if elem.Kind() == reflect.Ptr {
+			continue // Nil element
+		}
+
+		s, err := formatValue(elem, tag)
+		if err != nil {
+			return err
+		}
+
+		values.Add(tag.name, s)
+	}
+
+	return nil
+}
+
+func formatValue(v reflect.Value, tag fieldTag) (string, error) {
+	switch v.Type() {
+	case timeType:
+		t := v.Interface().(time.Time)
+		switch {
+		case tag.unix:
+			return strconv.FormatInt(t.Unix(), 10), nil
+		case tag.layout != "":
+			return t.Format(tag.layout), nil
+		default:
+			return t.Format(time.RFC3339Nano), nil
+		}
+	case durationType:
+		return time.Duration(v.Int()).String(), nil
+	}
+
+	if m, ok := textMarshaler(v); ok {
+		b, err := m.MarshalText()
+
+		return string(b), err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	}
+
+	return "", fmt.Errorf("unsupported type %s", v.Type())
+}
+
+func unmarshalStruct(rv reflect.Value, values url.Values) error {
+	t := rv.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fv := rv.Field(i)
+		if isEmbeddedStruct(field, "query") {
+			if fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					fv.Set(reflect.New(field.Type.Elem()))
+				}
+
+				fv = fv.Elem()
+			}
+
+			if err := unmarshalStruct(fv, values); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		tag, ok := parseTag(field, "query")
+		if !ok {
+			continue
+		}
+
+		raw := values[tag.name]
+		if len(raw) == 0 {
+			continue
+		}
+
+		if err := unmarshalValue(fv, raw, tag); err != nil {
+			return fmt.Errorf("encode: query field %s: %w", field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func unmarshalValue(v reflect.Value, raw []string, tag fieldTag) error {
+	if !isList(indirectType(v.Type())) {
+		return parseValue(v, raw[0], tag)
+	}
+
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+
+		v = v.Elem()
+	}
+
+	if v.Kind() == reflect.Array {
+		if len(raw) > v.Len() {
+			return fmt.Errorf("%d values do not fit in %s", len(raw), v.Type())
+		}
+	} else {
+		v.Set(reflect.MakeSlice(v.Type(), len(raw), len(raw)))
+	}
+
+	for i, s := range raw {
+		if err := parseValue(v.Index(i), s, tag); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parseValue(v reflect.Value, s string, tag fieldTag) error {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+
+		v = v.Elem()
+	}
+
+	switch v.Type() {
+	case timeType:
+		t, err := parseTime(s, tag)
+		if err != nil {
+			return err
+		}
+
+		v.Set(reflect.ValueOf(t))
+
+		return nil
+	case durationType:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+
+		v.SetInt(int64(d))
+
+		return nil
+	}
+
+	if v.CanAddr() && v.Addr().Type().Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+
+	return nil
+}
+
+func parseTime(s string, tag fieldTag) (time.Time, error) {
+	switch {
+	case tag.unix:
+		sec, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		return time.Unix(sec, 0).UTC(), nil
+	case tag.layout != "":
+		return time.Parse(tag.layout, s)
+	default:
+		return time.Parse(time.RFC3339Nano, s)
+	}
+}
+
+// parseTag returns the options of the field from its tag named key, or false when it is skipped.
+func parseTag(field reflect.StructField, key string) (fieldTag, bool) {
+	tag := field.Tag.Get(key)
+	if tag == "-" {
+		return fieldTag{}, false
+	}
+
+	parts := strings.Split(tag, ",")
+
+	qt := fieldTag{name: parts[0]}
+	if qt.name == "" {
+		qt.name = field.Name
+	}
+
+	for i, opt := range parts[1:] {
+		switch {
+		case opt == "omitempty":
+			qt.omitEmpty = true
+		case opt == "unix":
+			qt.unix = true
+		case strings.HasPrefix(opt, "layout="):
+			qt.layout = strings.TrimPrefix(strings.Join(parts[i+1:], ","), "layout=")
+
+			return qt, true
+		}
+	}
+
+	return qt, true
+}
+
+// isEmbeddedStruct reports whether the fields of field are flattened into its parent, unless it has a tag named key.
+func isEmbeddedStruct(field reflect.StructField, key string) bool {
+	if !field.Anonymous || field.Tag.Get(key) != "" {
+		return false
+	}
+
+	t := indirectType(field.Type)
+
+	return t.Kind() == reflect.Struct && !isScalar(t)
+}
+
+// isList reports whether t is encoded as repeated keys.
+func isList(t reflect.Type) bool {
+	return (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && !isScalar(t)
+}
+
+// isScalar reports whether t is encoded as a single value even though it is a struct, slice or array.
+func isScalar(t reflect.Type) bool {
+	return t == timeType || t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textUnmarshalerType)
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t
+}
+
+func textMarshaler(v reflect.Value) (encoding.TextMarshaler, bool) {
+	if v.Type().Implements(textMarshalerType) {
+		return v.Interface().(encoding.TextMarshaler), true
+	}
+
+	if v.CanAddr() && v.Addr().Type().Implements(textMarshalerType) {
+		return v.Addr().Interface().(encoding.TextMarshaler), true
+	}
+
+	return nil, false
+}
+
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
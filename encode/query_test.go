@@ -0,0 +1,208 @@
+package encode
+
+import (
+	"net"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type Page struct {
+	Limit  int `query:"limit,omitempty"`
+	Offset int `query:"offset,omitempty"`
+}
+
+type Filter struct {
+	Page
+	Query    string        `query:"q"`
+	Tags     []string      `query:"tag,omitempty"`
+	Active   *bool         `query:"active"`
+	MinPrice float64       `query:"min_price,omitempty"`
+	Since    time.Time     `query:"since,omitempty"`
+	Until    time.Time     `query:"until,omitempty,unix"`
+	Day      time.Time     `query:"day,omitempty,layout=Mon, 02 Jan 2006"`
+	Timeout  time.Duration `query:"timeout,omitempty"`
+	IP       net.IP        `query:"ip,omitempty"`
+	Internal string        `query:"-"`
+	Sort     string
+	hidden   string
+}
+
+func TestMarshalQuery(t *testing.T) {
+	active := false
+	since := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "success - zero values",
+			input: Filter{},
+			want:  "Sort=&q=",
+		},
+		{
+			name: "success - all fields",
+			input: &Filter{
+				Page:     Page{Limit: 20},
+				Query:    "go utils",
+				Tags:     []string{"a", "b"},
+				Active:   &active,
+				MinPrice: 9.5,
+				Since:    since,
+				Until:    since,
+				Day:      since,
+				Timeout:  1500 * time.Millisecond,
+				IP:       net.ParseIP("192.0.2.1"),
+				Internal: "secret",
+				Sort:     "name",
+				hidden:   "hidden",
+			},
+			want: "Sort=name&active=false&day=Fri%2C+15+Mar+2024&ip=192.0.2.1&limit=20&min_price=9.5&" +
+				"q=go+utils&since=2024-03-15T10%3A30%3A00Z&tag=a&tag=b&timeout=1.5s&until=1710498600",
+		},
+		{
+			name:  "success - nil embedded pointer",
+			input: struct{ *Page }{},
+			want:  "",
+		},
+		{
+			name:  "success - pointer slice elements",
+			input: struct{ IDs []*int }{IDs: []*int{intPtr(1), nil, intPtr(3)}},
+			want:  "IDs=1&IDs=3",
+		},
+		{name: "fail - not a struct", input: []string{"a"}, wantErr: true},
+		{name: "fail - nil pointer", input: (*Filter)(nil), wantErr: true},
+		{name: "fail - unsupported type", input: struct{ M map[string]int }{M: map[string]int{}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalQuery(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MarshalQuery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.Encode() != tt.want {
+				t.Errorf("MarshalQuery() = %q, want %q", got.Encode(), tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalQuery(t *testing.T) {
+	values, err := url.ParseQuery("limit=20&q=go+utils&tag=a&tag=b&active=true&min_price=9.5&" +
+		"since=2024-03-15T10:30:00Z&until=1710498600&day=Fri,+15+Mar+2024&timeout=1.5s&ip=192.0.2.1&" +
+		"Internal=x&Sort=name&unknown=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got Filter
+	if err := UnmarshalQuery(values, &got); err != nil {
+		t.Fatalf("UnmarshalQuery() error = %v", err)
+	}
+
+	active := true
+	since := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+	want := Filter{
+		Page:     Page{Limit: 20},
+		Query:    "go utils",
+		Tags:     []string{"a", "b"},
+		Active:   &active,
+		MinPrice: 9.5,
+		Since:    since,
+		Until:    since,
+		Day:      time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+		Timeout:  1500 * time.Millisecond,
+		IP:       net.ParseIP("192.0.2.1"),
+		Sort:     "name",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UnmarshalQuery() = %+v, want %+v", got, want)
+	}
+}
+
+func TestUnmarshalQueryKinds(t *testing.T) {
+	type target struct {
+		Small int8       `query:"small"`
+		Count *uint      `query:"count"`
+		IDs   []*int     `query:"id"`
+		Pair  [2]float32 `query:"pair"`
+		*Page
+		Default string `query:"default"`
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		want    target
+		wantErr string
+	}{
+		{
+			name:  "success - pointers and arrays",
+			query: "small=-8&count=3&id=1&id=2&pair=0.5&limit=10",
+			want:  target{Small: -8, Count: uintPtr(3), IDs: []*int{intPtr(1), intPtr(2)}, Pair: [2]float32{0.5}, Page: &Page{Limit: 10}, Default: "kept"},
+		},
+		{name: "success - first value", query: "small=1&small=2", want: target{Small: 1, Page: &Page{}, Default: "kept"}},
+		{name: "fail - overflow", query: "small=300", wantErr: "field Small"},
+		{name: "fail - negative unsigned", query: "count=-1", wantErr: "field Count"},
+		{name: "fail - too many array values", query: "pair=1&pair=2&pair=3", wantErr: "do not fit"},
+		{name: "fail - embedded field", query: "limit=ten", wantErr: "field Limit"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, _ := url.ParseQuery(tt.query)
+
+			got := target{Default: "kept"}
+			err := UnmarshalQuery(values, &got)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("UnmarshalQuery() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("UnmarshalQuery() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnmarshalQuery() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalQueryTarget(t *testing.T) {
+	var f Filter
+	for _, v := range []interface{}{f, (*Filter)(nil), new(int), nil} {
+		if err := UnmarshalQuery(url.Values{}, v); err == nil {
+			t.Errorf("UnmarshalQuery(%T) error = nil, want an error", v)
+		}
+	}
+}
+
+func TestQueryRoundTrip(t *testing.T) {
+	in := Filter{Page: Page{Limit: 5, Offset: 10}, Query: "x", Tags: []string{"a"}, Timeout: time.Minute}
+
+	values, err := MarshalQuery(in)
+	if err != nil {
+		t.Fatalf("MarshalQuery() error = %v", err)
+	}
+
+	var out Filter
+	if err := UnmarshalQuery(values, &out); err != nil {
+		t.Fatalf("UnmarshalQuery() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("round trip = %+v, want %+v", out, in)
+	}
+}
+
+func intPtr(n int) *int { return &n }
+
+func uintPtr(n uint) *uint { return &n }
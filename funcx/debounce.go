@@ -0,0 +1,111 @@
+/*
+Package funcx defines helpers to wrap and control function calls.
+*/
+package funcx
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+// Debounced is a function whose calls are delayed until no call happened for a given duration.
+// It is safe for concurrent use.
+type Debounced struct {
+	d     time.Duration
+	fn    func()
+	clock timex.Clock
+
+	mu      sync.Mutex
+	timer   timex.Timer
+	stop    chan struct{} // closed when the pending call is superseded, flushed or cancelled
+	running sync.Mutex    // serializes the calls to fn
+}
+
+// Debounce returns a Debounced calling fn once d elapsed without any call.
+func Debounce(d time.Duration, fn func()) *Debounced {
+	return DebounceWithClock(d, fn, timex.RealClock{})
+}
+
+// DebounceWithClock returns a Debounced like Debounce measuring time with clock.
+func DebounceWithClock(d time.Duration, fn func(), clock timex.Clock) *Debounced {
+	return &Debounced{d: d, fn: fn, clock: clock}
+}
+
+// Call schedules fn, postponing any pending call.
+func (db *Debounced) Call() {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	db.cancelLocked()
+
+	timer, stop := db.clock.NewTimer(db.d), make(chan struct{})
+	db.timer, db.stop = timer, stop
+
+	go func() {
+		select {
+		case <-timer.C():
+		case <-stop:
+			return
+		}
+
+		db.mu.Lock()
+		if db.stop != stop {
+			db.mu.Unlock()
+			return
+		}
+
+		db.timer, db.stop = nil, nil
+		db.mu.Unlock()
+
+		db.run()
+	}()
+}
+
+// Flush immediately calls fn if a call is pending.
+func (db *Debounced) Flush() {
+	db.mu.Lock()
+	pending := db.cancelLocked()
+	db.mu.Unlock()
+
+	if pending {
+		db.run()
+	}
+}
+
+// Cancel drops the pending call, if any.
+func (db *Debounced) Cancel() {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	db.cancelLocked()
+}
+
+// Pending reports whether a call is scheduled.
+func (db *Debounced) Pending() bool {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.stop != nil
+}
+
+// cancelLocked drops the pending call and reports whether there was one, the caller must hold db.mu.
+func (db *Debounced) cancelLocked() bool {
+	if db.stop == nil {
+		return false
+	}
+
+	db.timer.Stop()
+	close(db.stop)
+	db.timer, db.stop = nil, nil
+
+	return true
+}
+
+func (db *Debounced) run() {
+	db.running.Lock()
+	defer db.running.Unlock()
+
+	db.fn()
+}
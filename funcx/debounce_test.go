@@ -0,0 +1,89 @@
+package funcx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+var epoch = time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+// counter returns a function sending on calls each time it runs.
+func counter() (func(), chan struct{}) {
+	calls := make(chan struct{}, 10)
+	return func() { calls <- struct{}{} }, calls
+}
+
+func expectCalls(t *testing.T, calls chan struct{}, want int) {
+	t.Helper()
+
+	for i := 0; i < want; i++ {
+		select {
+		case <-calls:
+		case <-time.After(time.Second):
+			t.Fatalf("got %d calls, want %d", i, want)
+		}
+	}
+
+	select {
+	case <-calls:
+		t.Fatalf("got more than %d calls", want)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestDebounce(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+	fn, calls := counter()
+	db := DebounceWithClock(time.Second, fn, clock)
+
+	db.Call()
+	clock.BlockUntil(1)
+	clock.Advance(500 * time.Millisecond)
+
+	db.Call()
+	clock.BlockUntil(1)
+	clock.Advance(500 * time.Millisecond)
+	expectCalls(t, calls, 0)
+
+	if !db.Pending() {
+		t.Errorf("Pending() = false, want true")
+	}
+
+	clock.Advance(500 * time.Millisecond)
+	expectCalls(t, calls, 1)
+
+	if db.Pending() {
+		t.Errorf("Pending() = true after the call, want false")
+	}
+}
+
+func TestDebounceFlushCancel(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+	fn, calls := counter()
+	db := DebounceWithClock(time.Second, fn, clock)
+
+	db.Flush()
+	expectCalls(t, calls, 0)
+
+	db.Call()
+	db.Flush()
+	expectCalls(t, calls, 1)
+
+	db.Call()
+	db.Cancel()
+	clock.Advance(time.Hour)
+	expectCalls(t, calls, 0)
+}
+
+func TestDebounceRealClock(t *testing.T) {
+	fn, calls := counter()
+	db := Debounce(time.Millisecond, fn)
+
+	for i := 0; i < 5; i++ {
+		db.Call()
+	}
+
+	expectCalls(t, calls, 1)
+}
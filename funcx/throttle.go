@@ -0,0 +1,136 @@
+package funcx
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+// Throttled is a function called at most once per interval. The first call runs immediately and
+// the calls made during the interval are coalesced into one call at its end. It is safe for concurrent use.
+type Throttled struct {
+	d     time.Duration
+	fn    func()
+	clock timex.Clock
+
+	mu      sync.Mutex
+	lastRun time.Time
+	ran     bool
+	pending bool
+	timer   timex.Timer
+	stop    chan struct{}
+	running sync.Mutex
+}
+
+// Throttle returns a Throttled calling fn at most once every d.
+func Throttle(d time.Duration, fn func()) *Throttled {
+	return ThrottleWithClock(d, fn, timex.RealClock{})
+}
+
+// ThrottleWithClock returns a Throttled like Throttle measuring time with clock.
+func ThrottleWithClock(d time.Duration, fn func(), clock timex.Clock) *Throttled {
+	return &Throttled{d: d, fn: fn, clock: clock}
+}
+
+// Call calls fn now if the interval since the last call elapsed, and otherwise schedules it for the end of the interval.
+func (th *Throttled) Call() {
+	th.mu.Lock()
+
+	now := th.clock.Now()
+	if th.stop == nil && (!th.ran || now.Sub(th.lastRun) >= th.d) {
+		th.lastRun, th.ran = now, true
+		th.mu.Unlock()
+
+		th.run()
+
+		return
+	}
+
+	th.pending = true
+	if th.stop == nil {
+		th.schedule(th.lastRun.Add(th.d).Sub(now))
+	}
+
+	th.mu.Unlock()
+}
+
+// Flush immediately calls fn if a call is pending.
+func (th *Throttled) Flush() {
+	th.mu.Lock()
+	pending := th.pending
+	th.cancelLocked()
+	if pending {
+		th.lastRun, th.ran = th.clock.Now(), true
+	}
+	th.mu.Unlock()
+
+	if pending {
+		th.run()
+	}
+}
+
+// Cancel drops the pending call, if any.
+func (th *Throttled) Cancel() {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+
+	th.cancelLocked()
+}
+
+// Pending reports whether a call is scheduled.
+func (th *Throttled) Pending() bool {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+
+	return th.pending
+}
+
+// schedule runs the pending call after d, the caller must hold th.mu.
+func (th *Throttled) schedule(d time.Duration) {
+	timer, stop := th.clock.NewTimer(d), make(chan struct{})
+	th.timer, th.stop = timer, stop
+
+	go func() {
+		select {
+		case <-timer.C():
+		case <-stop:
+			return
+		}
+
+		th.mu.Lock()
+		if th.stop != stop {
+			th.mu.Unlock()
+			return
+		}
+
+		th.timer, th.stop = nil, nil
+		run := th.pending
+		th.pending = false
+		if run {
+			th.lastRun, th.ran = th.clock.Now(), true
+		}
+		th.mu.Unlock()
+
+		if run {
+			th.run()
+		}
+	}()
+}
+
+// cancelLocked drops the pending call, the caller must hold th.mu.
+func (th *Throttled) cancelLocked() {
+	th.pending = false
+	if th.stop != nil {
+		th.timer.Stop()
+		close(th.stop)
+		th.timer, th.stop = nil, nil
+	}
+}
+
+func (th *Throttled) run() {
+	th.running.Lock()
+	defer th.running.Unlock()
+
+	th.fn()
+}
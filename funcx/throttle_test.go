@@ -0,0 +1,63 @@
+package funcx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+func TestThrottle(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+	fn, calls := counter()
+	th := ThrottleWithClock(time.Second, fn, clock)
+
+	// the first call runs immediately
+	th.Call()
+	expectCalls(t, calls, 1)
+
+	// calls within the interval are coalesced into a trailing call
+	th.Call()
+	th.Call()
+	expectCalls(t, calls, 0)
+
+	if !th.Pending() {
+		t.Errorf("Pending() = false, want true")
+	}
+
+	clock.Advance(time.Second)
+	expectCalls(t, calls, 1)
+
+	// the trailing call started a new interval
+	clock.Advance(500 * time.Millisecond)
+	th.Call()
+	expectCalls(t, calls, 0)
+
+	clock.Advance(500 * time.Millisecond)
+	expectCalls(t, calls, 1)
+
+	// after a quiet interval calls run immediately again
+	clock.Advance(time.Hour)
+	th.Call()
+	expectCalls(t, calls, 1)
+}
+
+func TestThrottleFlushCancel(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+	fn, calls := counter()
+	th := ThrottleWithClock(time.Second, fn, clock)
+
+	th.Call()
+	th.Call()
+	th.Flush()
+	expectCalls(t, calls, 2)
+
+	th.Call()
+	th.Cancel()
+	clock.Advance(time.Hour)
+	expectCalls(t, calls, 0)
+
+	if th.Pending() {
+		t.Errorf("Pending() = true after Cancel(), want false")
+	}
+}
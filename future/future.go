@@ -0,0 +1,115 @@
+/*
+Package future defines a generic Future to compose asynchronous calls.
+*/
+package future
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+)
+
+// Future is the eventual result of an asynchronous call.
+type Future[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// Go calls fn in a new goroutine and returns its Future. A panic in fn is returned as an error.
+func Go[T any](fn func() (T, error)) *Future[T] {
+	f := &Future[T]{done: make(chan struct{})}
+
+	go func() {
+		defer close(f.done)
+		defer func() {
+			if r := recover(); r != nil {
+				f.err = fmt.Errorf("future panicked: %v\n%s", r, debug.Stack())
+			}
+		}()
+
+		f.value, f.err = fn()
+	}()
+
+	return f
+}
+
+// Resolved returns a completed Future with value and err.
+func Resolved[T any](value T, err error) *Future[T] {
+	f := &Future[T]{done: make(chan struct{}), value: value, err: err}
+	close(f.done)
+
+	return f
+}
+
+// Done returns a channel closed once the future completes.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Await waits for the result of the future, or returns the context error if ctx is done first.
+func (f *Future[T]) Await(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.value, f.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// Then returns a Future calling fn with the value of f once it succeeds. If f fails, its error is propagated without calling fn.
+func Then[T, U any](f *Future[T], fn func(T) (U, error)) *Future[U] {
+	return Go(func() (U, error) {
+		<-f.done
+		if f.err != nil {
+			var zero U
+			return zero, f.err
+		}
+
+		return fn(f.value)
+	})
+}
+
+// All returns a Future of the values of every future in order. It fails with the first error in order.
+func All[T any](futures ...*Future[T]) *Future[[]T] {
+	return Go(func() ([]T, error) {
+		values := make([]T, len(futures))
+		for i, f := range futures {
+			<-f.done
+			if f.err != nil {
+				return nil, f.err
+			}
+
+			values[i] = f.value
+		}
+
+		return values, nil
+	})
+}
+
+// Race returns a Future completing with the result, success or error, of the first future to complete.
+// It fails if no future is given.
+func Race[T any](futures ...*Future[T]) *Future[T] {
+	if len(futures) == 0 {
+		var zero T
+		return Resolved(zero, fmt.Errorf("race of no futures"))
+	}
+
+	result := &Future[T]{done: make(chan struct{})}
+	first := make(chan *Future[T], len(futures))
+	for _, f := range futures {
+		go func(f *Future[T]) {
+			<-f.done
+			first <- f
+		}(f)
+	}
+
+	go func() {
+		winner := <-first
+		result.value, result.err = winner.value, winner.err
+		close(result.done)
+	}()
+
+	return result
+}
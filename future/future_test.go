@@ -0,0 +1,124 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var errTest = errors.New("test error")
+
+func TestGoAwait(t *testing.T) {
+	f := Go(func() (int, error) { return 42, nil })
+
+	got, err := f.Await(context.Background())
+	if got != 42 || err != nil {
+		t.Errorf("Await() = %v, %v, want 42, nil", got, err)
+	}
+
+	// awaiting again returns the same result
+	if got, _ := f.Await(context.Background()); got != 42 {
+		t.Errorf("Await() = %v, want %v", got, 42)
+	}
+}
+
+func TestAwaitContext(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	f := Go(func() (int, error) {
+		<-release
+		return 1, nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := f.Await(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Await() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestGoPanic(t *testing.T) {
+	f := Go(func() (int, error) { panic("boom") })
+
+	if _, err := f.Await(context.Background()); err == nil || !strings.Contains(err.Error(), "future panicked: boom") {
+		t.Errorf("Await() error = %v, want a recovered panic", err)
+	}
+}
+
+func TestThen(t *testing.T) {
+	f := Then(Go(func() (int, error) { return 21, nil }), func(n int) (string, error) {
+		return strings.Repeat("a", n*2), nil
+	})
+
+	if got, _ := f.Await(context.Background()); len(got) != 42 {
+		t.Errorf("Then() = %d characters, want %d", len(got), 42)
+	}
+
+	called := false
+	failed := Then(Resolved(0, errTest), func(n int) (int, error) {
+		called = true
+		return n, nil
+	})
+
+	if _, err := failed.Await(context.Background()); err != errTest || called {
+		t.Errorf("Then() error = %v, called %v, want %v without calling", err, called, errTest)
+	}
+}
+
+func TestAll(t *testing.T) {
+	tests := []struct {
+		name    string
+		futures []*Future[int]
+		want    []int
+		wantErr bool
+	}{
+		{
+			name:    "success - all values in order",
+			futures: []*Future[int]{Go(func() (int, error) { time.Sleep(5 * time.Millisecond); return 1, nil }), Resolved(2, nil)},
+			want:    []int{1, 2},
+		},
+		{name: "success - no futures", futures: nil, want: []int{}},
+		{name: "fail - one future fails", futures: []*Future[int]{Resolved(1, nil), Resolved(0, errTest)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := All(tt.futures...).Await(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("All() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("All() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRace(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	slow := Go(func() (string, error) {
+		<-release
+		return "slow", nil
+	})
+	fast := Go(func() (string, error) { return "fast", nil })
+
+	if got, _ := Race(slow, fast).Await(context.Background()); got != "fast" {
+		t.Errorf("Race() = %v, want %v", got, "fast")
+	}
+
+	if _, err := Race(slow, Resolved("", errTest)).Await(context.Background()); err != errTest {
+		t.Errorf("Race() error = %v, want %v", err, errTest)
+	}
+
+	if _, err := Race[string]().Await(context.Background()); err == nil {
+		t.Errorf("Race() expected error without futures")
+	}
+}
@@ -2,4 +2,11 @@ module github.com/kashifkhan0771/utils
 
 go 1.18
 
-require golang.org/x/text v0.14.0
+require (
+	github.com/BurntSushi/toml v1.3.2
+	golang.org/x/crypto v0.21.0
+	golang.org/x/text v0.14.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require golang.org/x/sys v0.18.0 // indirect
@@ -0,0 +1,122 @@
+/*
+Package humanize defines helpers to format numbers and sizes for humans.
+*/
+package humanize
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Locale defines the language used to format values
+type Locale string
+
+const (
+	// English formats numbers like 1,234,567
+	English Locale = "en"
+
+	// PortugueseBR formats numbers like 1.234.567
+	PortugueseBR Locale = "pt-BR"
+)
+
+var (
+	siSizes  = []string{"B", "KB", "MB", "GB", "TB", "PB", "EB"}
+	iecSizes = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+)
+
+// Bytes returns a human readable representation of a size in bytes using SI (base 1000) units.
+// For example, Bytes(1536) returns "1.5 KB".
+func Bytes(size uint64) string {
+	return formatBytes(size, 1000, siSizes)
+}
+
+// IBytes returns a human readable representation of a size in bytes using IEC (base 1024) units.
+// For example, IBytes(1536) returns "1.5 KiB".
+func IBytes(size uint64) string {
+	return formatBytes(size, 1024, iecSizes)
+}
+
+func formatBytes(size uint64, base float64, units []string) string {
+	if float64(size) < base {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	exp := int(math.Floor(math.Log(float64(size)) / math.Log(base)))
+	if exp >= len(units) {
+		exp = len(units) - 1
+	}
+
+	value := float64(size) / math.Pow(base, float64(exp))
+	// rounding may carry the value over to the next unit, e.g. 999999 -> 1000 KB
+	if math.Round(value*10)/10 >= base && exp < len(units)-1 {
+		exp++
+		value /= base
+	}
+
+	format := "%.1f %s"
+	if value >= 10 {
+		format = "%.0f %s"
+	}
+
+	return fmt.Sprintf(format, value, units[exp])
+}
+
+// Ordinal returns the number with its English ordinal suffix.
+// For example, Ordinal(3) returns "3rd" and Ordinal(11) returns "11th".
+func Ordinal(n int) string {
+	suffix := "th"
+
+	abs := n
+	if abs < 0 {
+		abs = -abs
+	}
+
+	switch abs % 100 {
+	case 11, 12, 13:
+	default:
+		switch abs % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+
+	return strconv.Itoa(n) + suffix
+}
+
+// Comma returns the number with thousands separators using the English locale.
+// For example, Comma(1234567) returns "1,234,567".
+func Comma(n int64) string {
+	return CommaWithLocale(n, English)
+}
+
+// CommaWithLocale returns the number with the thousands separator of the provided locale.
+// For example, CommaWithLocale(1234567, PortugueseBR) returns "1.234.567".
+// Unknown locales fall back to English.
+func CommaWithLocale(n int64, locale Locale) string {
+	separator := byte(',')
+	if locale == PortugueseBR {
+		separator = '.'
+	}
+
+	digits := strconv.FormatInt(n, 10)
+
+	sign := ""
+	if digits[0] == '-' {
+		sign, digits = "-", digits[1:]
+	}
+
+	result := make([]byte, 0, len(digits)+len(digits)/3)
+	for i := 0; i < len(digits); i++ {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			result = append(result, separator)
+		}
+		result = append(result, digits[i])
+	}
+
+	return sign + string(result)
+}
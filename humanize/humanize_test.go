@@ -0,0 +1,105 @@
+package humanize
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		size uint64
+		want string
+	}{
+		{name: "success - zero bytes", size: 0, want: "0 B"},
+		{name: "success - bytes", size: 512, want: "512 B"},
+		{name: "success - kilobytes", size: 1536, want: "1.5 KB"},
+		{name: "success - megabytes", size: 82854982, want: "83 MB"},
+		{name: "success - round up to the next unit", size: 999999, want: "1.0 MB"},
+		{name: "success - max uint64", size: math.MaxUint64, want: "18 EB"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Bytes(tt.size); got != tt.want {
+				t.Errorf("Bytes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		size uint64
+		want string
+	}{
+		{name: "success - bytes", size: 1023, want: "1023 B"},
+		{name: "success - kibibytes", size: 1536, want: "1.5 KiB"},
+		{name: "success - mebibytes", size: 10 * 1024 * 1024, want: "10 MiB"},
+		{name: "success - gibibytes", size: 1 << 30, want: "1.0 GiB"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IBytes(tt.size); got != tt.want {
+				t.Errorf("IBytes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want string
+	}{
+		{name: "success - first", n: 1, want: "1st"},
+		{name: "success - second", n: 2, want: "2nd"},
+		{name: "success - third", n: 3, want: "3rd"},
+		{name: "success - fourth", n: 4, want: "4th"},
+		{name: "success - eleventh", n: 11, want: "11th"},
+		{name: "success - twelfth", n: 12, want: "12th"},
+		{name: "success - twenty first", n: 21, want: "21st"},
+		{name: "success - one hundred thirteenth", n: 113, want: "113th"},
+		{name: "success - zero", n: 0, want: "0th"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Ordinal(tt.n); got != tt.want {
+				t.Errorf("Ordinal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommaWithLocale(t *testing.T) {
+	type args struct {
+		n      int64
+		locale Locale
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{name: "success - english", args: args{n: 1234567, locale: English}, want: "1,234,567"},
+		{name: "success - portuguese", args: args{n: 1234567, locale: PortugueseBR}, want: "1.234.567"},
+		{name: "success - small number", args: args{n: 999, locale: English}, want: "999"},
+		{name: "success - negative number", args: args{n: -1234, locale: English}, want: "-1,234"},
+		{name: "success - min int64", args: args{n: math.MinInt64, locale: English}, want: "-9,223,372,036,854,775,808"},
+		{name: "success - unknown locale", args: args{n: 1000, locale: "xx"}, want: "1,000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CommaWithLocale(tt.args.n, tt.args.locale); got != tt.want {
+				t.Errorf("CommaWithLocale() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComma(t *testing.T) {
+	if got := Comma(1234567); got != "1,234,567" {
+		t.Errorf("Comma() = %v, want %v", got, "1,234,567")
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Package jsonx defines helpers on top of encoding/json for marshaling, strict decoding and streaming.
+*/
+package jsonx
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// MustMarshal returns the JSON encoding of v, panicking if it cannot be encoded.
+// It is meant for values known to be encodable, such as fixtures and package level variables.
+func MustMarshal(v interface{}) []byte {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("jsonx: %v", err))
+	}
+
+	return b
+}
+
+// PrettyString returns the JSON encoding of v indented with two spaces, without escaping HTML characters,
+// for logs and debugging. If v cannot be encoded, it returns v formatted with %+v.
+func PrettyString(v interface{}) string {
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(v); err != nil {
+		return fmt.Sprintf("%+v", v)
+	}
+
+	return string(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+}
+
+// DecodeStrict decodes the JSON value of r into v, rejecting object keys that do not match a field of v
+// and data after the value.
+func DecodeStrict(r io.Reader, v interface{}) error {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("jsonx: %w", err)
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return errors.New("jsonx: unexpected data after the value")
+	}
+
+	return nil
+}
+
+// StreamArrayFunc decodes the elements of the JSON array of r one at a time, calling fn with each of them,
+// so that huge arrays are processed in constant memory. It stops at the first error, including the errors
+// returned by fn. See StreamArray for an iterator version.
+func StreamArrayFunc[T any](r io.Reader, fn func(T) error) error {
+	dec := json.NewDecoder(r)
+
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("jsonx: %w", err)
+	}
+
+	if tok != json.Delim('[') {
+		return fmt.Errorf("jsonx: expected an array, got %v", tok)
+	}
+
+	for i := 0; dec.More(); i++ {
+		var v T
+		if err := dec.Decode(&v); err != nil {
+			return fmt.Errorf("jsonx: element %d: %w", i, err)
+		}
+
+		if err := fn(v); err != nil {
+			return err
+		}
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("jsonx: %w", err)
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return errors.New("jsonx: unexpected data after the array")
+	}
+
+	return nil
+}
+
+// decodeValue decodes the single JSON value of b into generic values, keeping numbers as json.Number
+// so that they are encoded back unchanged.
+func decodeValue(b []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("jsonx: %w", err)
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("jsonx: unexpected data after the value")
+	}
+
+	return v, nil
+}
@@ -0,0 +1,133 @@
+package jsonx
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type item struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func TestMustMarshal(t *testing.T) {
+	if got := string(MustMarshal(item{ID: 1, Name: "a"})); got != `{"id":1,"name":"a"}` {
+		t.Errorf("MustMarshal() = %s, want %s", got, `{"id":1,"name":"a"}`)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("MustMarshal() did not panic for an unsupported value")
+		}
+	}()
+
+	MustMarshal(make(chan int))
+}
+
+func TestPrettyString(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface{}
+		want  string
+	}{
+		{name: "success - struct", input: item{ID: 1, Name: "<a & b>"}, want: "{\n  \"id\": 1,\n  \"name\": \"<a & b>\"\n}"},
+		{name: "success - empty slice", input: []int{}, want: "[]"},
+		{name: "success - nil", input: nil, want: "null"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PrettyString(tt.input); got != tt.want {
+				t.Errorf("PrettyString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	ch := make(chan int)
+	if got, want := PrettyString(ch), fmt.Sprintf("%+v", ch); got != want {
+		t.Errorf("PrettyString() = %q, want the %%+v fallback %q", got, want)
+	}
+}
+
+func TestDecodeStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    item
+		wantErr bool
+	}{
+		{name: "success - known fields", input: `{"id": 1, "name": "a"}`, want: item{ID: 1, Name: "a"}},
+		{name: "success - trailing whitespace", input: "{\"id\": 2}\n\n", want: item{ID: 2}},
+		{name: "fail - unknown field", input: `{"id": 1, "extra": true}`, wantErr: true},
+		{name: "fail - trailing value", input: `{"id": 1} {"id": 2}`, wantErr: true},
+		{name: "fail - trailing garbage", input: `{"id": 1}}`, wantErr: true},
+		{name: "fail - wrong type", input: `{"id": "1"}`, wantErr: true},
+		{name: "fail - empty", input: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got item
+			err := DecodeStrict(strings.NewReader(tt.input), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DecodeStrict() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("DecodeStrict() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStreamArrayFunc(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []item
+		wantErr string
+	}{
+		{name: "success - elements", input: `[{"id": 1}, {"id": 2, "name": "b"}]`, want: []item{{ID: 1}, {ID: 2, Name: "b"}}},
+		{name: "success - empty array", input: " [ ] \n"},
+		{name: "fail - not an array", input: `{"id": 1}`, wantErr: "expected an array"},
+		{name: "fail - bad element", input: `[{"id": 1}, {"id": "x"}]`, want: []item{{ID: 1}}, wantErr: "element 1"},
+		{name: "fail - truncated", input: `[{"id": 1}`, want: []item{{ID: 1}}, wantErr: "unexpected end"},
+		{name: "fail - trailing data", input: `[] []`, wantErr: "after the array"},
+		{name: "fail - empty", input: "", wantErr: "EOF"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []item
+			err := StreamArrayFunc(strings.NewReader(tt.input), func(v item) error {
+				got = append(got, v)
+
+				return nil
+			})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("StreamArrayFunc() error = %v, want %q", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("StreamArrayFunc() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StreamArrayFunc() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStreamArrayFuncStop(t *testing.T) {
+	errStop := errors.New("stop")
+
+	calls := 0
+	err := StreamArrayFunc(strings.NewReader(`[1, 2, 3]`), func(int) error {
+		calls++
+
+		return errStop
+	})
+
+	if !errors.Is(err, errStop) || calls != 1 {
+		t.Errorf("StreamArrayFunc() = %v after %d calls, want %v after 1 call", err, calls, errStop)
+	}
+}
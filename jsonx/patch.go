@@ -0,0 +1,93 @@
+package jsonx
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// MergePatch applies the JSON Merge Patch of RFC 7386 to the original document: members of patch objects
+// replace the members of original, recursively, and null members remove them. A patch that is not an object
+// replaces the whole document.
+func MergePatch(original, patch []byte) ([]byte, error) {
+	doc, err := decodeValue(original)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := decodeValue(patch)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(mergePatch(doc, p))
+}
+
+// CreateMergePatch returns the JSON Merge Patch turning document a into document b, such that
+// MergePatch(a, CreateMergePatch(a, b)) equals b. Merge patches cannot set members to null, so
+// null members of b are removed instead.
+func CreateMergePatch(a, b []byte) ([]byte, error) {
+	docA, err := decodeValue(a)
+	if err != nil {
+		return nil, err
+	}
+
+	docB, err := decodeValue(b)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(createMergePatch(docA, docB))
+}
+
+func mergePatch(doc, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	d, ok := doc.(map[string]interface{})
+	if !ok {
+		d = make(map[string]interface{}, len(p))
+	}
+
+	for k, v := range p {
+		if v == nil {
+			delete(d, k)
+		} else {
+			d[k] = mergePatch(d[k], v)
+		}
+	}
+
+	return d
+}
+
+func createMergePatch(a, b interface{}) interface{} {
+	objA, okA := a.(map[string]interface{})
+	objB, okB := b.(map[string]interface{})
+
+	if !okA || !okB {
+		return b
+	}
+
+	patch := make(map[string]interface{})
+	for k := range objA {
+		if _, ok := objB[k]; !ok {
+			patch[k] = nil
+		}
+	}
+
+	for k, vb := range objB {
+		va, ok := objA[k]
+		if ok && reflect.DeepEqual(va, vb) {
+			continue
+		}
+
+		if _, isObj := vb.(map[string]interface{}); isObj && ok {
+			patch[k] = createMergePatch(va, vb)
+		} else {
+			patch[k] = vb
+		}
+	}
+
+	return patch
+}
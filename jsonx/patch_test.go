@@ -0,0 +1,86 @@
+package jsonx
+
+import "testing"
+
+func TestMergePatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		original string
+		patch    string
+		want     string
+		wantErr  bool
+	}{
+		// Examples of RFC 7386 appendix A
+		{name: "success - replace member", original: `{"a":"b"}`, patch: `{"a":"c"}`, want: `{"a":"c"}`},
+		{name: "success - add member", original: `{"a":"b"}`, patch: `{"b":"c"}`, want: `{"a":"b","b":"c"}`},
+		{name: "success - remove member", original: `{"a":"b"}`, patch: `{"a":null}`, want: `{}`},
+		{name: "success - remove one of two", original: `{"a":"b","b":"c"}`, patch: `{"a":null}`, want: `{"b":"c"}`},
+		{name: "success - replace array", original: `{"a":["b"]}`, patch: `{"a":"c"}`, want: `{"a":"c"}`},
+		{name: "success - array with array", original: `{"a":"c"}`, patch: `{"a":["b"]}`, want: `{"a":["b"]}`},
+		{name: "success - nested", original: `{"a":{"b":"c"}}`, patch: `{"a":{"b":"d","c":null}}`, want: `{"a":{"b":"d"}}`},
+		{name: "success - arrays are replaced", original: `{"a":[{"b":"c"}]}`, patch: `{"a":[1]}`, want: `{"a":[1]}`},
+		{name: "success - patch array", original: `["a","b"]`, patch: `["c","d"]`, want: `["c","d"]`},
+		{name: "success - object replaced by array", original: `{"a":"b"}`, patch: `["c"]`, want: `["c"]`},
+		{name: "success - null patch", original: `{"a":"foo"}`, patch: `null`, want: `null`},
+		{name: "success - string patch", original: `{"a":"foo"}`, patch: `"bar"`, want: `"bar"`},
+		{name: "success - null in patch array", original: `{"e":null}`, patch: `{"a":1}`, want: `{"a":1,"e":null}`},
+		{name: "success - patch on array", original: `[1,2]`, patch: `{"a":"b","c":null}`, want: `{"a":"b"}`},
+		{name: "success - deep null", original: `{}`, patch: `{"a":{"bb":{"ccc":null}}}`, want: `{"a":{"bb":{}}}`},
+		{name: "success - numbers kept", original: `{"n":12345678901234567890,"f":1.50}`, patch: `{}`, want: `{"f":1.50,"n":12345678901234567890}`},
+		{name: "fail - invalid original", original: `{`, patch: `{}`, wantErr: true},
+		{name: "fail - invalid patch", original: `{}`, patch: `{"a"}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MergePatch([]byte(tt.original), []byte(tt.patch))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MergePatch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(got) != tt.want {
+				t.Errorf("MergePatch() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateMergePatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       string
+		b       string
+		want    string
+		wantErr bool
+	}{
+		{name: "success - equal", a: `{"a":1,"b":[1,2]}`, b: `{"b":[1,2],"a":1}`, want: `{}`},
+		{name: "success - changes", a: `{"a":1,"b":2,"c":3}`, b: `{"a":1,"b":5,"d":4}`, want: `{"b":5,"c":null,"d":4}`},
+		{name: "success - nested", a: `{"o":{"x":1,"y":2},"k":true}`, b: `{"o":{"x":1,"y":3},"k":true}`, want: `{"o":{"y":3}}`},
+		{name: "success - array changed", a: `{"l":[1,2]}`, b: `{"l":[1]}`, want: `{"l":[1]}`},
+		{name: "success - scalar to object", a: `{"o":1}`, b: `{"o":{"x":1}}`, want: `{"o":{"x":1}}`},
+		{name: "success - not objects", a: `[1]`, b: `"x"`, want: `"x"`},
+		{name: "fail - invalid", a: `{}`, b: `{`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CreateMergePatch([]byte(tt.a), []byte(tt.b))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateMergePatch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("CreateMergePatch() = %s, want %s", got, tt.want)
+			}
+
+			applied, err := MergePatch([]byte(tt.a), got)
+			if err != nil {
+				t.Fatalf("MergePatch() error = %v", err)
+			}
+
+			want, _ := GetPointer([]byte(tt.b), "")
+			if string(applied) != string(want) {
+				t.Errorf("MergePatch(a, CreateMergePatch(a, b)) = %s, want %s", applied, want)
+			}
+		})
+	}
+}
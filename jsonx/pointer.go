@@ -0,0 +1,168 @@
+package jsonx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrNotFound is returned when a JSON Pointer does not reference a value of the document.
+var ErrNotFound = errors.New("jsonx: pointer not found")
+
+// GetPointer returns the JSON encoding of the value of doc referenced by the JSON Pointer of RFC 6901,
+// such as "/a/b/0". The empty pointer references the whole document. Missing values return ErrNotFound.
+func GetPointer(doc []byte, pointer string) ([]byte, error) {
+	tokens, err := parsePointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := decodeValue(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, token := range tokens {
+		switch node := v.(type) {
+		case map[string]interface{}:
+			child, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("%w: %q", ErrNotFound, pointer)
+			}
+
+			v = child
+		case []interface{}:
+			i, ok := arrayIndex(token, len(node))
+			if !ok || i == len(node) {
+				return nil, fmt.Errorf("%w: %q", ErrNotFound, pointer)
+			}
+
+			v = node[i]
+		default:
+			return nil, fmt.Errorf("%w: %q", ErrNotFound, pointer)
+		}
+	}
+
+	return json.Marshal(v)
+}
+
+// SetPointer returns doc with the value referenced by the JSON Pointer replaced by the JSON encoding of value.
+// Object members are added or replaced, array elements are replaced, and the "-" index appends to an array.
+// The parent of the referenced value must exist, otherwise ErrNotFound is returned.
+func SetPointer(doc []byte, pointer string, value interface{}) ([]byte, error) {
+	tokens, err := parsePointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := decodeValue(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("jsonx: %w", err)
+	}
+
+	v, err := decodeValue(b)
+	if err != nil {
+		return nil, err
+	}
+
+	root, ok := setPointer(root, tokens, v)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrNotFound, pointer)
+	}
+
+	return json.Marshal(root)
+}
+
+// setPointer returns node with the value at tokens set to v, or false when the parent of the value does not exist.
+func setPointer(node interface{}, tokens []string, v interface{}) (interface{}, bool) {
+	if len(tokens) == 0 {
+		return v, true
+	}
+
+	token, rest := tokens[0], tokens[1:]
+
+	switch n := node.(type) {
+	case map[string]interface{}:
+		child, ok := n[token]
+		if !ok && len(rest) > 0 {
+			return nil, false
+		}
+
+		if n[token], ok = setPointer(child, rest, v); !ok {
+			return nil, false
+		}
+
+		return n, true
+	case []interface{}:
+		i, ok := arrayIndex(token, len(n))
+		if !ok {
+			return nil, false
+		}
+
+		if i == len(n) {
+			if len(rest) > 0 {
+				return nil, false
+			}
+
+			return append(n, v), true
+		}
+
+		if n[i], ok = setPointer(n[i], rest, v); !ok {
+			return nil, false
+		}
+
+		return n, true
+	default:
+		return nil, false
+	}
+}
+
+// parsePointer splits a JSON Pointer into its unescaped reference tokens.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+
+	if pointer[0] != '/' {
+		return nil, fmt.Errorf("jsonx: pointer %q does not start with /", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		for j := 0; j < len(token); j++ {
+			if token[j] == '~' && (j+1 == len(token) || (token[j+1] != '0' && token[j+1] != '1')) {
+				return nil, fmt.Errorf("jsonx: pointer %q has an invalid escape", pointer)
+			}
+		}
+
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+
+	return tokens, nil
+}
+
+// arrayIndex parses the array index token of an array of length n. The "-" token and n itself
+// reference the position after the last element.
+func arrayIndex(token string, n int) (int, bool) {
+	if token == "-" {
+		return n, true
+	}
+
+	if token == "" || (len(token) > 1 && token[0] == '0') || strings.Trim(token, "0123456789") != "" {
+		return 0, false
+	}
+
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || i > n {
+		return 0, false
+	}
+
+	return i, true
+}
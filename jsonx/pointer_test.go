@@ -0,0 +1,92 @@
+package jsonx
+
+import (
+	"errors"
+	"testing"
+)
+
+// doc is the example document of RFC 6901 section 5.
+const doc = `{"foo":["bar","baz"],"":0,"a/b":1,"c%d":2,"e^f":3,"g|h":4,"i\\j":5,"k\"l":6," ":7,"m~n":8}`
+
+func TestGetPointer(t *testing.T) {
+	tests := []struct {
+		name    string
+		pointer string
+		want    string
+		wantErr error
+	}{
+		{name: "success - whole document", pointer: "", want: `{"":0," ":7,"a/b":1,"c%d":2,"e^f":3,"foo":["bar","baz"],"g|h":4,"i\\j":5,"k\"l":6,"m~n":8}`},
+		{name: "success - member", pointer: "/foo", want: `["bar","baz"]`},
+		{name: "success - array element", pointer: "/foo/0", want: `"bar"`},
+		{name: "success - empty key", pointer: "/", want: `0`},
+		{name: "success - escaped slash", pointer: "/a~1b", want: `1`},
+		{name: "success - percent", pointer: "/c%d", want: `2`},
+		{name: "success - space", pointer: "/ ", want: `7`},
+		{name: "success - escaped tilde", pointer: "/m~0n", want: `8`},
+		{name: "fail - missing member", pointer: "/bar", wantErr: ErrNotFound},
+		{name: "fail - index out of range", pointer: "/foo/2", wantErr: ErrNotFound},
+		{name: "fail - append index", pointer: "/foo/-", wantErr: ErrNotFound},
+		{name: "fail - leading zero", pointer: "/foo/01", wantErr: ErrNotFound},
+		{name: "fail - signed index", pointer: "/foo/+1", wantErr: ErrNotFound},
+		{name: "fail - through scalar", pointer: "/a~1b/c", wantErr: ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetPointer([]byte(doc), tt.pointer)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetPointer() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && string(got) != tt.want {
+				t.Errorf("GetPointer() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetPointerInvalid(t *testing.T) {
+	for _, pointer := range []string{"foo", "/m~2n", "/m~"} {
+		if _, err := GetPointer([]byte(doc), pointer); err == nil || errors.Is(err, ErrNotFound) {
+			t.Errorf("GetPointer(%q) error = %v, want a syntax error", pointer, err)
+		}
+	}
+
+	if _, err := GetPointer([]byte(`{"a":`), "/a"); err == nil {
+		t.Errorf("GetPointer() error = nil, want an error for an invalid document")
+	}
+}
+
+func TestSetPointer(t *testing.T) {
+	const base = `{"a":{"b":[1,2]},"c":"d"}`
+
+	tests := []struct {
+		name    string
+		pointer string
+		value   interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "success - replace member", pointer: "/c", value: "e", want: `{"a":{"b":[1,2]},"c":"e"}`},
+		{name: "success - add member", pointer: "/a/x", value: map[string]int{"y": 1}, want: `{"a":{"b":[1,2],"x":{"y":1}},"c":"d"}`},
+		{name: "success - replace element", pointer: "/a/b/0", value: 9, want: `{"a":{"b":[9,2]},"c":"d"}`},
+		{name: "success - append", pointer: "/a/b/-", value: 3, want: `{"a":{"b":[1,2,3]},"c":"d"}`},
+		{name: "success - append at length", pointer: "/a/b/2", value: nil, want: `{"a":{"b":[1,2,null]},"c":"d"}`},
+		{name: "success - whole document", pointer: "", value: []int{1}, want: `[1]`},
+		{name: "fail - missing parent", pointer: "/x/y", value: 1, wantErr: true},
+		{name: "fail - out of range", pointer: "/a/b/5", value: 1, wantErr: true},
+		{name: "fail - below append", pointer: "/a/b/-/x", value: 1, wantErr: true},
+		{name: "fail - through scalar", pointer: "/c/x", value: 1, wantErr: true},
+		{name: "fail - invalid pointer", pointer: "c", value: 1, wantErr: true},
+		{name: "fail - unsupported value", pointer: "/c", value: make(chan int), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SetPointer([]byte(base), tt.pointer, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetPointer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(got) != tt.want {
+				t.Errorf("SetPointer() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
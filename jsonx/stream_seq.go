@@ -0,0 +1,31 @@
+//go:build go1.23
+
+package jsonx
+
+import (
+	"errors"
+	"io"
+	"iter"
+)
+
+var errStopped = errors.New("jsonx: stopped")
+
+// StreamArray returns a sequence lazily decoding the elements of the JSON array of r, like StreamArrayFunc.
+// A decoding error is yielded with the zero value of T and ends the sequence. r is read as the sequence is
+// consumed, so the sequence can only be iterated once.
+func StreamArray[T any](r io.Reader) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		err := StreamArrayFunc(r, func(v T) error {
+			if !yield(v, nil) {
+				return errStopped
+			}
+
+			return nil
+		})
+
+		if err != nil && err != errStopped {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
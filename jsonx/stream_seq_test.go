@@ -0,0 +1,48 @@
+//go:build go1.23
+
+package jsonx
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStreamArray(t *testing.T) {
+	got := make([]item, 0)
+	for v, err := range StreamArray[item](strings.NewReader(`[{"id": 1}, {"id": 2}, {"id": 3}]`)) {
+		if err != nil {
+			t.Fatalf("StreamArray() error = %v", err)
+		}
+
+		if v.ID == 3 {
+			break
+		}
+
+		got = append(got, v)
+	}
+
+	if want := []item{{ID: 1}, {ID: 2}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("StreamArray() = %+v, want %+v", got, want)
+	}
+}
+
+func TestStreamArrayError(t *testing.T) {
+	var (
+		values []int
+		errs   []error
+	)
+	for v, err := range StreamArray[int](strings.NewReader(`[1, "two", 3]`)) {
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		values = append(values, v)
+	}
+
+	if !reflect.DeepEqual(values, []int{1}) || len(errs) != 1 || !strings.Contains(errs[0].Error(), "element 1") {
+		t.Errorf("StreamArray() = %v, errors %v, want [1] and one error on element 1", values, errs)
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Package jwt defines minimal JSON Web Token issuance and verification with HS256, RS256 and ES256.
+*/
+package jwt
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+var (
+	// ErrMalformed is returned when a token is not a well-formed compact JWS.
+	ErrMalformed = errors.New("malformed token")
+	// ErrAlgorithm is returned when the token algorithm does not match the verification key.
+	ErrAlgorithm = errors.New("unexpected signing algorithm")
+	// ErrSignature is returned when the token signature is invalid.
+	ErrSignature = errors.New("invalid signature")
+	// ErrExpired is returned when the token is past its expiration time.
+	ErrExpired = errors.New("token is expired")
+	// ErrNotYetValid is returned when the token is used before its not before time.
+	ErrNotYetValid = errors.New("token is not valid yet")
+	// ErrIssuer is returned when the token issuer is not the expected one.
+	ErrIssuer = errors.New("invalid issuer")
+	// ErrAudience is returned when the token is not intended for the expected audience.
+	ErrAudience = errors.New("invalid audience")
+)
+
+// Claims is implemented by claim types, usually by embedding RegisteredClaims in a struct of custom claims.
+type Claims interface {
+	Registered() RegisteredClaims
+}
+
+// RegisteredClaims holds the registered claim names of RFC 7519. Times are Unix seconds; zero means unset.
+type RegisteredClaims struct {
+	Issuer    string   `json:"iss,omitempty"`
+	Subject   string   `json:"sub,omitempty"`
+	Audience  Audience `json:"aud,omitempty"`
+	ExpiresAt int64    `json:"exp,omitempty"`
+	NotBefore int64    `json:"nbf,omitempty"`
+	IssuedAt  int64    `json:"iat,omitempty"`
+	ID        string   `json:"jti,omitempty"`
+}
+
+// Registered implements Claims.
+func (c RegisteredClaims) Registered() RegisteredClaims {
+	return c
+}
+
+// Audience is the "aud" claim, encoded as a single string when it has one value and as an array otherwise.
+type Audience []string
+
+// MarshalJSON implements json.Marshaler.
+func (a Audience) MarshalJSON() ([]byte, error) {
+	if len(a) == 1 {
+		return json.Marshal(a[0])
+	}
+
+	return json.Marshal([]string(a))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting a string or an array of strings.
+func (a *Audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = Audience{single}
+
+		return nil
+	}
+
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+
+	*a = many
+
+	return nil
+}
+
+// Contains reports whether audience is one of the values of a.
+func (a Audience) Contains(audience string) bool {
+	for _, v := range a {
+		if v == audience {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validation configures the claim checks made by Parse.
+type Validation struct {
+	Issuer        string        // Required "iss" when not empty
+	Audience      string        // Required value of "aud" when not empty
+	Leeway        time.Duration // Tolerated clock skew for "exp" and "nbf"
+	RequireExpiry bool          // Reject tokens without "exp"
+	Clock         timex.Clock   // Source of the current time, timex.RealClock when nil
+}
+
+type header struct {
+	Algorithm string `json:"alg"`
+	Type      string `json:"typ,omitempty"`
+}
+
+var encoding = base64.RawURLEncoding
+
+// Sign encodes the claims into a compact token signed with key.
+func Sign[C Claims](claims C, key Key) (string, error) {
+	if key.sign == nil {
+		return "", fmt.Errorf("key cannot sign %s tokens", key.alg)
+	}
+
+	h, err := json.Marshal(header{Algorithm: key.alg, Type: "JWT"})
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode claims: %w", err)
+	}
+
+	signingInput := encoding.EncodeToString(h) + "." + encoding.EncodeToString(payload)
+
+	signature, err := key.sign([]byte(signingInput))
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	return signingInput + "." + encoding.EncodeToString(signature), nil
+}
+
+// Parse verifies the token signature with key, decodes its claims into C and validates the registered claims.
+// The token algorithm must match the key, so "none" and algorithm confusion attacks are rejected.
+func Parse[C Claims](token string, key Key, validation Validation) (C, error) {
+	var claims C
+
+	if key.verify == nil {
+		return claims, fmt.Errorf("key cannot verify tokens")
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return claims, ErrMalformed
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return claims, err
+	}
+
+	if h.Algorithm != key.alg {
+		return claims, fmt.Errorf("%w: got %q, want %q", ErrAlgorithm, h.Algorithm, key.alg)
+	}
+
+	signature, err := encoding.DecodeString(parts[2])
+	if err != nil {
+		return claims, ErrMalformed
+	}
+
+	if !key.verify([]byte(parts[0]+"."+parts[1]), signature) {
+		return claims, ErrSignature
+	}
+
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return claims, err
+	}
+
+	return claims, validate(claims.Registered(), validation)
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := encoding.DecodeString(segment)
+	if err != nil {
+		return ErrMalformed
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+
+	return nil
+}
+
+func validate(claims RegisteredClaims, validation Validation) error {
+	var clock timex.Clock = timex.RealClock{}
+	if validation.Clock != nil {
+		clock = validation.Clock
+	}
+
+	now := clock.Now()
+
+	if claims.ExpiresAt == 0 && validation.RequireExpiry {
+		return fmt.Errorf("%w: missing exp claim", ErrExpired)
+	}
+
+	if claims.ExpiresAt != 0 && !now.Before(time.Unix(claims.ExpiresAt, 0).Add(validation.Leeway)) {
+		return ErrExpired
+	}
+
+	if claims.NotBefore != 0 && now.Add(validation.Leeway).Before(time.Unix(claims.NotBefore, 0)) {
+		return ErrNotYetValid
+	}
+
+	if validation.Issuer != "" && claims.Issuer != validation.Issuer {
+		return ErrIssuer
+	}
+
+	if validation.Audience != "" && !claims.Audience.Contains(validation.Audience) {
+		return ErrAudience
+	}
+
+	return nil
+}
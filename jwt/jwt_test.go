@@ -0,0 +1,163 @@
+package jwt
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type userClaims struct {
+	RegisteredClaims
+	Name  string   `json:"name"`
+	Roles []string `json:"roles"`
+}
+
+func TestSignParse(t *testing.T) {
+	key := HS256([]byte("secret"))
+	claims := userClaims{
+		RegisteredClaims: RegisteredClaims{
+			Issuer:    "auth.example.com",
+			Subject:   "42",
+			Audience:  Audience{"api"},
+			ExpiresAt: epoch.Add(time.Hour).Unix(),
+		},
+		Name:  "Ada",
+		Roles: []string{"admin"},
+	}
+
+	token, err := Sign(claims, key)
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+
+	got, err := Parse[userClaims](token, key, Validation{
+		Issuer:   "auth.example.com",
+		Audience: "api",
+		Clock:    timex.NewFakeClock(epoch),
+	})
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(got, claims) {
+		t.Errorf("Parse() = %+v, want %+v", got, claims)
+	}
+}
+
+func TestParseValidation(t *testing.T) {
+	key := HS256([]byte("secret"))
+	registered := RegisteredClaims{
+		Issuer:    "issuer",
+		Audience:  Audience{"web", "api"},
+		NotBefore: epoch.Unix(),
+		ExpiresAt: epoch.Add(time.Hour).Unix(),
+	}
+
+	tests := []struct {
+		name       string
+		claims     RegisteredClaims
+		now        time.Time
+		validation Validation
+		wantErr    error
+	}{
+		{name: "success - valid", claims: registered, now: epoch.Add(time.Minute)},
+		{name: "success - matching issuer and audience", claims: registered, now: epoch,
+			validation: Validation{Issuer: "issuer", Audience: "api"}},
+		{name: "success - expired within leeway", claims: registered, now: epoch.Add(time.Hour + 30*time.Second),
+			validation: Validation{Leeway: time.Minute}},
+		{name: "success - not yet valid within leeway", claims: registered, now: epoch.Add(-30 * time.Second),
+			validation: Validation{Leeway: time.Minute}},
+		{name: "fail - expired", claims: registered, now: epoch.Add(time.Hour), wantErr: ErrExpired},
+		{name: "fail - not yet valid", claims: registered, now: epoch.Add(-time.Second), wantErr: ErrNotYetValid},
+		{name: "fail - missing expiry", claims: RegisteredClaims{}, now: epoch,
+			validation: Validation{RequireExpiry: true}, wantErr: ErrExpired},
+		{name: "fail - wrong issuer", claims: registered, now: epoch,
+			validation: Validation{Issuer: "other"}, wantErr: ErrIssuer},
+		{name: "fail - wrong audience", claims: registered, now: epoch,
+			validation: Validation{Audience: "mobile"}, wantErr: ErrAudience},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := Sign(tt.claims, key)
+			if err != nil {
+				t.Fatalf("Sign() error = %v", err)
+			}
+
+			tt.validation.Clock = timex.NewFakeClock(tt.now)
+			if _, err := Parse[RegisteredClaims](token, key, tt.validation); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Parse() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseMalformed(t *testing.T) {
+	key := HS256([]byte("secret"))
+	token, _ := Sign(RegisteredClaims{Subject: "42"}, key)
+	parts := strings.Split(token, ".")
+
+	badPayload := parts[0] + "." + encoding.EncodeToString([]byte("{"))
+	badSignature, _ := key.sign([]byte(badPayload))
+	badPayload += "." + encoding.EncodeToString(badSignature)
+
+	none := encoding.EncodeToString([]byte(`{"alg":"none"}`)) + "." + parts[1] + "."
+
+	tests := []struct {
+		name    string
+		token   string
+		key     Key
+		wantErr error
+	}{
+		{name: "fail - two segments", token: parts[0] + "." + parts[1], key: key, wantErr: ErrMalformed},
+		{name: "fail - bad header encoding", token: "!." + parts[1] + "." + parts[2], key: key, wantErr: ErrMalformed},
+		{name: "fail - bad signature encoding", token: parts[0] + "." + parts[1] + ".!", key: key, wantErr: ErrMalformed},
+		{name: "fail - bad payload json", token: badPayload, key: key, wantErr: ErrMalformed},
+		{name: "fail - none algorithm", token: none, key: key, wantErr: ErrAlgorithm},
+		{name: "fail - wrong secret", token: token, key: HS256([]byte("other")), wantErr: ErrSignature},
+		{name: "fail - tampered payload", token: parts[0] + "." + encoding.EncodeToString([]byte(`{"sub":"1"}`)) + "." + parts[2],
+			key: key, wantErr: ErrSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse[RegisteredClaims](tt.token, tt.key, Validation{}); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Parse() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAudienceJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		audience Audience
+		want     string
+	}{
+		{name: "success - single", audience: Audience{"api"}, want: `"api"`},
+		{name: "success - many", audience: Audience{"api", "web"}, want: `["api","web"]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.audience)
+			if err != nil || string(data) != tt.want {
+				t.Fatalf("MarshalJSON() = %s, %v, want %v", data, err, tt.want)
+			}
+
+			var got Audience
+			if err := json.Unmarshal(data, &got); err != nil || !reflect.DeepEqual(got, tt.audience) {
+				t.Errorf("UnmarshalJSON() = %v, %v, want %v", got, err, tt.audience)
+			}
+		})
+	}
+
+	var got Audience
+	if err := json.Unmarshal([]byte(`42`), &got); err == nil {
+		t.Errorf("UnmarshalJSON() expected error for a number")
+	}
+}
@@ -0,0 +1,117 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+)
+
+// Key signs and verifies tokens with a single algorithm. Keys built from public keys can only verify.
+type Key struct {
+	alg    string
+	sign   func(signingInput []byte) ([]byte, error)
+	verify func(signingInput, signature []byte) bool
+}
+
+// Algorithm returns the JWS algorithm name of the key, such as "HS256".
+func (k Key) Algorithm() string {
+	return k.alg
+}
+
+// HS256 returns a key signing and verifying with HMAC-SHA256 and the shared secret.
+func HS256(secret []byte) Key {
+	mac := func(signingInput []byte) []byte {
+		h := hmac.New(sha256.New, secret)
+		h.Write(signingInput)
+
+		return h.Sum(nil)
+	}
+
+	return Key{
+		alg: "HS256",
+		sign: func(signingInput []byte) ([]byte, error) {
+			if len(secret) == 0 {
+				return nil, fmt.Errorf("empty secret")
+			}
+
+			return mac(signingInput), nil
+		},
+		verify: func(signingInput, signature []byte) bool {
+			return len(secret) > 0 && hmac.Equal(mac(signingInput), signature)
+		},
+	}
+}
+
+// RS256 returns a key signing with RSASSA-PKCS1-v1_5 SHA-256 and verifying with the public half of key.
+func RS256(key *rsa.PrivateKey) Key {
+	k := RS256Public(&key.PublicKey)
+	k.sign = func(signingInput []byte) ([]byte, error) {
+		digest := sha256.Sum256(signingInput)
+
+		return rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	}
+
+	return k
+}
+
+// RS256Public returns a key verifying RS256 tokens with the public key.
+func RS256Public(key *rsa.PublicKey) Key {
+	return Key{
+		alg: "RS256",
+		verify: func(signingInput, signature []byte) bool {
+			digest := sha256.Sum256(signingInput)
+
+			return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+		},
+	}
+}
+
+// ES256 returns a key signing with ECDSA P-256 SHA-256 and verifying with the public half of key.
+func ES256(key *ecdsa.PrivateKey) Key {
+	k := ES256Public(&key.PublicKey)
+	k.sign = func(signingInput []byte) ([]byte, error) {
+		if key.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("ES256 requires a P-256 key")
+		}
+
+		digest := sha256.Sum256(signingInput)
+
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		if err != nil {
+			return nil, err
+		}
+
+		// JWS encodes the signature as the fixed size concatenation of r and s.
+		signature := make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+
+		return signature, nil
+	}
+
+	return k
+}
+
+// ES256Public returns a key verifying ES256 tokens with the public key.
+func ES256Public(key *ecdsa.PublicKey) Key {
+	return Key{
+		alg: "ES256",
+		verify: func(signingInput, signature []byte) bool {
+			if key.Curve != elliptic.P256() || len(signature) != 64 {
+				return false
+			}
+
+			digest := sha256.Sum256(signingInput)
+			r := new(big.Int).SetBytes(signature[:32])
+			s := new(big.Int).SetBytes(signature[32:])
+
+			return ecdsa.Verify(key, digest[:], r, s)
+		},
+	}
+}
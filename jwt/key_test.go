@@ -0,0 +1,87 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"testing"
+)
+
+func TestKeys(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherEC, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	tests := []struct {
+		name    string
+		signer  Key
+		parser  Key
+		wantErr error
+	}{
+		{name: "success - HS256", signer: HS256([]byte("secret")), parser: HS256([]byte("secret"))},
+		{name: "success - RS256", signer: RS256(rsaKey), parser: RS256Public(&rsaKey.PublicKey)},
+		{name: "success - ES256", signer: ES256(ecKey), parser: ES256Public(&ecKey.PublicKey)},
+		{name: "success - ES256 private key verifies", signer: ES256(ecKey), parser: ES256(ecKey)},
+		{name: "fail - ES256 wrong key", signer: ES256(ecKey), parser: ES256Public(&otherEC.PublicKey), wantErr: ErrSignature},
+		{name: "fail - RS256 token with HS256 key", signer: RS256(rsaKey), parser: HS256([]byte("secret")), wantErr: ErrAlgorithm},
+		{name: "fail - HS256 token with RS256 key", signer: HS256([]byte("secret")), parser: RS256Public(&rsaKey.PublicKey),
+			wantErr: ErrAlgorithm},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := Sign(RegisteredClaims{Subject: "42"}, tt.signer)
+			if err != nil {
+				t.Fatalf("Sign() error = %v", err)
+			}
+
+			claims, err := Parse[RegisteredClaims](token, tt.parser, Validation{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && claims.Subject != "42" {
+				t.Errorf("Parse() subject = %v, want 42", claims.Subject)
+			}
+		})
+	}
+}
+
+func TestKeysCannotSign(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	p384, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+
+	tests := []struct {
+		name string
+		key  Key
+	}{
+		{name: "fail - public key", key: RS256Public(&rsaKey.PublicKey)},
+		{name: "fail - empty secret", key: HS256(nil)},
+		{name: "fail - wrong curve", key: ES256(p384)},
+		{name: "fail - zero key", key: Key{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Sign(RegisteredClaims{}, tt.key); err == nil {
+				t.Errorf("Sign() expected error")
+			}
+		})
+	}
+
+	if _, err := Parse[RegisteredClaims]("a.b.c", Key{}, Validation{}); err == nil {
+		t.Errorf("Parse() expected error for a zero key")
+	}
+
+	if got := HS256(nil).Algorithm(); got != "HS256" {
+		t.Errorf("Algorithm() = %v, want HS256", got)
+	}
+}
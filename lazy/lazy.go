@@ -0,0 +1,133 @@
+/*
+Package lazy defines helpers for lazy initialization.
+*/
+package lazy
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+// Options contains options to configure a Value.
+type Options struct {
+	ErrorTTL time.Duration // How long a failure is returned before init is called again, retried on the next Get if zero
+	Clock    timex.Clock   // Clock measuring the ErrorTTL, the real clock by default
+}
+
+// Value is a lazily initialized value. Unlike sync.OnceValue, failures are not cached forever.
+type Value[T any] struct {
+	init func() (T, error)
+	opts Options
+
+	loaded atomic.Value // *T holding the successful result, nil until then
+	mu     sync.Mutex
+	err    error
+	retry  time.Time // when the cached failure expires
+}
+
+// New returns a Value calling init on the first Get, and again after each failure.
+func New[T any](init func() (T, error)) *Value[T] {
+	return NewWithOptions(init, Options{})
+}
+
+// NewWithOptions returns a Value configured with opts.
+func NewWithOptions[T any](init func() (T, error), opts Options) *Value[T] {
+	if opts.Clock == nil {
+		opts.Clock = timex.RealClock{}
+	}
+
+	return &Value[T]{init: init, opts: opts}
+}
+
+// Get returns the value, calling init if it did not succeed yet. Concurrent callers wait for a single init call.
+func (v *Value[T]) Get() (T, error) {
+	if value, ok := v.load(); ok {
+		return value, nil
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if value, ok := v.load(); ok {
+		return value, nil
+	}
+
+	if v.err != nil && v.opts.Clock.Now().Before(v.retry) {
+		var zero T
+		return zero, v.err
+	}
+
+	value, err := v.init()
+	if err != nil {
+		v.err = err
+		v.retry = v.opts.Clock.Now().Add(v.opts.ErrorTTL)
+
+		var zero T
+		return zero, err
+	}
+
+	v.err = nil
+	v.loaded.Store(&value)
+
+	return value, nil
+}
+
+func (v *Value[T]) load() (T, bool) {
+	if p, _ := v.loaded.Load().(*T); p != nil {
+		return *p, true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// MustGet is like Get but panics if init fails.
+func (v *Value[T]) MustGet() T {
+	value, err := v.Get()
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// Reset discards the value or failure, so the next Get calls init again.
+func (v *Value[T]) Reset() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.err, v.retry = nil, time.Time{}
+	v.loaded.Store((*T)(nil))
+}
+
+// ResettableOnce is like sync.Once but can be reset to run again. The zero value is ready to use.
+type ResettableOnce struct {
+	done uint32
+	mu   sync.Mutex
+}
+
+// Do calls fn if Do was not called since the creation of o or the last Reset.
+func (o *ResettableOnce) Do(fn func()) {
+	if atomic.LoadUint32(&o.done) == 1 {
+		return
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.done == 0 {
+		defer atomic.StoreUint32(&o.done, 1)
+		fn()
+	}
+}
+
+// Reset lets the next Do call its function again.
+func (o *ResettableOnce) Reset() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	atomic.StoreUint32(&o.done, 0)
+}
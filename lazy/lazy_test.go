@@ -0,0 +1,113 @@
+package lazy
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+var errTest = errors.New("test error")
+
+func TestValueMemoizesSuccess(t *testing.T) {
+	var calls int64
+	v := New(func() (int, error) {
+		atomic.AddInt64(&calls, 1)
+		return 42, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got, err := v.Get(); got != 42 || err != nil {
+				t.Errorf("Get() = %v, %v, want 42, nil", got, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("init calls = %v, want %v", calls, 1)
+	}
+
+	v.Reset()
+	if got := v.MustGet(); got != 42 || calls != 2 {
+		t.Errorf("MustGet() = %v with %d calls after Reset(), want 42 with 2 calls", got, calls)
+	}
+}
+
+func TestValueRetriesFailures(t *testing.T) {
+	calls := 0
+	v := New(func() (string, error) {
+		calls++
+		if calls < 3 {
+			return "", errTest
+		}
+
+		return "connected", nil
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := v.Get(); err != errTest {
+			t.Errorf("Get() error = %v, want %v", err, errTest)
+		}
+	}
+
+	if got, err := v.Get(); got != "connected" || err != nil {
+		t.Errorf("Get() = %v, %v, want connected, nil", got, err)
+	}
+}
+
+func TestValueErrorTTL(t *testing.T) {
+	clock := timex.NewFakeClock(time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC))
+
+	calls := 0
+	v := NewWithOptions(func() (int, error) {
+		calls++
+		return 0, errTest
+	}, Options{ErrorTTL: time.Minute, Clock: clock})
+
+	_, _ = v.Get()
+	_, _ = v.Get()
+	if calls != 1 {
+		t.Errorf("init calls = %v within the ErrorTTL, want %v", calls, 1)
+	}
+
+	clock.Advance(time.Minute)
+	_, _ = v.Get()
+	if calls != 2 {
+		t.Errorf("init calls = %v after the ErrorTTL, want %v", calls, 2)
+	}
+}
+
+func TestMustGetPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustGet() did not panic on failure")
+		}
+	}()
+
+	New(func() (int, error) { return 0, errTest }).MustGet()
+}
+
+func TestResettableOnce(t *testing.T) {
+	var o ResettableOnce
+	calls := 0
+
+	o.Do(func() { calls++ })
+	o.Do(func() { calls++ })
+	if calls != 1 {
+		t.Errorf("calls = %v, want %v", calls, 1)
+	}
+
+	o.Reset()
+	o.Do(func() { calls++ })
+	if calls != 2 {
+		t.Errorf("calls = %v after Reset(), want %v", calls, 2)
+	}
+}
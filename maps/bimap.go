@@ -0,0 +1,142 @@
+package maps
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// BiMap is a one-to-one map keeping an inverse index, so values can be looked up by key and keys by value.
+// The zero value is an empty map ready to use. A BiMap is not safe for concurrent use.
+type BiMap[K, V comparable] struct {
+	forward map[K]V
+	inverse map[V]K
+}
+
+// NewBiMap creates a new empty BiMap.
+func NewBiMap[K, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{
+		forward: make(map[K]V),
+		inverse: make(map[V]K),
+	}
+}
+
+func (m *BiMap[K, V]) init() {
+	if m.forward == nil {
+		m.forward = make(map[K]V)
+		m.inverse = make(map[V]K)
+	}
+}
+
+// Put maps the key to the value. It returns an error if the key is already mapped to another value
+// or the value is already mapped to another key.
+func (m *BiMap[K, V]) Put(key K, value V) error {
+	m.init()
+
+	if existing, ok := m.forward[key]; ok && existing != value {
+		return fmt.Errorf("key %v is already mapped to value %v", key, existing)
+	}
+
+	if existing, ok := m.inverse[value]; ok && existing != key {
+		return fmt.Errorf("value %v is already mapped to key %v", value, existing)
+	}
+
+	m.forward[key] = value
+	m.inverse[value] = key
+
+	return nil
+}
+
+// ForcePut maps the key to the value, removing any existing mapping of the key or of the value.
+func (m *BiMap[K, V]) ForcePut(key K, value V) {
+	m.init()
+
+	m.DeleteKey(key)
+	m.DeleteValue(value)
+
+	m.forward[key] = value
+	m.inverse[value] = key
+}
+
+// Get returns the value mapped to the key and whether it was found.
+func (m *BiMap[K, V]) Get(key K) (V, bool) {
+	v, ok := m.forward[key]
+
+	return v, ok
+}
+
+// GetKey returns the key mapped to the value and whether it was found.
+func (m *BiMap[K, V]) GetKey(value V) (K, bool) {
+	k, ok := m.inverse[value]
+
+	return k, ok
+}
+
+// DeleteKey removes the key and its value from the map.
+func (m *BiMap[K, V]) DeleteKey(key K) {
+	if v, ok := m.forward[key]; ok {
+		delete(m.forward, key)
+		delete(m.inverse, v)
+	}
+}
+
+// DeleteValue removes the value and its key from the map.
+func (m *BiMap[K, V]) DeleteValue(value V) {
+	if k, ok := m.inverse[value]; ok {
+		delete(m.inverse, value)
+		delete(m.forward, k)
+	}
+}
+
+// Len returns the number of entries of the map.
+func (m *BiMap[K, V]) Len() int {
+	return len(m.forward)
+}
+
+// Inverse returns a new BiMap with the keys and values swapped.
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	inverse := NewBiMap[V, K]()
+	for k, v := range m.forward {
+		inverse.forward[v] = k
+		inverse.inverse[k] = v
+	}
+
+	return inverse
+}
+
+// Map returns a copy of the key to value mapping.
+func (m *BiMap[K, V]) Map() map[K]V {
+	result := make(map[K]V, len(m.forward))
+	for k, v := range m.forward {
+		result[k] = v
+	}
+
+	return result
+}
+
+// MarshalJSON encodes the key to value mapping as a JSON object.
+func (m *BiMap[K, V]) MarshalJSON() ([]byte, error) {
+	if m.forward == nil {
+		return []byte("{}"), nil
+	}
+
+	return json.Marshal(m.forward)
+}
+
+// UnmarshalJSON decodes a JSON object into the map. It returns an error if several keys share the same value.
+func (m *BiMap[K, V]) UnmarshalJSON(data []byte) error {
+	var decoded map[K]V
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	result := NewBiMap[K, V]()
+	for k, v := range decoded {
+		if err := result.Put(k, v); err != nil {
+			return err
+		}
+	}
+
+	*m = *result
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package maps
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestBiMap(t *testing.T) {
+	var m BiMap[string, int]
+
+	if err := m.Put("ext-1", 100); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	if err := m.Put("ext-2", 200); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	if v, ok := m.Get("ext-1"); !ok || v != 100 {
+		t.Errorf("Get() = %v, %v, want %v, %v", v, ok, 100, true)
+	}
+
+	if k, ok := m.GetKey(200); !ok || k != "ext-2" {
+		t.Errorf("GetKey() = %v, %v, want %v, %v", k, ok, "ext-2", true)
+	}
+
+	m.DeleteKey("ext-1")
+	if _, ok := m.GetKey(100); ok {
+		t.Errorf("DeleteKey() did not remove the inverse entry")
+	}
+
+	m.DeleteValue(200)
+	if _, ok := m.Get("ext-2"); ok || m.Len() != 0 {
+		t.Errorf("DeleteValue() did not remove the forward entry")
+	}
+}
+
+func TestBiMap_Conflicts(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		value   int
+		wantErr bool
+	}{
+		{name: "success - same mapping again", key: "a", value: 1, wantErr: false},
+		{name: "fail - key mapped to another value", key: "a", value: 2, wantErr: true},
+		{name: "fail - value mapped to another key", key: "b", value: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewBiMap[string, int]()
+			_ = m.Put("a", 1)
+
+			if err := m.Put(tt.key, tt.value); (err != nil) != tt.wantErr {
+				t.Errorf("Put() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if m.Len() != 1 {
+				t.Errorf("Put() changed the map after a conflict: %v", m.Map())
+			}
+		})
+	}
+}
+
+func TestBiMap_ForcePut(t *testing.T) {
+	m := NewBiMap[string, int]()
+	_ = m.Put("a", 1)
+	_ = m.Put("b", 2)
+
+	m.ForcePut("a", 2)
+
+	if want := map[string]int{"a": 2}; !reflect.DeepEqual(m.Map(), want) {
+		t.Errorf("ForcePut() = %v, want %v", m.Map(), want)
+	}
+
+	if k, _ := m.GetKey(2); k != "a" {
+		t.Errorf("GetKey() = %v, want %v", k, "a")
+	}
+}
+
+func TestBiMap_Inverse(t *testing.T) {
+	m := NewBiMap[string, int]()
+	_ = m.Put("a", 1)
+
+	inverse := m.Inverse()
+	if k, ok := inverse.Get(1); !ok || k != "a" {
+		t.Errorf("Inverse().Get() = %v, %v, want %v, %v", k, ok, "a", true)
+	}
+}
+
+func TestBiMap_JSON(t *testing.T) {
+	m := NewBiMap[string, int]()
+	_ = m.Put("a", 1)
+	_ = m.Put("b", 2)
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+
+	if want := `{"a":1,"b":2}`; string(data) != want {
+		t.Errorf("MarshalJSON() = %s, want %s", data, want)
+	}
+
+	var decoded BiMap[string, int]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+
+	if k, ok := decoded.GetKey(2); !ok || k != "b" {
+		t.Errorf("GetKey() after UnmarshalJSON() = %v, %v, want %v, %v", k, ok, "b", true)
+	}
+
+	if err := json.Unmarshal([]byte(`{"a":1,"b":1}`), &decoded); err == nil {
+		t.Errorf("UnmarshalJSON() expected error for duplicate values")
+	}
+}
@@ -0,0 +1,226 @@
+package maps
+
+import (
+	"hash/maphash"
+	"math"
+	"sync"
+)
+
+// DefaultShardCount is the number of shards used by NewConcurrentMap when the provided count is not positive.
+const DefaultShardCount = 32
+
+// ConcurrentMap is a typed map safe for concurrent use. Keys are spread over several shards,
+// each one with its own lock, to reduce contention between goroutines.
+type ConcurrentMap[K comparable, V any] struct {
+	shards []*shard[K, V]
+	hasher func(K) uint64
+}
+
+type shard[K comparable, V any] struct {
+	mu      sync.RWMutex
+	entries map[K]V
+}
+
+// NewConcurrentMap creates a new ConcurrentMap with the given number of shards.
+// Keys are hashed with a default hasher, which is fast for strings and numbers and uses
+// maphash.Comparable for other types, such as structs and arrays, since Go 1.24.
+// Before Go 1.24 those keys are hashed by their formatted text, which is slow and sends equal keys
+// holding -0 and 0 floats to different shards, so they need NewConcurrentMapWithHasher.
+func NewConcurrentMap[K comparable, V any](shards int) *ConcurrentMap[K, V] {
+	seed := maphash.MakeSeed()
+
+	return NewConcurrentMapWithHasher[K, V](shards, func(key K) uint64 {
+		return defaultHash(seed, key)
+	})
+}
+
+// NewConcurrentMapWithHasher creates a new ConcurrentMap with the given number of shards,
+// using hasher to assign keys to shards.
+func NewConcurrentMapWithHasher[K comparable, V any](shards int, hasher func(K) uint64) *ConcurrentMap[K, V] {
+	if shards <= 0 {
+		shards = DefaultShardCount
+	}
+
+	m := &ConcurrentMap[K, V]{
+		shards: make([]*shard[K, V], shards),
+		hasher: hasher,
+	}
+
+	for i := range m.shards {
+		m.shards[i] = &shard[K, V]{entries: make(map[K]V)}
+	}
+
+	return m
+}
+
+func (m *ConcurrentMap[K, V]) shardFor(key K) *shard[K, V] {
+	return m.shards[m.hasher(key)%uint64(len(m.shards))]
+}
+
+// Load returns the value stored for the key and whether it was found.
+func (m *ConcurrentMap[K, V]) Load(key K) (V, bool) {
+	s := m.shardFor(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	v, ok := s.entries[key]
+
+	return v, ok
+}
+
+// Store stores the value for the key.
+func (m *ConcurrentMap[K, V]) Store(key K, value V) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = value
+}
+
+// Delete removes the key from the map.
+func (m *ConcurrentMap[K, V]) Delete(key K) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+}
+
+// LoadAndDelete removes the key from the map and returns its previous value, if any.
+func (m *ConcurrentMap[K, V]) LoadAndDelete(key K) (V, bool) {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.entries[key]
+	delete(s.entries, key)
+
+	return v, ok
+}
+
+// GetOrCompute returns the value stored for the key. If the key is missing, compute is called
+// to build the value, which is stored and returned. Concurrent calls for the same key call compute
+// only once. compute must not access the map.
+func (m *ConcurrentMap[K, V]) GetOrCompute(key K, compute func() V) V {
+	s := m.shardFor(key)
+
+	s.mu.RLock()
+	v, ok := s.entries[key]
+	s.mu.RUnlock()
+
+	if ok {
+		return v
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// another goroutine may have stored the key while the lock was released
+	if v, ok := s.entries[key]; ok {
+		return v
+	}
+
+	v = compute()
+	s.entries[key] = v
+
+	return v
+}
+
+// Update atomically replaces the value of the key with the result of fn, which receives the current
+// value and whether the key was present, and returns the new value. fn must not access the map.
+func (m *ConcurrentMap[K, V]) Update(key K, fn func(value V, exists bool) V) V {
+	s := m.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.entries[key]
+	v = fn(v, ok)
+	s.entries[key] = v
+
+	return v
+}
+
+// Range calls f for each entry of the map until f returns false. Each shard is locked for reading
+// while its entries are visited, so f must not modify the map.
+func (m *ConcurrentMap[K, V]) Range(f func(key K, value V) bool) {
+	for _, s := range m.shards {
+		s.mu.RLock()
+		for k, v := range s.entries {
+			if !f(k, v) {
+				s.mu.RUnlock()
+				return
+			}
+		}
+		s.mu.RUnlock()
+	}
+}
+
+// Len returns the number of entries of the map.
+func (m *ConcurrentMap[K, V]) Len() int {
+	n := 0
+	for _, s := range m.shards {
+		s.mu.RLock()
+		n += len(s.entries)
+		s.mu.RUnlock()
+	}
+
+	return n
+}
+
+func defaultHash[K comparable](seed maphash.Seed, key K) uint64 {
+	switch k := any(key).(type) {
+	case string:
+		var h maphash.Hash
+		h.SetSeed(seed)
+		_, _ = h.WriteString(k)
+		return h.Sum64()
+	case int:
+		return mix64(uint64(k))
+	case int8:
+		return mix64(uint64(k))
+	case int16:
+		return mix64(uint64(k))
+	case int32:
+		return mix64(uint64(k))
+	case int64:
+		return mix64(uint64(k))
+	case uint:
+		return mix64(uint64(k))
+	case uint32:
+		return mix64(uint64(k))
+	case uint64:
+		return mix64(k)
+	case uint8:
+		return mix64(uint64(k))
+	case uint16:
+		return mix64(uint64(k))
+	case uintptr:
+		return mix64(uint64(k))
+	case float32:
+		return mix64(floatBits(float64(k)))
+	case float64:
+		return mix64(floatBits(k))
+	default:
+		return hashComparable(seed, key)
+	}
+}
+
+// floatBits returns the bits of f, with -0 and 0 sharing the same bits as they are equal keys.
+func floatBits(f float64) uint64 {
+	if f == 0 {
+		return 0
+	}
+
+	return math.Float64bits(f)
+}
+
+// mix64 is the splitmix64 finalizer, spreading consecutive integers over all the shards.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+
+	return x
+}
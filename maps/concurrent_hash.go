@@ -0,0 +1,10 @@
+//go:build go1.24
+
+package maps
+
+import "hash/maphash"
+
+// hashComparable hashes any comparable key, equal keys always sharing the same hash.
+func hashComparable[K comparable](seed maphash.Seed, key K) uint64 {
+	return maphash.Comparable(seed, key)
+}
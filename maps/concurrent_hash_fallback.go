@@ -0,0 +1,17 @@
+//go:build !go1.24
+
+package maps
+
+import (
+	"fmt"
+	"hash/maphash"
+)
+
+// hashComparable hashes the formatted key, so equal keys holding floats -0 and 0 hash differently.
+func hashComparable[K comparable](seed maphash.Seed, key K) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	_, _ = h.WriteString(fmt.Sprintf("%#v", key))
+
+	return h.Sum64()
+}
@@ -0,0 +1,30 @@
+//go:build go1.24
+
+package maps
+
+import (
+	"math"
+	"testing"
+)
+
+func TestConcurrentMap_CompositeZeroKeys(t *testing.T) {
+	type point struct{ X, Y float64 }
+
+	negZero := math.Copysign(0, -1)
+
+	m := NewConcurrentMap[point, string](0)
+	m.Store(point{0, 0}, "a")
+	m.Store(point{negZero, 0}, "b")
+
+	if v, ok := m.Load(point{0, negZero}); !ok || v != "b" || m.Len() != 1 {
+		t.Errorf("Load() = %v, %v with len %v, want %v, %v with len %v", v, ok, m.Len(), "b", true, 1)
+	}
+
+	arrays := NewConcurrentMap[[2]float32, int](0)
+	arrays.Store([2]float32{0, 1}, 1)
+	arrays.Store([2]float32{float32(negZero), 1}, 2)
+
+	if arrays.Len() != 1 {
+		t.Errorf("Len() = %v, want %v", arrays.Len(), 1)
+	}
+}
@@ -0,0 +1,172 @@
+package maps
+
+import (
+	"hash/maphash"
+	"math"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestConcurrentMap(t *testing.T) {
+	m := NewConcurrentMap[string, int](4)
+
+	m.Store("a", 1)
+	m.Store("b", 2)
+
+	if v, ok := m.Load("a"); !ok || v != 1 {
+		t.Errorf("Load() = %v, %v, want %v, %v", v, ok, 1, true)
+	}
+
+	if _, ok := m.Load("missing"); ok {
+		t.Errorf("Load() found a missing key")
+	}
+
+	if m.Len() != 2 {
+		t.Errorf("Len() = %v, want %v", m.Len(), 2)
+	}
+
+	m.Delete("a")
+	if _, ok := m.Load("a"); ok {
+		t.Errorf("Delete() did not remove the key")
+	}
+
+	if v, ok := m.LoadAndDelete("b"); !ok || v != 2 || m.Len() != 0 {
+		t.Errorf("LoadAndDelete() = %v, %v, len %v", v, ok, m.Len())
+	}
+}
+
+func TestConcurrentMap_KeyTypes(t *testing.T) {
+	type point struct{ X, Y int }
+
+	m := NewConcurrentMap[point, string](0)
+	m.Store(point{1, 2}, "a")
+	m.Store(point{2, 1}, "b")
+
+	if v, ok := m.Load(point{1, 2}); !ok || v != "a" {
+		t.Errorf("Load() = %v, %v, want %v, %v", v, ok, "a", true)
+	}
+
+	ints := NewConcurrentMap[int, int](8)
+	for i := 0; i < 100; i++ {
+		ints.Store(i, i*i)
+	}
+
+	if v, _ := ints.Load(9); v != 81 || ints.Len() != 100 {
+		t.Errorf("Load() = %v, len %v", v, ints.Len())
+	}
+
+	floats := NewConcurrentMap[float64, string](0)
+	floats.Store(0.0, "zero")
+
+	negZero := math.Copysign(0, -1)
+	if v, ok := floats.Load(negZero); !ok || v != "zero" {
+		t.Errorf("Load(-0) = %v, %v, want %v, %v", v, ok, "zero", true)
+	}
+
+	small := NewConcurrentMap[int8, int](8)
+	for i := -50; i < 50; i++ {
+		small.Store(int8(i), i)
+	}
+
+	if v, _ := small.Load(-7); v != -7 || small.Len() != 100 {
+		t.Errorf("Load() = %v, len %v", v, small.Len())
+	}
+}
+
+func TestDefaultHash(t *testing.T) {
+	seed := maphash.MakeSeed()
+
+	tests := []struct {
+		name string
+		a, b uint64
+	}{
+		{name: "success - float64 zeros", a: defaultHash(seed, 0.0), b: defaultHash(seed, math.Copysign(0, -1))},
+		{name: "success - float32 zeros", a: defaultHash(seed, float32(0)), b: defaultHash(seed, float32(math.Copysign(0, -1)))},
+		{name: "success - int16", a: defaultHash(seed, int16(-3)), b: defaultHash(seed, -3)},
+		{name: "success - uintptr", a: defaultHash(seed, uintptr(42)), b: mix64(42)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.a != tt.b {
+				t.Errorf("defaultHash() = %v, want %v", tt.a, tt.b)
+			}
+		})
+	}
+}
+
+func TestConcurrentMap_GetOrCompute(t *testing.T) {
+	m := NewConcurrentMap[string, int](4)
+	var calls int32
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v := m.GetOrCompute("key", func() int {
+				atomic.AddInt32(&calls, 1)
+				return 42
+			})
+			if v != 42 {
+				t.Errorf("GetOrCompute() = %v, want %v", v, 42)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("compute called %d times, want 1", calls)
+	}
+}
+
+func TestConcurrentMap_Update(t *testing.T) {
+	m := NewConcurrentMap[string, int](4)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			m.Update("counter"+strconv.Itoa(i%2), func(v int, _ bool) int { return v + 1 })
+		}(i)
+	}
+	wg.Wait()
+
+	if v, _ := m.Load("counter0"); v != 50 {
+		t.Errorf("Update() counter0 = %v, want %v", v, 50)
+	}
+
+	if v, _ := m.Load("counter1"); v != 50 {
+		t.Errorf("Update() counter1 = %v, want %v", v, 50)
+	}
+}
+
+func TestConcurrentMap_Range(t *testing.T) {
+	m := NewConcurrentMapWithHasher[int, int](3, func(k int) uint64 { return uint64(k) })
+	for i := 0; i < 10; i++ {
+		m.Store(i, i)
+	}
+
+	sum := 0
+	m.Range(func(_ int, v int) bool {
+		sum += v
+		return true
+	})
+
+	if sum != 45 {
+		t.Errorf("Range() sum = %v, want %v", sum, 45)
+	}
+
+	visited := 0
+	m.Range(func(_ int, _ int) bool {
+		visited++
+		return visited < 3
+	})
+
+	if visited != 3 {
+		t.Errorf("Range() visited %v entries after stopping, want %v", visited, 3)
+	}
+}
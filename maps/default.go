@@ -0,0 +1,69 @@
+package maps
+
+// DefaultMap is a map that builds a default value for missing keys, like Python's defaultdict.
+// A DefaultMap is not safe for concurrent use.
+type DefaultMap[K comparable, V any] struct {
+	entries map[K]V
+	factory func() V
+}
+
+// NewDefaultMap creates a new DefaultMap using factory to build the value of missing keys.
+func NewDefaultMap[K comparable, V any](factory func() V) *DefaultMap[K, V] {
+	return &DefaultMap[K, V]{
+		entries: make(map[K]V),
+		factory: factory,
+	}
+}
+
+// Get returns the value stored for the key. If the key is missing, a default value is
+// built with the factory, stored and returned.
+func (m *DefaultMap[K, V]) Get(key K) V {
+	if v, ok := m.entries[key]; ok {
+		return v
+	}
+
+	v := m.factory()
+	m.entries[key] = v
+
+	return v
+}
+
+// Lookup returns the value stored for the key and whether it was found, without building a default value.
+func (m *DefaultMap[K, V]) Lookup(key K) (V, bool) {
+	v, ok := m.entries[key]
+
+	return v, ok
+}
+
+// Set stores the value for the key.
+func (m *DefaultMap[K, V]) Set(key K, value V) {
+	m.entries[key] = value
+}
+
+// Update replaces the value of the key, or its default value if missing, with the result of f.
+// For example, m.Update(key, func(v []int) []int { return append(v, 1) }) accumulates values in slices.
+func (m *DefaultMap[K, V]) Update(key K, f func(V) V) {
+	m.entries[key] = f(m.Get(key))
+}
+
+// Has checks if the key is present in the map.
+func (m *DefaultMap[K, V]) Has(key K) bool {
+	_, ok := m.entries[key]
+
+	return ok
+}
+
+// Delete removes the key from the map.
+func (m *DefaultMap[K, V]) Delete(key K) {
+	delete(m.entries, key)
+}
+
+// Len returns the number of entries of the map.
+func (m *DefaultMap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+// Map returns the underlying map. Changes to it are reflected in the DefaultMap.
+func (m *DefaultMap[K, V]) Map() map[K]V {
+	return m.entries
+}
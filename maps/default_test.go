@@ -0,0 +1,62 @@
+package maps
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDefaultMap_Get(t *testing.T) {
+	calls := 0
+	m := NewDefaultMap[string, int](func() int {
+		calls++
+		return 42
+	})
+
+	if got := m.Get("a"); got != 42 {
+		t.Errorf("Get() = %v, want %v", got, 42)
+	}
+
+	m.Set("b", 1)
+	if got := m.Get("b"); got != 1 {
+		t.Errorf("Get() = %v, want %v", got, 1)
+	}
+
+	m.Get("a")
+	if calls != 1 {
+		t.Errorf("factory called %d times, want 1", calls)
+	}
+
+	if !m.Has("a") || m.Len() != 2 {
+		t.Errorf("Has() = %v, Len() = %v", m.Has("a"), m.Len())
+	}
+}
+
+func TestDefaultMap_Lookup(t *testing.T) {
+	m := NewDefaultMap[string, int](func() int { return 42 })
+
+	if _, ok := m.Lookup("a"); ok {
+		t.Errorf("Lookup() found a missing key")
+	}
+
+	if m.Has("a") {
+		t.Errorf("Lookup() stored a default value")
+	}
+}
+
+func TestDefaultMap_Update(t *testing.T) {
+	groups := NewDefaultMap[string, []string](func() []string { return nil })
+
+	for _, word := range []string{"apple", "avocado", "banana"} {
+		groups.Update(word[:1], func(v []string) []string { return append(v, word) })
+	}
+
+	want := map[string][]string{"a": {"apple", "avocado"}, "b": {"banana"}}
+	if !reflect.DeepEqual(groups.Map(), want) {
+		t.Errorf("Map() = %v, want %v", groups.Map(), want)
+	}
+
+	groups.Delete("a")
+	if groups.Has("a") {
+		t.Errorf("Delete() did not remove the key")
+	}
+}
@@ -0,0 +1,75 @@
+package maps
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Flatten returns a single level map from a nested map, joining the keys of nested maps with sep.
+// For example, {"a": {"b": {"c": 1}}} becomes {"a.b.c": 1} with "." as separator.
+// Slices and empty maps are kept as values.
+func Flatten(m map[string]any, sep string) map[string]any {
+	result := make(map[string]any)
+	flatten(result, "", m, sep)
+
+	return result
+}
+
+func flatten(result map[string]any, prefix string, m map[string]any, sep string) {
+	for k, v := range m {
+		key := k
+		if prefix != "" {
+			key = prefix + sep + k
+		}
+
+		if nested, ok := v.(map[string]any); ok && len(nested) > 0 {
+			flatten(result, key, nested, sep)
+			continue
+		}
+
+		result[key] = v
+	}
+}
+
+// Unflatten builds a nested map from a single level map whose keys are joined with sep, reversing Flatten.
+// It returns an error if a key is both a value and the parent of other keys, like "a" and "a.b".
+func Unflatten(m map[string]any, sep string) (map[string]any, error) {
+	if sep == "" {
+		return nil, fmt.Errorf("separator cannot be empty")
+	}
+
+	// sorting the keys makes the reported conflicts deterministic
+	keys := SortedKeys(m)
+
+	result := make(map[string]any)
+	for _, key := range keys {
+		parts := strings.Split(key, sep)
+		current := result
+
+		for i, part := range parts[:len(parts)-1] {
+			next, exists := current[part]
+			if !exists {
+				child := make(map[string]any)
+				current[part] = child
+				current = child
+				continue
+			}
+
+			child, ok := next.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("key %q conflicts with the value of key %q", key, strings.Join(parts[:i+1], sep))
+			}
+			current = child
+		}
+
+		last := parts[len(parts)-1]
+		if existing, exists := current[last]; exists {
+			if _, ok := existing.(map[string]any); ok {
+				return nil, fmt.Errorf("key %q conflicts with nested keys", key)
+			}
+		}
+		current[last] = m[key]
+	}
+
+	return result, nil
+}
@@ -0,0 +1,105 @@
+package maps
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name  string
+		input map[string]any
+		sep   string
+		want  map[string]any
+	}{
+		{
+			name: "success - flatten nested maps",
+			input: map[string]any{
+				"db":    map[string]any{"host": "localhost", "pool": map[string]any{"size": 10}},
+				"debug": true,
+			},
+			sep:  ".",
+			want: map[string]any{"db.host": "localhost", "db.pool.size": 10, "debug": true},
+		},
+		{
+			name:  "success - keep slices and empty maps as values",
+			input: map[string]any{"tags": []any{"a", "b"}, "extra": map[string]any{}},
+			sep:   "_",
+			want:  map[string]any{"tags": []any{"a", "b"}, "extra": map[string]any{}},
+		},
+		{
+			name:  "success - env style separator",
+			input: map[string]any{"APP": map[string]any{"PORT": 8080}},
+			sep:   "__",
+			want:  map[string]any{"APP__PORT": 8080},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Flatten(tt.input, tt.sep); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Flatten() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnflatten(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   map[string]any
+		sep     string
+		want    map[string]any
+		wantErr bool
+	}{
+		{
+			name:  "success - unflatten keys",
+			input: map[string]any{"db.host": "localhost", "db.pool.size": 10, "debug": true},
+			sep:   ".",
+			want: map[string]any{
+				"db":    map[string]any{"host": "localhost", "pool": map[string]any{"size": 10}},
+				"debug": true,
+			},
+		},
+		{
+			name:    "fail - value and nested keys conflict",
+			input:   map[string]any{"db": "postgres", "db.host": "localhost"},
+			sep:     ".",
+			wantErr: true,
+		},
+		{
+			name:    "fail - empty separator",
+			input:   map[string]any{"a": 1},
+			sep:     "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Unflatten(tt.input, tt.sep)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unflatten() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Unflatten() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlattenUnflattenRoundTrip(t *testing.T) {
+	input := map[string]any{
+		"server": map[string]any{"http": map[string]any{"port": 80}, "name": "api"},
+		"level":  "info",
+	}
+
+	got, err := Unflatten(Flatten(input, "."), ".")
+	if err != nil {
+		t.Fatalf("Unflatten() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(got, input) {
+		t.Errorf("Unflatten(Flatten()) = %v, want %v", got, input)
+	}
+}
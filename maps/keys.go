@@ -0,0 +1,57 @@
+package maps
+
+import (
+	"sort"
+
+	"github.com/kashifkhan0771/utils/slice"
+)
+
+// Keys returns the keys of the map. The order of the result is not specified.
+func Keys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// Values returns the values of the map. The order of the result is not specified.
+func Values[K comparable, V any](m map[K]V) []V {
+	values := make([]V, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// Pair is a key-value entry of a map.
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// SortedKeys returns the keys of the map in ascending order.
+func SortedKeys[K slice.Ordered, V any](m map[K]V) []K {
+	keys := Keys(m)
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	return keys
+}
+
+// Entries returns the entries of the map sorted by key in ascending order.
+func Entries[K slice.Ordered, V any](m map[K]V) []Pair[K, V] {
+	return EntriesFunc(m, func(a, b Pair[K, V]) bool { return a.Key < b.Key })
+}
+
+// EntriesFunc returns the entries of the map sorted with the provided less function.
+func EntriesFunc[K comparable, V any](m map[K]V, less func(a, b Pair[K, V]) bool) []Pair[K, V] {
+	entries := make([]Pair[K, V], 0, len(m))
+	for k, v := range m {
+		entries = append(entries, Pair[K, V]{Key: k, Value: v})
+	}
+	sort.Slice(entries, func(i, j int) bool { return less(entries[i], entries[j]) })
+
+	return entries
+}
@@ -0,0 +1,66 @@
+package maps
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		m    map[string]int
+		want []string
+	}{
+		{name: "success - keys of a map", m: map[string]int{"b": 2, "a": 1}, want: []string{"a", "b"}},
+		{name: "success - empty map", m: map[string]int{}, want: []string{}},
+		{name: "success - nil map", m: nil, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Keys(tt.m)
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Keys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValues(t *testing.T) {
+	got := Values(map[string]int{"b": 2, "a": 1})
+	sort.Ints(got)
+
+	if want := []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+}
+
+func TestSortedKeys(t *testing.T) {
+	got := SortedKeys(map[string]int{"c": 3, "a": 1, "b": 2})
+
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedKeys() = %v, want %v", got, want)
+	}
+}
+
+func TestEntries(t *testing.T) {
+	got := Entries(map[int]string{3: "c", 1: "a", 2: "b"})
+	want := []Pair[int, string]{{1, "a"}, {2, "b"}, {3, "c"}}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Entries() = %v, want %v", got, want)
+	}
+}
+
+func TestEntriesFunc(t *testing.T) {
+	scores := map[string]int{"alice": 7, "bob": 9, "carol": 3}
+
+	got := EntriesFunc(scores, func(a, b Pair[string, int]) bool { return a.Value > b.Value })
+	want := []Pair[string, int]{{"bob", 9}, {"alice", 7}, {"carol", 3}}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("EntriesFunc() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,69 @@
+package maps
+
+// Merge copies the entries of src into dst. When a key exists in both maps, the value
+// stored is the one returned by resolve, called with the value of dst and the value of src.
+// A nil resolve keeps the value of src.
+func Merge[K comparable, V any](dst, src map[K]V, resolve func(key K, a, b V) V) {
+	for k, v := range src {
+		if existing, ok := dst[k]; ok && resolve != nil {
+			v = resolve(k, existing, v)
+		}
+		dst[k] = v
+	}
+}
+
+// MergeAll returns a new map holding the entries of all the maps, merged from left to right
+// with the same conflict resolution as Merge.
+func MergeAll[K comparable, V any](resolve func(key K, a, b V) V, maps ...map[K]V) map[K]V {
+	result := make(map[K]V)
+	for _, m := range maps {
+		Merge(result, m, resolve)
+	}
+
+	return result
+}
+
+// DeepMerge returns a new map with the entries of src merged into the entries of dst.
+// Nested map[string]any values present in both maps are merged recursively, any other
+// value of src replaces the value of dst. Neither input map is modified.
+// It is useful to combine layered configuration trees.
+func DeepMerge(dst, src map[string]any) map[string]any {
+	result := make(map[string]any, len(dst)+len(src))
+	for k, v := range dst {
+		result[k] = deepCopy(v)
+	}
+
+	for k, v := range src {
+		srcMap, srcIsMap := v.(map[string]any)
+		dstMap, dstIsMap := result[k].(map[string]any)
+
+		if srcIsMap && dstIsMap {
+			result[k] = DeepMerge(dstMap, srcMap)
+			continue
+		}
+
+		result[k] = deepCopy(v)
+	}
+
+	return result
+}
+
+// deepCopy copies nested map[string]any and []any values so merged trees don't share them.
+func deepCopy(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for k, nested := range v {
+			result[k] = deepCopy(nested)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, nested := range v {
+			result[i] = deepCopy(nested)
+		}
+		return result
+	default:
+		return value
+	}
+}
@@ -0,0 +1,90 @@
+package maps
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	sum := func(_ string, a, b int) int { return a + b }
+
+	tests := []struct {
+		name    string
+		dst     map[string]int
+		src     map[string]int
+		resolve func(string, int, int) int
+		want    map[string]int
+	}{
+		{
+			name:    "success - overwrite on conflict",
+			dst:     map[string]int{"a": 1, "b": 2},
+			src:     map[string]int{"b": 3, "c": 4},
+			resolve: nil,
+			want:    map[string]int{"a": 1, "b": 3, "c": 4},
+		},
+		{
+			name:    "success - sum on conflict",
+			dst:     map[string]int{"a": 1, "b": 2},
+			src:     map[string]int{"b": 3, "c": 4},
+			resolve: sum,
+			want:    map[string]int{"a": 1, "b": 5, "c": 4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Merge(tt.dst, tt.src, tt.resolve)
+			if !reflect.DeepEqual(tt.dst, tt.want) {
+				t.Errorf("Merge() = %v, want %v", tt.dst, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeAll(t *testing.T) {
+	keepFirst := func(_ string, a, _ string) string { return a }
+
+	a := map[string]string{"env": "dev", "region": "us"}
+	b := map[string]string{"env": "prod"}
+	c := map[string]string{"debug": "true"}
+
+	got := MergeAll(keepFirst, a, b, c)
+	want := map[string]string{"env": "dev", "region": "us", "debug": "true"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeAll() = %v, want %v", got, want)
+	}
+
+	if len(a) != 2 {
+		t.Errorf("MergeAll() modified its input: %v", a)
+	}
+}
+
+func TestDeepMerge(t *testing.T) {
+	defaults := map[string]any{
+		"server": map[string]any{"host": "localhost", "port": 8080},
+		"tags":   []any{"a"},
+		"debug":  false,
+	}
+	overrides := map[string]any{
+		"server": map[string]any{"port": 9090, "tls": map[string]any{"enabled": true}},
+		"tags":   []any{"b"},
+		"debug":  map[string]any{"level": "info"},
+	}
+
+	got := DeepMerge(defaults, overrides)
+	want := map[string]any{
+		"server": map[string]any{"host": "localhost", "port": 9090, "tls": map[string]any{"enabled": true}},
+		"tags":   []any{"b"},
+		"debug":  map[string]any{"level": "info"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DeepMerge() = %v, want %v", got, want)
+	}
+
+	// the result must not share nested maps with the inputs
+	got["server"].(map[string]any)["host"] = "changed"
+	if defaults["server"].(map[string]any)["host"] != "localhost" {
+		t.Errorf("DeepMerge() result shares nested maps with its input")
+	}
+}
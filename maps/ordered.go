@@ -0,0 +1,262 @@
+package maps
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// OrderedMap is a map that preserves the insertion order of its keys.
+// Setting an existing key keeps its original position. The zero value is an empty map ready to use.
+// An OrderedMap is not safe for concurrent use.
+type OrderedMap[K comparable, V any] struct {
+	entries map[K]*orderedEntry[K, V]
+	head    *orderedEntry[K, V]
+	tail    *orderedEntry[K, V]
+}
+
+type orderedEntry[K comparable, V any] struct {
+	key   K
+	value V
+	prev  *orderedEntry[K, V]
+	next  *orderedEntry[K, V]
+}
+
+// NewOrderedMap creates a new empty OrderedMap.
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{}
+}
+
+// Set stores the value for the key. New keys are added at the end of the map.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if entry, ok := m.entries[key]; ok {
+		entry.value = value
+		return
+	}
+
+	if m.entries == nil {
+		m.entries = make(map[K]*orderedEntry[K, V])
+	}
+
+	entry := &orderedEntry[K, V]{key: key, value: value, prev: m.tail}
+	if m.tail != nil {
+		m.tail.next = entry
+	} else {
+		m.head = entry
+	}
+	m.tail = entry
+	m.entries[key] = entry
+}
+
+// Get returns the value stored for the key and whether it was found.
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if entry, ok := m.entries[key]; ok {
+		return entry.value, true
+	}
+
+	var zero V
+
+	return zero, false
+}
+
+// Has checks if the key is present in the map.
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := m.entries[key]
+
+	return ok
+}
+
+// Delete removes the key from the map and reports whether it was present.
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	entry, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+
+	if entry.prev != nil {
+		entry.prev.next = entry.next
+	} else {
+		m.head = entry.next
+	}
+
+	if entry.next != nil {
+		entry.next.prev = entry.prev
+	} else {
+		m.tail = entry.prev
+	}
+
+	delete(m.entries, key)
+
+	return true
+}
+
+// Len returns the number of entries of the map.
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+// Keys returns the keys of the map in insertion order.
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Len())
+	for entry := m.head; entry != nil; entry = entry.next {
+		keys = append(keys, entry.key)
+	}
+
+	return keys
+}
+
+// Values returns the values of the map in insertion order.
+func (m *OrderedMap[K, V]) Values() []V {
+	values := make([]V, 0, m.Len())
+	for entry := m.head; entry != nil; entry = entry.next {
+		values = append(values, entry.value)
+	}
+
+	return values
+}
+
+// Entries returns the entries of the map in insertion order.
+func (m *OrderedMap[K, V]) Entries() []Pair[K, V] {
+	entries := make([]Pair[K, V], 0, m.Len())
+	for entry := m.head; entry != nil; entry = entry.next {
+		entries = append(entries, Pair[K, V]{Key: entry.key, Value: entry.value})
+	}
+
+	return entries
+}
+
+// Range calls f for each entry of the map in insertion order until f returns false.
+func (m *OrderedMap[K, V]) Range(f func(key K, value V) bool) {
+	for entry := m.head; entry != nil; entry = entry.next {
+		if !f(entry.key, entry.value) {
+			return
+		}
+	}
+}
+
+// MarshalJSON encodes the map as a JSON object keeping the insertion order of the keys.
+// Keys must be strings, integers or implement encoding.TextMarshaler.
+// It has a value receiver so maps held by value in other structs are encoded too.
+func (m OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	for entry := m.head; entry != nil; entry = entry.next {
+		if entry != m.head {
+			buf.WriteByte(',')
+		}
+
+		key, err := marshalKey(entry.key)
+		if err != nil {
+			return nil, err
+		}
+
+		encodedKey, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encodedKey)
+		buf.WriteByte(':')
+
+		encodedValue, err := json.Marshal(entry.value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal value of key %q: %w", key, err)
+		}
+		buf.Write(encodedValue)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON object into the map keeping the order of its keys.
+// The decoded entries are added to the existing ones.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("cannot unmarshal %v into an ordered map: expected a JSON object", token)
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		key, err := unmarshalKey[K](token.(string))
+		if err != nil {
+			return err
+		}
+
+		var value V
+		if err := decoder.Decode(&value); err != nil {
+			return fmt.Errorf("failed to unmarshal value of key %q: %w", token, err)
+		}
+
+		m.Set(key, value)
+	}
+
+	_, err = decoder.Token()
+
+	return err
+}
+
+func marshalKey(key any) (string, error) {
+	if marshaler, ok := key.(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		return string(text), err
+	}
+
+	v := reflect.ValueOf(key)
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	default:
+		return "", fmt.Errorf("unsupported key type %T", key)
+	}
+}
+
+func unmarshalKey[K comparable](text string) (K, error) {
+	var key K
+
+	if unmarshaler, ok := any(&key).(encoding.TextUnmarshaler); ok {
+		err := unmarshaler.UnmarshalText([]byte(text))
+		return key, err
+	}
+
+	v := reflect.ValueOf(&key).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(text)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 10, v.Type().Bits())
+		if err != nil {
+			return key, fmt.Errorf("invalid key %q: %w", text, err)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(text, 10, v.Type().Bits())
+		if err != nil {
+			return key, fmt.Errorf("invalid key %q: %w", text, err)
+		}
+		v.SetUint(n)
+	default:
+		return key, fmt.Errorf("unsupported key type %T", key)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,171 @@
+package maps
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestOrderedMap(t *testing.T) {
+	var m OrderedMap[string, int]
+
+	m.Set("c", 3)
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("a", 10)
+
+	if got, want := m.Keys(), []string{"c", "a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Keys() = %v, want %v", got, want)
+	}
+
+	if got, want := m.Values(), []int{3, 10, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+
+	if v, ok := m.Get("a"); !ok || v != 10 {
+		t.Errorf("Get() = %v, %v, want %v, %v", v, ok, 10, true)
+	}
+
+	if _, ok := m.Get("missing"); ok {
+		t.Errorf("Get() found a missing key")
+	}
+
+	if !m.Delete("c") || m.Delete("c") {
+		t.Errorf("Delete() returned unexpected results")
+	}
+
+	m.Set("c", 30)
+	if got, want := m.Entries(), []Pair[string, int]{{"a", 10}, {"b", 2}, {"c", 30}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Entries() = %v, want %v", got, want)
+	}
+
+	if m.Len() != 3 || !m.Has("b") {
+		t.Errorf("Len() = %v, Has() = %v", m.Len(), m.Has("b"))
+	}
+}
+
+func TestOrderedMap_Delete(t *testing.T) {
+	tests := []struct {
+		name   string
+		delete []string
+		want   []string
+	}{
+		{name: "success - delete head", delete: []string{"a"}, want: []string{"b", "c"}},
+		{name: "success - delete middle", delete: []string{"b"}, want: []string{"a", "c"}},
+		{name: "success - delete tail", delete: []string{"c"}, want: []string{"a", "b"}},
+		{name: "success - delete everything", delete: []string{"a", "b", "c"}, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewOrderedMap[string, bool]()
+			for _, k := range []string{"a", "b", "c"} {
+				m.Set(k, true)
+			}
+
+			for _, k := range tt.delete {
+				m.Delete(k)
+			}
+
+			if got := m.Keys(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Keys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_Range(t *testing.T) {
+	m := NewOrderedMap[int, string]()
+	m.Set(2, "two")
+	m.Set(1, "one")
+	m.Set(3, "three")
+
+	var keys []int
+	m.Range(func(k int, _ string) bool {
+		keys = append(keys, k)
+		return len(keys) < 2
+	})
+
+	if want := []int{2, 1}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Range() visited %v, want %v", keys, want)
+	}
+}
+
+func TestOrderedMap_JSON(t *testing.T) {
+	input := `{"zeta":1,"alpha":{"nested":true},"mid":[1,2]}`
+
+	m := NewOrderedMap[string, any]()
+	if err := json.Unmarshal([]byte(input), m); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+
+	if got, want := m.Keys(), []string{"zeta", "alpha", "mid"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Keys() = %v, want %v", got, want)
+	}
+
+	output, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+
+	if string(output) != input {
+		t.Errorf("MarshalJSON() = %s, want %s", output, input)
+	}
+}
+
+func TestOrderedMap_JSONIntKeys(t *testing.T) {
+	type response struct {
+		Scores OrderedMap[int, float64] `json:"scores"`
+	}
+
+	var r response
+	if err := json.Unmarshal([]byte(`{"scores":{"3":1.5,"1":2}}`), &r); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+
+	if got, want := r.Scores.Keys(), []int{3, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Keys() = %v, want %v", got, want)
+	}
+
+	output, err := json.Marshal(&r)
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+
+	if want := `{"scores":{"3":1.5,"1":2}}`; string(output) != want {
+		t.Errorf("MarshalJSON() = %s, want %s", output, want)
+	}
+}
+
+func TestOrderedMap_JSONValueField(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("b", 2)
+	m.Set("a", 1)
+
+	output, err := json.Marshal(struct{ M OrderedMap[string, int] }{M: *m})
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+
+	if want := `{"M":{"b":2,"a":1}}`; string(output) != want {
+		t.Errorf("MarshalJSON() = %s, want %s", output, want)
+	}
+}
+
+func TestOrderedMap_JSONErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "fail - not an object", input: `[1,2]`},
+		{name: "fail - invalid key", input: `{"one":1}`},
+		{name: "fail - invalid value", input: `{"1":"one"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewOrderedMap[int, int]()
+			if err := json.Unmarshal([]byte(tt.input), m); err == nil {
+				t.Errorf("UnmarshalJSON() expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package maps
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// pathToken is a segment of a path: a map key, or a slice index when isIndex is true.
+type pathToken struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+func (t pathToken) String() string {
+	if t.isIndex {
+		return fmt.Sprintf("[%d]", t.index)
+	}
+
+	return t.key
+}
+
+// parsePath splits a path like "a.b[2].c" into its tokens.
+func parsePath(path string) ([]pathToken, error) {
+	if path == "" {
+		return nil, fmt.Errorf("path cannot be empty")
+	}
+
+	var tokens []pathToken
+	for _, segment := range strings.Split(path, ".") {
+		key := segment
+		if i := strings.IndexByte(segment, '['); i >= 0 {
+			key = segment[:i]
+		}
+
+		if key == "" {
+			return nil, fmt.Errorf("invalid path %q: empty key", path)
+		}
+		tokens = append(tokens, pathToken{key: key})
+
+		rest := segment[len(key):]
+		for rest != "" {
+			end := strings.IndexByte(rest, ']')
+			if rest[0] != '[' || end < 0 {
+				return nil, fmt.Errorf("invalid path %q: malformed index in %q", path, segment)
+			}
+
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid path %q: invalid index %q", path, rest[1:end])
+			}
+
+			tokens = append(tokens, pathToken{index: index, isIndex: true})
+			rest = rest[end+1:]
+		}
+	}
+
+	return tokens, nil
+}
+
+// GetPath returns the value found at the path in a nested map, like the ones decoded from JSON or YAML.
+// The path is made of keys separated by dots and slice indexes in brackets, e.g. "a.b[2].c".
+func GetPath(m map[string]any, path string) (any, error) {
+	tokens, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var current any = m
+	for i, token := range tokens {
+		switch node := current.(type) {
+		case map[string]any:
+			value, ok := node[token.key]
+			if token.isIndex || !ok {
+				return nil, fmt.Errorf("path %q not found at %q", path, pathPrefix(tokens[:i+1]))
+			}
+			current = value
+		case []any:
+			if !token.isIndex || token.index >= len(node) {
+				return nil, fmt.Errorf("path %q not found at %q", path, pathPrefix(tokens[:i+1]))
+			}
+			current = node[token.index]
+		default:
+			return nil, fmt.Errorf("path %q not found at %q: %T is not a map or a slice", path, pathPrefix(tokens[:i+1]), current)
+		}
+	}
+
+	return current, nil
+}
+
+// GetStringPath returns the string found at the path in a nested map.
+func GetStringPath(m map[string]any, path string) (string, error) {
+	value, err := GetPath(m, path)
+	if err != nil {
+		return "", err
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("value at path %q is a %T, not a string", path, value)
+	}
+
+	return s, nil
+}
+
+// GetIntPath returns the integer found at the path in a nested map.
+// Whole floating-point numbers, as decoded from JSON, and json.Number values are accepted.
+func GetIntPath(m map[string]any, path string) (int, error) {
+	value, err := GetPath(m, path)
+	if err != nil {
+		return 0, err
+	}
+
+	switch v := value.(type) {
+	case int:
+		return v, nil
+	case int64:
+		return int(v), nil
+	case float64:
+		if v != math.Trunc(v) {
+			return 0, fmt.Errorf("value at path %q is not an integer: %v", path, v)
+		}
+		return int(v), nil
+	case json.Number:
+		n, err := strconv.Atoi(v.String())
+		if err != nil {
+			return 0, fmt.Errorf("value at path %q is not an integer: %w", path, err)
+		}
+		return n, nil
+	default:
+		return 0, fmt.Errorf("value at path %q is a %T, not an integer", path, value)
+	}
+}
+
+// SetPath stores the value at the path in a nested map, creating the missing intermediate maps.
+// Slice indexes in the path must already exist.
+func SetPath(m map[string]any, path string, value any) error {
+	tokens, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+
+	_, err = setPath(m, tokens, 0, value)
+
+	return err
+}
+
+func setPath(node any, tokens []pathToken, i int, value any) (any, error) {
+	if i == len(tokens) {
+		return value, nil
+	}
+
+	token := tokens[i]
+	if token.isIndex {
+		s, ok := node.([]any)
+		if !ok || token.index >= len(s) {
+			return nil, fmt.Errorf("index %q does not exist", pathPrefix(tokens[:i+1]))
+		}
+
+		child, err := setPath(s[token.index], tokens, i+1, value)
+		if err != nil {
+			return nil, err
+		}
+		s[token.index] = child
+
+		return s, nil
+	}
+
+	if node == nil {
+		node = make(map[string]any)
+	}
+
+	m, ok := node.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("cannot set key %q: %T is not a map", pathPrefix(tokens[:i+1]), node)
+	}
+
+	child, err := setPath(m[token.key], tokens, i+1, value)
+	if err != nil {
+		return nil, err
+	}
+	m[token.key] = child
+
+	return m, nil
+}
+
+// DeletePath removes the value found at the path in a nested map. Deleting a slice element
+// shifts the following elements. It returns an error if the path does not exist.
+func DeletePath(m map[string]any, path string) error {
+	tokens, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+
+	_, err = deletePath(m, tokens, 0)
+
+	return err
+}
+
+func deletePath(node any, tokens []pathToken, i int) (any, error) {
+	token := tokens[i]
+	last := i == len(tokens)-1
+
+	if token.isIndex {
+		s, ok := node.([]any)
+		if !ok || token.index >= len(s) {
+			return nil, fmt.Errorf("index %q does not exist", pathPrefix(tokens[:i+1]))
+		}
+
+		if last {
+			return append(s[:token.index:token.index], s[token.index+1:]...), nil
+		}
+
+		child, err := deletePath(s[token.index], tokens, i+1)
+		if err != nil {
+			return nil, err
+		}
+		s[token.index] = child
+
+		return s, nil
+	}
+
+	m, ok := node.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("key %q does not exist", pathPrefix(tokens[:i+1]))
+	}
+
+	child, exists := m[token.key]
+	if !exists {
+		return nil, fmt.Errorf("key %q does not exist", pathPrefix(tokens[:i+1]))
+	}
+
+	if last {
+		delete(m, token.key)
+		return m, nil
+	}
+
+	child, err := deletePath(child, tokens, i+1)
+	if err != nil {
+		return nil, err
+	}
+	m[token.key] = child
+
+	return m, nil
+}
+
+// pathPrefix formats tokens back into a path, used in error messages.
+func pathPrefix(tokens []pathToken) string {
+	var b strings.Builder
+	for i, token := range tokens {
+		if i > 0 && !token.isIndex {
+			b.WriteByte('.')
+		}
+		b.WriteString(token.String())
+	}
+
+	return b.String()
+}
@@ -0,0 +1,161 @@
+package maps
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func decodeJSON(t *testing.T, input string) map[string]any {
+	t.Helper()
+
+	var m map[string]any
+	if err := json.Unmarshal([]byte(input), &m); err != nil {
+		t.Fatalf("failed to decode test input: %v", err)
+	}
+
+	return m
+}
+
+const pathDocument = `{
+	"user": {"name": "alice", "age": 30, "height": 1.7},
+	"orders": [{"id": 1, "items": ["a", "b"]}, {"id": 2, "items": ["c"]}],
+	"matrix": [[1, 2], [3, 4]]
+}`
+
+func TestGetPath(t *testing.T) {
+	m := decodeJSON(t, pathDocument)
+
+	tests := []struct {
+		name    string
+		path    string
+		want    any
+		wantErr bool
+	}{
+		{name: "success - nested key", path: "user.name", want: "alice"},
+		{name: "success - slice index", path: "orders[1].id", want: float64(2)},
+		{name: "success - nested slice index", path: "orders[0].items[1]", want: "b"},
+		{name: "success - consecutive indexes", path: "matrix[1][0]", want: float64(3)},
+		{name: "fail - missing key", path: "user.email", wantErr: true},
+		{name: "fail - index out of range", path: "orders[5].id", wantErr: true},
+		{name: "fail - index on a map", path: "user[0]", wantErr: true},
+		{name: "fail - key on a scalar", path: "user.name.first", wantErr: true},
+		{name: "fail - malformed index", path: "orders[x]", wantErr: true},
+		{name: "fail - empty key", path: "user..name", wantErr: true},
+		{name: "fail - empty path", path: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetPath(m, tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetPath() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetStringPath(t *testing.T) {
+	m := decodeJSON(t, pathDocument)
+
+	if got, err := GetStringPath(m, "user.name"); err != nil || got != "alice" {
+		t.Errorf("GetStringPath() = %v, %v, want %v", got, err, "alice")
+	}
+
+	if _, err := GetStringPath(m, "user.age"); err == nil {
+		t.Errorf("GetStringPath() expected error for a number")
+	}
+}
+
+func TestGetIntPath(t *testing.T) {
+	m := decodeJSON(t, pathDocument)
+
+	if got, err := GetIntPath(m, "user.age"); err != nil || got != 30 {
+		t.Errorf("GetIntPath() = %v, %v, want %v", got, err, 30)
+	}
+
+	if _, err := GetIntPath(m, "user.height"); err == nil {
+		t.Errorf("GetIntPath() expected error for a decimal number")
+	}
+
+	if _, err := GetIntPath(m, "user.name"); err == nil {
+		t.Errorf("GetIntPath() expected error for a string")
+	}
+
+	if got, err := GetIntPath(map[string]any{"n": json.Number("12")}, "n"); err != nil || got != 12 {
+		t.Errorf("GetIntPath() = %v, %v, want %v", got, err, 12)
+	}
+}
+
+func TestSetPath(t *testing.T) {
+	m := decodeJSON(t, pathDocument)
+
+	tests := []struct {
+		name    string
+		path    string
+		value   any
+		wantErr bool
+	}{
+		{name: "success - replace nested key", path: "user.name", value: "bob"},
+		{name: "success - create intermediate maps", path: "settings.theme.color", value: "dark"},
+		{name: "success - set slice element", path: "orders[1].items[0]", value: "z"},
+		{name: "fail - index out of range", path: "orders[3].id", value: 3, wantErr: true},
+		{name: "fail - key on a scalar", path: "user.age.years", value: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := SetPath(m, tt.path, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetPath() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got, err := GetPath(m, tt.path); err != nil || !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("GetPath() after SetPath() = %v, %v, want %v", got, err, tt.value)
+			}
+		})
+	}
+}
+
+func TestDeletePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		check   string
+		want    any
+		wantErr bool
+	}{
+		{name: "success - delete nested key", path: "user.name", check: "user", want: map[string]any{"age": float64(30), "height": 1.7}},
+		{name: "success - delete slice element", path: "orders[0].items[0]", check: "orders[0].items", want: []any{"b"}},
+		{name: "success - delete whole slice element", path: "orders[0]", check: "orders[0].id", want: float64(2)},
+		{name: "fail - missing key", path: "user.email", wantErr: true},
+		{name: "fail - index out of range", path: "orders[4]", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := decodeJSON(t, pathDocument)
+
+			err := DeletePath(m, tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeletePath() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got, err := GetPath(m, tt.check); err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetPath() after DeletePath() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
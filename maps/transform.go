@@ -0,0 +1,86 @@
+package maps
+
+import "fmt"
+
+// Invert returns a new map with the keys and values of the map swapped.
+// It returns an error if several keys share the same value.
+func Invert[K, V comparable](m map[K]V) (map[V]K, error) {
+	result := make(map[V]K, len(m))
+	for k, v := range m {
+		if existing, ok := result[v]; ok {
+			return nil, fmt.Errorf("duplicate value %v for keys %v and %v", v, existing, k)
+		}
+		result[v] = k
+	}
+
+	return result, nil
+}
+
+// InvertLossy returns a new map with the keys and values of the map swapped.
+// When several keys share the same value, only one of them is kept and which one is not specified.
+func InvertLossy[K, V comparable](m map[K]V) map[V]K {
+	result := make(map[V]K, len(m))
+	for k, v := range m {
+		result[v] = k
+	}
+
+	return result
+}
+
+// MapKeys returns a new map with the keys transformed by f.
+// When several keys are transformed to the same key, only one of the values is kept and which one is not specified.
+func MapKeys[K, K2 comparable, V any](m map[K]V, f func(K) K2) map[K2]V {
+	result := make(map[K2]V, len(m))
+	for k, v := range m {
+		result[f(k)] = v
+	}
+
+	return result
+}
+
+// MapValues returns a new map with the values transformed by f.
+func MapValues[K comparable, V, V2 any](m map[K]V, f func(V) V2) map[K]V2 {
+	result := make(map[K]V2, len(m))
+	for k, v := range m {
+		result[k] = f(v)
+	}
+
+	return result
+}
+
+// Filter returns a new map with the entries for which keep returns true.
+func Filter[K comparable, V any](m map[K]V, keep func(K, V) bool) map[K]V {
+	result := make(map[K]V)
+	for k, v := range m {
+		if keep(k, v) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Pick returns a new map with only the entries of the provided keys that are present in the map.
+func Pick[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	result := make(map[K]V, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Omit returns a new map without the entries of the provided keys.
+func Omit[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	omitted := make(map[K]struct{}, len(keys))
+	for _, k := range keys {
+		omitted[k] = struct{}{}
+	}
+
+	return Filter(m, func(k K, _ V) bool {
+		_, ok := omitted[k]
+		return !ok
+	})
+}
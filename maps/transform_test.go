@@ -0,0 +1,118 @@
+package maps
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestInvert(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       map[string]int
+		want    map[int]string
+		wantErr bool
+	}{
+		{
+			name: "success - invert unique values",
+			m:    map[string]int{"one": 1, "two": 2},
+			want: map[int]string{1: "one", 2: "two"},
+		},
+		{
+			name:    "fail - duplicate values",
+			m:       map[string]int{"one": 1, "uno": 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Invert(tt.m)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Invert() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Invert() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInvertLossy(t *testing.T) {
+	got := InvertLossy(map[string]int{"one": 1, "uno": 1, "two": 2})
+
+	if len(got) != 2 || got[2] != "two" || (got[1] != "one" && got[1] != "uno") {
+		t.Errorf("InvertLossy() = %v", got)
+	}
+}
+
+func TestMapKeys(t *testing.T) {
+	got := MapKeys(map[string]int{"a": 1, "b": 2}, strings.ToUpper)
+
+	if want := map[string]int{"A": 1, "B": 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MapKeys() = %v, want %v", got, want)
+	}
+}
+
+func TestMapValues(t *testing.T) {
+	got := MapValues(map[string]int{"a": 1, "b": 2}, func(v int) bool { return v%2 == 0 })
+
+	if want := map[string]bool{"a": false, "b": true}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MapValues() = %v, want %v", got, want)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	got := Filter(map[string]int{"a": 1, "b": 2, "c": 3}, func(_ string, v int) bool { return v > 1 })
+
+	if want := map[string]int{"b": 2, "c": 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Filter() = %v, want %v", got, want)
+	}
+}
+
+func TestPick(t *testing.T) {
+	payload := map[string]any{"user": "alice", "password": "s3cr3t", "email": "alice@test.com"}
+
+	tests := []struct {
+		name string
+		keys []string
+		want map[string]any
+	}{
+		{
+			name: "success - pick existing keys",
+			keys: []string{"user", "email"},
+			want: map[string]any{"user": "alice", "email": "alice@test.com"},
+		},
+		{
+			name: "success - ignore missing keys",
+			keys: []string{"user", "missing"},
+			want: map[string]any{"user": "alice"},
+		},
+		{
+			name: "success - no keys",
+			keys: nil,
+			want: map[string]any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Pick(payload, tt.keys...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Pick() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOmit(t *testing.T) {
+	payload := map[string]any{"user": "alice", "password": "s3cr3t", "token": "abc"}
+
+	got := Omit(payload, "password", "token", "missing")
+	if want := map[string]any{"user": "alice"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Omit() = %v, want %v", got, want)
+	}
+
+	if len(payload) != 3 {
+		t.Errorf("Omit() modified its input: %v", payload)
+	}
+}
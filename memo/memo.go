@@ -0,0 +1,171 @@
+/*
+Package memo defines helpers to memoize functions.
+*/
+package memo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kashifkhan0771/utils/cache"
+	"github.com/kashifkhan0771/utils/single"
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+type config struct {
+	ttl     time.Duration
+	maxSize int
+	clock   timex.Clock
+}
+
+// Option configures Func.
+type Option func(*config)
+
+// TTL sets how long a result is reused, forever if zero.
+func TTL(d time.Duration) Option {
+	return func(c *config) { c.ttl = d }
+}
+
+// MaxSize bounds the number of results kept, evicting the least recently used. Unbounded if zero.
+func MaxSize(n int) Option {
+	return func(c *config) { c.maxSize = n }
+}
+
+// WithClock sets the clock measuring the TTL.
+func WithClock(clock timex.Clock) Option {
+	return func(c *config) { c.clock = clock }
+}
+
+// Func returns a memoized version of fn, which must return the same result for the same key.
+// Errors are not cached, and concurrent calls with the same key share a single call to fn.
+// Results are kept in memory without bound unless MaxSize is set, expired ones being dropped
+// at most once per TTL. It is safe for concurrent use.
+func Func[K comparable, V any](fn func(K) (V, error), opts ...Option) func(K) (V, error) {
+	c := config{clock: timex.RealClock{}}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	results := newResults[K, V](c)
+
+	var group single.Group[K, V]
+
+	return func(key K) (V, error) {
+		if value, ok := results.Get(key); ok {
+			return value, nil
+		}
+
+		return group.Do(context.Background(), key, func(context.Context) (V, error) {
+			value, err := fn(key)
+			if err != nil {
+				return value, err
+			}
+
+			results.Set(key, value)
+
+			return value, nil
+		})
+	}
+}
+
+// store is the part of a cache used by Func.
+type store[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Set(key K, value V)
+}
+
+// newResults returns the cache of the results of Func: a TTL cache owning the expiration,
+// or an LRU cache checking it when the size is bounded.
+func newResults[K comparable, V any](c config) store[K, V] {
+	if c.maxSize <= 0 {
+		ttl := cache.NewTTLWithOptions(cache.TTLOptions[K, V]{DefaultTTL: c.ttl, Clock: c.clock})
+
+		return &sweepingTTL[K, V]{TTL: ttl, ttl: c.ttl, clock: c.clock}
+	}
+
+	lru, _ := cache.NewLRU[K, entry[V]](c.maxSize)
+
+	return &expiringLRU[K, V]{lru: lru, ttl: c.ttl, clock: c.clock}
+}
+
+// sweepingTTL drops the expired results when storing one, at most once per TTL, so results of keys
+// never requested again do not stay in memory without a background goroutine.
+type sweepingTTL[K comparable, V any] struct {
+	*cache.TTL[K, V]
+	ttl       time.Duration
+	clock     timex.Clock
+	mu        sync.Mutex
+	nextSweep time.Time
+}
+
+func (s *sweepingTTL[K, V]) Set(key K, value V) {
+	s.TTL.Set(key, value)
+	if s.ttl <= 0 {
+		return
+	}
+
+	now := s.clock.Now()
+
+	s.mu.Lock()
+	sweep := !now.Before(s.nextSweep)
+	if sweep {
+		s.nextSweep = now.Add(s.ttl)
+	}
+	s.mu.Unlock()
+
+	if sweep {
+		s.DeleteExpired()
+	}
+}
+
+// expiringLRU is an LRU cache of results expiring after ttl, or never if zero.
+type expiringLRU[K comparable, V any] struct {
+	lru   *cache.LRU[K, entry[V]]
+	ttl   time.Duration
+	clock timex.Clock
+}
+
+type entry[V any] struct {
+	value   V
+	expires time.Time // zero if the result never expires
+}
+
+func (l *expiringLRU[K, V]) Get(key K) (V, bool) {
+	e, ok := l.lru.Get(key)
+	if ok && (e.expires.IsZero() || l.clock.Now().Before(e.expires)) {
+		return e.value, true
+	}
+
+	if ok {
+		l.lru.Delete(key)
+	}
+
+	var zero V
+	return zero, false
+}
+
+func (l *expiringLRU[K, V]) Set(key K, value V) {
+	e := entry[V]{value: value}
+	if l.ttl > 0 {
+		e.expires = l.clock.Now().Add(l.ttl)
+	}
+
+	l.lru.Set(key, e)
+}
+
+type pair[A, B comparable] struct {
+	a A
+	b B
+}
+
+// Func2 is like Func for functions of two arguments.
+func Func2[A, B comparable, V any](fn func(A, B) (V, error), opts ...Option) func(A, B) (V, error) {
+	memoized := Func(func(key pair[A, B]) (V, error) {
+		return fn(key.a, key.b)
+	}, opts...)
+
+	return func(a A, b B) (V, error) {
+		return memoized(pair[A, B]{a, b})
+	}
+}
@@ -0,0 +1,167 @@
+package memo
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+func TestFunc(t *testing.T) {
+	calls := make(map[string]int)
+	square := Func(func(key string) (int, error) {
+		calls[key]++
+		if key == "" {
+			return 0, errors.New("empty key")
+		}
+
+		return len(key) * len(key), nil
+	})
+
+	tests := []struct {
+		name      string
+		key       string
+		want      int
+		wantErr   bool
+		wantCalls int
+	}{
+		{name: "success - computed", key: "abc", want: 9, wantCalls: 1},
+		{name: "success - memoized", key: "abc", want: 9, wantCalls: 1},
+		{name: "fail - error", key: "", wantErr: true, wantCalls: 1},
+		{name: "fail - errors are not memoized", key: "", wantErr: true, wantCalls: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := square(tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Func() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("Func() = %v, want %v", got, tt.want)
+			}
+
+			if calls[tt.key] != tt.wantCalls {
+				t.Errorf("calls = %v, want %v", calls[tt.key], tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestFuncOptions(t *testing.T) {
+	clock := timex.NewFakeClock(time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC))
+	var calls int64
+	double := Func(func(n int) (int, error) {
+		atomic.AddInt64(&calls, 1)
+		return 2 * n, nil
+	}, TTL(time.Minute), MaxSize(2), WithClock(clock))
+
+	double(1)
+	double(1)
+	if got := atomic.LoadInt64(&calls); got != 1 {
+		t.Errorf("calls = %v, want %v", got, 1)
+	}
+
+	clock.Advance(time.Minute)
+	double(1)
+	if got := atomic.LoadInt64(&calls); got != 2 {
+		t.Errorf("calls = %v after the TTL, want %v", got, 2)
+	}
+
+	// 1 is evicted by the max size
+	double(2)
+	double(3)
+	double(1)
+	if got := atomic.LoadInt64(&calls); got != 5 {
+		t.Errorf("calls = %v after eviction, want %v", got, 5)
+	}
+}
+
+func TestFuncDropsExpired(t *testing.T) {
+	clock := timex.NewFakeClock(time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC))
+	results := newResults[int, int](config{ttl: time.Minute, clock: clock}).(*sweepingTTL[int, int])
+
+	for key := 0; key < 100; key++ {
+		results.Set(key, key)
+	}
+
+	clock.Advance(time.Minute)
+	results.Set(-1, -1)
+
+	if got := results.Len(); got != 1 {
+		t.Errorf("Len() = %v after the TTL, want %v", got, 1)
+	}
+
+	if _, ok := results.Get(-1); !ok {
+		t.Errorf("Get(-1) = false, want the fresh result")
+	}
+}
+
+func TestFuncConcurrent(t *testing.T) {
+	var calls int64
+	release := make(chan struct{})
+	slow := Func(func(n int) (int, error) {
+		atomic.AddInt64(&calls, 1)
+		<-release
+
+		return n, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got, err := slow(7); err != nil || got != 7 {
+				t.Errorf("Func() = %v, %v, want 7, nil", got, err)
+			}
+		}()
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt64(&calls); got != 1 {
+		t.Errorf("calls = %v, want %v", got, 1)
+	}
+}
+
+func TestFunc2(t *testing.T) {
+	var calls int64
+	price := Func2(func(product string, quantity int) (float64, error) {
+		atomic.AddInt64(&calls, 1)
+		return float64(quantity) * 1.5, nil
+	})
+
+	tests := []struct {
+		name      string
+		product   string
+		quantity  int
+		want      float64
+		wantCalls int64
+	}{
+		{name: "success - computed", product: "apple", quantity: 2, want: 3, wantCalls: 1},
+		{name: "success - memoized", product: "apple", quantity: 2, want: 3, wantCalls: 1},
+		{name: "success - other arguments", product: "apple", quantity: 4, want: 6, wantCalls: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := price(tt.product, tt.quantity)
+			if err != nil {
+				t.Fatalf("Func2() error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("Func2() = %v, want %v", got, tt.want)
+			}
+
+			if got := atomic.LoadInt64(&calls); got != tt.wantCalls {
+				t.Errorf("calls = %v, want %v", got, tt.wantCalls)
+			}
+		})
+	}
+}
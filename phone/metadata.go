@@ -0,0 +1,327 @@
+package phone
+
+import "regexp"
+
+// format groups the digits of a national significant number for national display.
+type format struct {
+	pattern  *regexp.Regexp
+	template string // expanded by regexp.ReplaceAllString, including the trunk prefix where used
+}
+
+// region holds the numbering plan of a region. Patterns match national significant numbers, the
+// number without country code nor trunk prefix, and are checked in the order toll free, mobile,
+// fixed line, fixed line or mobile.
+type region struct {
+	code          string
+	countryCode   int
+	trunkPrefix   string
+	leading       *regexp.Regexp // Selects the region among others sharing its country code
+	tollFree      *regexp.Regexp
+	mobile        *regexp.Regexp
+	fixedLine     *regexp.Regexp
+	fixedOrMobile *regexp.Regexp // For plans where mobile and fixed lines cannot be told apart
+	formats       []format
+}
+
+func re(pattern string) *regexp.Regexp {
+	return regexp.MustCompile("^(?:" + pattern + ")$")
+}
+
+func leading(pattern string) *regexp.Regexp {
+	return regexp.MustCompile("^(?:" + pattern + ")")
+}
+
+func formats(pairs ...string) []format {
+	f := make([]format, 0, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		f = append(f, format{pattern: re(pairs[i]), template: pairs[i+1]})
+	}
+
+	return f
+}
+
+// nanp returns a region of the North American Numbering Plan.
+func nanp(code, areaCodes string) *region {
+	r := &region{
+		code:          code,
+		countryCode:   1,
+		trunkPrefix:   "1",
+		tollFree:      re(`8(?:00|33|44|55|66|77|88)[2-9]\d{6}`),
+		fixedOrMobile: re(`[2-9]\d{2}[2-9]\d{6}`),
+		formats:       formats(`(\d{3})(\d{3})(\d{4})`, "($1) $2-$3"),
+	}
+	if areaCodes != "" {
+		r.leading = leading(areaCodes)
+	}
+
+	return r
+}
+
+// regions lists the supported regions. The first region of each country code is its main region,
+// used when no other region sharing the code has a matching leading pattern.
+var regions = []*region{
+	// North America and the Caribbean.
+	nanp("US", ""),
+	nanp("CA", `204|226|236|249|250|263|289|306|343|354|365|367|368|382|387|403|416|418|428|431|437|438|450|`+
+		`460|468|474|506|514|519|548|579|581|584|587|604|613|639|647|672|683|705|709|742|753|778|780|782|807|`+
+		`819|825|867|873|879|902|905`),
+	nanp("PR", `787|939`),
+	nanp("DO", `809|829|849`),
+	nanp("JM", `658|876`),
+	nanp("TT", `868`),
+	nanp("BS", `242`),
+	nanp("BB", `246`),
+	{
+		code: "MX", countryCode: 52,
+		tollFree:      re(`800\d{7}`),
+		fixedOrMobile: re(`[1-9]\d{9}`),
+		formats:       formats(`(33|55|81)(\d{4})(\d{4})`, "$1 $2 $3", `(\d{3})(\d{3})(\d{4})`, "$1 $2 $3"),
+	},
+	// South America.
+	{
+		code: "BR", countryCode: 55, trunkPrefix: "0",
+		tollFree:  re(`800\d{6,7}`),
+		mobile:    re(`[1-9]{2}9\d{8}`),
+		fixedLine: re(`[1-9]{2}[2-5]\d{7}`),
+		formats: formats(`(800)(\d{3})(\d{3,4})`, "0$1 $2 $3", `(\d{2})(\d{5})(\d{4})`, "($1) $2-$3",
+			`(\d{2})(\d{4})(\d{4})`, "($1) $2-$3"),
+	},
+	{
+		code: "AR", countryCode: 54, trunkPrefix: "0",
+		tollFree:  re(`800\d{7}`),
+		mobile:    re(`9[1-9]\d{9}`),
+		fixedLine: re(`[1-9]\d{9}`),
+		formats: formats(`9(11)(\d{4})(\d{4})`, "0$1 15-$2-$3", `9(\d{3})(\d{3})(\d{4})`, "0$1 15-$2-$3",
+			`(11)(\d{4})(\d{4})`, "0$1 $2-$3", `(\d{3})(\d{3})(\d{4})`, "0$1 $2-$3"),
+	},
+	{
+		code: "CL", countryCode: 56,
+		mobile:    re(`9\d{8}`),
+		fixedLine: re(`[2-8]\d{8}`),
+		formats:   formats(`([29])(\d{4})(\d{4})`, "$1 $2 $3", `(\d{2})(\d{3})(\d{4})`, "$1 $2 $3"),
+	},
+	{
+		code: "CO", countryCode: 57,
+		tollFree:  re(`1800\d{7}`),
+		mobile:    re(`3\d{9}`),
+		fixedLine: re(`60\d{8}`),
+		formats:   formats(`(\d{3})(\d{7})`, "$1 $2"),
+	},
+	{
+		code: "PE", countryCode: 51, trunkPrefix: "0",
+		mobile:    re(`9\d{8}`),
+		fixedLine: re(`1\d{7}|[4-8]\d{7}`),
+		formats: formats(`(9\d{2})(\d{3})(\d{3})`, "$1 $2 $3", `(1)(\d{7})`, "(0$1) $2",
+			`(\d{2})(\d{6})`, "(0$1) $2"),
+	},
+	{
+		code: "UY", countryCode: 598, trunkPrefix: "0",
+		mobile:    re(`9\d{7}`),
+		fixedLine: re(`[24]\d{7}`),
+		formats:   formats(`(9\d)(\d{3})(\d{3})`, "0$1 $2 $3", `(\d{4})(\d{4})`, "$1 $2"),
+	},
+	{
+		code: "PY", countryCode: 595, trunkPrefix: "0",
+		mobile:    re(`9[6-9]\d{7}`),
+		fixedLine: re(`21\d{6,7}|[2-8]\d{6,8}`),
+		formats:   formats(`(9\d{2})(\d{6})`, "0$1 $2", `(21)(\d{6,7})`, "(0$1) $2"),
+	},
+	{
+		code: "BO", countryCode: 591, trunkPrefix: "0",
+		mobile:    re(`[67]\d{7}`),
+		fixedLine: re(`[2-4]\d{7}`),
+		formats:   formats(`([2-4])(\d{7})`, "$1 $2"),
+	},
+	{
+		code: "EC", countryCode: 593, trunkPrefix: "0",
+		mobile:    re(`9\d{8}`),
+		fixedLine: re(`[2-7]\d{7}`),
+		formats:   formats(`(9\d)(\d{3})(\d{4})`, "0$1 $2 $3", `(\d)(\d{3})(\d{4})`, "(0$1) $2-$3"),
+	},
+	{
+		code: "VE", countryCode: 58, trunkPrefix: "0",
+		tollFree:  re(`800\d{7}`),
+		mobile:    re(`4(?:1[24-6]|2[46])\d{7}`),
+		fixedLine: re(`[2-9]\d{9}`),
+		formats:   formats(`(\d{3})(\d{7})`, "0$1-$2"),
+	},
+	// Europe.
+	{
+		code: "GB", countryCode: 44, trunkPrefix: "0",
+		tollFree:  re(`80(?:0\d{6,7}|8\d{7})`),
+		mobile:    re(`7[1-57-9]\d{8}`),
+		fixedLine: re(`[123]\d{8,9}`),
+		formats: formats(`(7\d{3})(\d{6})`, "0$1 $2", `(2\d)(\d{4})(\d{4})`, "0$1 $2 $3",
+			`(80\d)(\d{3})(\d{3,4})`, "0$1 $2 $3", `(1\d{3})(\d{5,6})`, "0$1 $2", `(3\d{2})(\d{3})(\d{4})`, "0$1 $2 $3"),
+	},
+	{
+		code: "IE", countryCode: 353, trunkPrefix: "0",
+		tollFree:  re(`1800\d{6}`),
+		mobile:    re(`8[35-9]\d{7}`),
+		fixedLine: re(`1\d{7}|[2-9]\d{6,8}`),
+		formats:   formats(`(8\d)(\d{3})(\d{4})`, "0$1 $2 $3", `(1)(\d{3})(\d{4})`, "0$1 $2 $3"),
+	},
+	{
+		code: "FR", countryCode: 33, trunkPrefix: "0",
+		tollFree:  re(`80\d{7}`),
+		mobile:    re(`[67]\d{8}`),
+		fixedLine: re(`[1-59]\d{8}`),
+		formats:   formats(`(\d)(\d{2})(\d{2})(\d{2})(\d{2})`, "0$1 $2 $3 $4 $5"),
+	},
+	{
+		code: "DE", countryCode: 49, trunkPrefix: "0",
+		tollFree:  re(`800\d{7,12}`),
+		mobile:    re(`1(?:5\d{9}|[67]\d{8,9})`),
+		fixedLine: re(`[2-9]\d{5,10}`),
+		formats:   formats(`(1[5-7]\d)(\d{7,8})`, "0$1 $2", `(30|40|69|89)(\d{4,9})`, "0$1 $2"),
+	},
+	{
+		code: "ES", countryCode: 34,
+		tollFree:  re(`900\d{6}`),
+		mobile:    re(`(?:6\d|7[1-9])\d{7}`),
+		fixedLine: re(`[89]\d{8}`),
+		formats:   formats(`(\d{3})(\d{3})(\d{3})`, "$1 $2 $3"),
+	},
+	{
+		code: "PT", countryCode: 351,
+		tollFree:  re(`800\d{6}`),
+		mobile:    re(`9[1236]\d{7}`),
+		fixedLine: re(`2\d{8}`),
+		formats:   formats(`(\d{3})(\d{3})(\d{3})`, "$1 $2 $3"),
+	},
+	{
+		code: "IT", countryCode: 39,
+		tollFree:  re(`80(?:0\d{6}|3\d{3})`),
+		mobile:    re(`3\d{8,9}`),
+		fixedLine: re(`0\d{5,10}`),
+		formats: formats(`(3\d{2})(\d{6,7})`, "$1 $2", `(0[26])(\d{4})(\d{4})`, "$1 $2 $3",
+			`(0\d{2,3})(\d{4,7})`, "$1 $2"),
+	},
+	{
+		code: "NL", countryCode: 31, trunkPrefix: "0",
+		tollFree:  re(`800\d{4,7}`),
+		mobile:    re(`6[1-58]\d{7}`),
+		fixedLine: re(`[1-57-9]\d{8}`),
+		formats:   formats(`(6)(\d{8})`, "0$1 $2", `(\d{2})(\d{3})(\d{4})`, "0$1 $2 $3"),
+	},
+	{
+		code: "BE", countryCode: 32, trunkPrefix: "0",
+		tollFree:  re(`800\d{5}`),
+		mobile:    re(`4[5-9]\d{7}`),
+		fixedLine: re(`[1-9]\d{7}`),
+		formats: formats(`(4\d{2})(\d{2})(\d{2})(\d{2})`, "0$1 $2 $3 $4", `([23])(\d{3})(\d{2})(\d{2})`, "0$1 $2 $3 $4",
+			`(\d{2})(\d{2})(\d{2})(\d{2})`, "0$1 $2 $3 $4"),
+	},
+	{
+		code: "LU", countryCode: 352,
+		mobile:    re(`6[269][18]\d{6}`),
+		fixedLine: re(`[2-9]\d{5,8}`),
+		formats:   formats(`(6\d{2})(\d{3})(\d{3})`, "$1 $2 $3"),
+	},
+	{
+		code: "CH", countryCode: 41, trunkPrefix: "0",
+		tollFree:  re(`800\d{6}`),
+		mobile:    re(`7[5-9]\d{7}`),
+		fixedLine: re(`[2-6]\d{8}|[89]1\d{7}`),
+		formats:   formats(`(\d{2})(\d{3})(\d{2})(\d{2})`, "0$1 $2 $3 $4"),
+	},
+	{
+		code: "AT", countryCode: 43, trunkPrefix: "0",
+		tollFree:  re(`800\d{6,10}`),
+		mobile:    re(`6(?:5[0-3579]|6[013-9]|[7-9]\d)\d{4,10}`),
+		fixedLine: re(`[1-9]\d{3,12}`),
+		formats:   formats(`(1)(\d{3,12})`, "0$1 $2", `(6\d{2})(\d{4,10})`, "0$1 $2"),
+	},
+	{
+		code: "SE", countryCode: 46, trunkPrefix: "0",
+		tollFree:  re(`20\d{4,7}`),
+		mobile:    re(`7[02369]\d{7}`),
+		fixedLine: re(`[1-9]\d{6,9}`),
+		formats:   formats(`(7\d)(\d{3})(\d{2})(\d{2})`, "0$1-$2 $3 $4", `(8)(\d{3})(\d{2})(\d{2})`, "0$1-$2 $3 $4"),
+	},
+	{
+		code: "NO", countryCode: 47,
+		mobile:    re(`[49]\d{7}`),
+		fixedLine: re(`[2-7]\d{7}`),
+		formats:   formats(`(\d{3})(\d{2})(\d{3})`, "$1 $2 $3"),
+	},
+	{
+		code: "DK", countryCode: 45,
+		fixedOrMobile: re(`[2-9]\d{7}`),
+		formats:       formats(`(\d{2})(\d{2})(\d{2})(\d{2})`, "$1 $2 $3 $4"),
+	},
+	{
+		code: "FI", countryCode: 358, trunkPrefix: "0",
+		tollFree:  re(`800\d{4,6}`),
+		mobile:    re(`(?:4\d|50)\d{4,8}`),
+		fixedLine: re(`[1-9]\d{4,11}`),
+		formats:   formats(`(\d{2})(\d{3,9})`, "0$1 $2"),
+	},
+	{
+		code: "PL", countryCode: 48,
+		tollFree:  re(`800\d{6}`),
+		mobile:    re(`(?:45|5[0137]|6[069]|7[2389]|88)\d{7}`),
+		fixedLine: re(`[1-9]\d{8}`),
+		formats:   formats(`(\d{3})(\d{3})(\d{3})`, "$1 $2 $3"),
+	},
+	{
+		code: "CZ", countryCode: 420,
+		tollFree:  re(`800\d{6}`),
+		mobile:    re(`(?:60[1-8]|7[2-9]\d)\d{6}`),
+		fixedLine: re(`[2-5]\d{8}`),
+		formats:   formats(`(\d{3})(\d{3})(\d{3})`, "$1 $2 $3"),
+	},
+	{
+		code: "GR", countryCode: 30,
+		tollFree:  re(`800\d{7}`),
+		mobile:    re(`69\d{8}`),
+		fixedLine: re(`2\d{9}`),
+		formats:   formats(`(\d{3})(\d{3})(\d{4})`, "$1 $2 $3"),
+	},
+	{
+		code: "RO", countryCode: 40, trunkPrefix: "0",
+		tollFree:  re(`800\d{6}`),
+		mobile:    re(`7[0-8]\d{7}`),
+		fixedLine: re(`[23]\d{8}`),
+		formats:   formats(`(21)(\d{3})(\d{4})`, "0$1 $2 $3", `(\d{3})(\d{3})(\d{3})`, "0$1 $2 $3"),
+	},
+	{
+		code: "HU", countryCode: 36, trunkPrefix: "06",
+		tollFree:  re(`80\d{6}`),
+		mobile:    re(`(?:20|30|31|50|70)\d{7}`),
+		fixedLine: re(`1\d{7}|[2-9]\d{7}`),
+		formats:   formats(`(1)(\d{3})(\d{4})`, "06 $1 $2 $3", `(\d{2})(\d{3})(\d{3,4})`, "06 $1 $2 $3"),
+	},
+	{
+		code: "UA", countryCode: 380, trunkPrefix: "0",
+		tollFree:  re(`800\d{6}`),
+		mobile:    re(`(?:39|50|6[3678]|73|9[1-9])\d{7}`),
+		fixedLine: re(`[3-6]\d{8}`),
+		formats:   formats(`(\d{2})(\d{3})(\d{4})`, "0$1 $2 $3"),
+	},
+	{
+		code: "RU", countryCode: 7, trunkPrefix: "8",
+		tollFree:  re(`800\d{7}`),
+		mobile:    re(`9\d{9}`),
+		fixedLine: re(`[348]\d{9}`),
+		formats:   formats(`(\d{3})(\d{3})(\d{2})(\d{2})`, "8 ($1) $2-$3-$4"),
+	},
+	{
+		code: "KZ", countryCode: 7, trunkPrefix: "8", leading: leading(`[67]`),
+		mobile:    re(`7\d{9}`),
+		fixedLine: re(`[67]\d{9}`),
+		formats:   formats(`(\d{3})(\d{3})(\d{2})(\d{2})`, "8 ($1) $2 $3 $4"),
+	},
+}
+
+var (
+	regionsByCode        = make(map[string]*region)
+	regionsByCountryCode = make(map[int][]*region)
+)
+
+func init() {
+	for _, r := range regions {
+		regionsByCode[r.code] = r
+		regionsByCountryCode[r.countryCode] = append(regionsByCountryCode[r.countryCode], r)
+	}
+}
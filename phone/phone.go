@@ -0,0 +1,245 @@
+/*
+Package phone defines parsing, validation and formatting of phone numbers for the Americas and Europe.
+*/
+package phone
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Type is the kind of line of a phone number.
+type Type int
+
+const (
+	TypeUnknown           Type = iota // The number matches no pattern of its region
+	TypeFixedLine                     // A landline number
+	TypeMobile                        // A mobile number
+	TypeFixedLineOrMobile             // A number of a plan not distinguishing fixed and mobile lines, such as NANP
+	TypeTollFree                      // A toll free number
+)
+
+// String returns the name of the type.
+func (t Type) String() string {
+	switch t {
+	case TypeFixedLine:
+		return "fixed line"
+	case TypeMobile:
+		return "mobile"
+	case TypeFixedLineOrMobile:
+		return "fixed line or mobile"
+	case TypeTollFree:
+		return "toll free"
+	default:
+		return "unknown"
+	}
+}
+
+var (
+	// ErrInvalidNumber is returned for numbers that are malformed or not valid in their region.
+	ErrInvalidNumber = errors.New("invalid phone number")
+	// ErrUnknownRegion is returned for unsupported regions and country codes.
+	ErrUnknownRegion = errors.New("unknown region")
+)
+
+// Number is a parsed phone number.
+type Number struct {
+	CountryCode    int    // Country calling code, such as 55
+	NationalNumber string // National significant number, digits without trunk prefix, such as "11987654321"
+	Region         string // ISO 3166-1 alpha-2 region code, such as "BR"
+	Type           Type
+}
+
+// Parse parses a phone number written in international format ("+55 11 98765-4321", "0055 11 98765-4321")
+// or in the national format of defaultRegion, an ISO 3166-1 alpha-2 code such as "BR" that may be empty
+// for international numbers. Spaces, dots, dashes, slashes and parentheses are ignored. The number must be
+// valid for its region, which is inferred from the country code and, when shared, the leading digits.
+func Parse(number, defaultRegion string) (Number, error) {
+	digits, international, err := normalize(number)
+	if err != nil {
+		return Number{}, err
+	}
+
+	def, ok := regionsByCode[strings.ToUpper(defaultRegion)]
+	if !ok && (defaultRegion != "" || !international) {
+		return Number{}, fmt.Errorf("%w %q", ErrUnknownRegion, defaultRegion)
+	}
+
+	// Numbers may also be dialed internationally with the exit code of the default region.
+	if !international && def != nil {
+		exitCode := "00"
+		if def.countryCode == 1 {
+			exitCode = "011"
+		}
+
+		if strings.HasPrefix(digits, exitCode) {
+			digits, international = digits[len(exitCode):], true
+		}
+	}
+
+	if international {
+		return parseInternational(number, digits)
+	}
+
+	if n, ok := def.parseNational(digits); ok {
+		return n, nil
+	}
+
+	// The number may include the country code without the plus sign.
+	if cc := strconv.Itoa(def.countryCode); strings.HasPrefix(digits, cc) {
+		if n, err := parseInternational(number, digits); err == nil {
+			return n, nil
+		}
+	}
+
+	return Number{}, fmt.Errorf("%w %q for region %s", ErrInvalidNumber, number, def.code)
+}
+
+// IsValid reports whether number parses as a valid phone number with Parse.
+func IsValid(number, defaultRegion string) bool {
+	_, err := Parse(number, defaultRegion)
+
+	return err == nil
+}
+
+// Regions returns the supported region codes in alphabetical order.
+func Regions() []string {
+	codes := make([]string, 0, len(regions))
+	for _, r := range regions {
+		codes = append(codes, r.code)
+	}
+
+	sort.Strings(codes)
+
+	return codes
+}
+
+// FormatE164 returns the number in E.164 format, such as "+5511987654321".
+func (n Number) FormatE164() string {
+	return "+" + strconv.Itoa(n.CountryCode) + n.NationalNumber
+}
+
+// FormatNational returns the number as dialed within its region, such as "(11) 98765-4321".
+func (n Number) FormatNational() string {
+	r, ok := regionsByCode[n.Region]
+	if !ok {
+		return n.NationalNumber
+	}
+
+	for _, f := range r.formats {
+		if f.pattern.MatchString(n.NationalNumber) {
+			return f.pattern.ReplaceAllString(n.NationalNumber, f.template)
+		}
+	}
+
+	return r.trunkPrefix + n.NationalNumber
+}
+
+// String returns the number in E.164 format.
+func (n Number) String() string {
+	return n.FormatE164()
+}
+
+// normalize returns the digits of number and whether it starts with a plus sign.
+func normalize(number string) (string, bool, error) {
+	s := strings.TrimSpace(number)
+	international := strings.HasPrefix(s, "+")
+	s = strings.TrimPrefix(s, "+")
+
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case strings.ContainsRune(" .-/()", r):
+		default:
+			return "", false, fmt.Errorf("%w %q", ErrInvalidNumber, number)
+		}
+	}
+
+	if b.Len() == 0 {
+		return "", false, fmt.Errorf("%w %q", ErrInvalidNumber, number)
+	}
+
+	return b.String(), international, nil
+}
+
+func parseInternational(number, digits string) (Number, error) {
+	for i := 1; i <= 3 && i < len(digits); i++ {
+		cc, _ := strconv.Atoi(digits[:i])
+		if _, ok := regionsByCountryCode[cc]; !ok {
+			continue
+		}
+
+		if n, ok := parseForCountryCode(cc, digits[i:]); ok {
+			return n, nil
+		}
+
+		return Number{}, fmt.Errorf("%w %q", ErrInvalidNumber, number)
+	}
+
+	return Number{}, fmt.Errorf("%w: no country code in %q", ErrUnknownRegion, number)
+}
+
+// parseForCountryCode parses a national significant number in the region of the country code it belongs to:
+// a region selected by its leading digits, or else the main region of the code.
+func parseForCountryCode(cc int, nsn string) (Number, bool) {
+	candidates := regionsByCountryCode[cc]
+
+	for _, r := range candidates[1:] {
+		if r.leading.MatchString(nsn) {
+			if n, ok := r.parseSignificant(nsn); ok {
+				return n, true
+			}
+		}
+	}
+
+	return candidates[0].parseSignificant(nsn)
+}
+
+// parseNational parses digits dialed within the region r, with or without trunk prefix. The number
+// may belong to another region sharing the country code of r, such as Canada for the United States.
+func (r *region) parseNational(digits string) (Number, bool) {
+	if r.trunkPrefix != "" && strings.HasPrefix(digits, r.trunkPrefix) {
+		if n, ok := parseForCountryCode(r.countryCode, digits[len(r.trunkPrefix):]); ok {
+			return n, true
+		}
+	}
+
+	return parseForCountryCode(r.countryCode, digits)
+}
+
+// parseSignificant parses a national significant number, accepting a redundant trunk prefix as in
+// "+44 (0) 20 7946 0958".
+func (r *region) parseSignificant(nsn string) (Number, bool) {
+	if t := r.typeOf(nsn); t != TypeUnknown {
+		return Number{CountryCode: r.countryCode, NationalNumber: nsn, Region: r.code, Type: t}, true
+	}
+
+	if r.trunkPrefix != "" && r.countryCode != 1 && strings.HasPrefix(nsn, r.trunkPrefix) {
+		trimmed := nsn[len(r.trunkPrefix):]
+		if t := r.typeOf(trimmed); t != TypeUnknown {
+			return Number{CountryCode: r.countryCode, NationalNumber: trimmed, Region: r.code, Type: t}, true
+		}
+	}
+
+	return Number{}, false
+}
+
+func (r *region) typeOf(nsn string) Type {
+	switch {
+	case r.tollFree != nil && r.tollFree.MatchString(nsn):
+		return TypeTollFree
+	case r.mobile != nil && r.mobile.MatchString(nsn):
+		return TypeMobile
+	case r.fixedLine != nil && r.fixedLine.MatchString(nsn):
+		return TypeFixedLine
+	case r.fixedOrMobile != nil && r.fixedOrMobile.MatchString(nsn):
+		return TypeFixedLineOrMobile
+	default:
+		return TypeUnknown
+	}
+}
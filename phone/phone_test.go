@@ -0,0 +1,165 @@
+package phone
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		number        string
+		defaultRegion string
+		want          Number
+		wantErr       error
+	}{
+		{name: "success - br mobile international", number: "+55 11 98765-4321",
+			want: Number{CountryCode: 55, NationalNumber: "11987654321", Region: "BR", Type: TypeMobile}},
+		{name: "success - br mobile national", number: "(11) 98765-4321", defaultRegion: "BR",
+			want: Number{CountryCode: 55, NationalNumber: "11987654321", Region: "BR", Type: TypeMobile}},
+		{name: "success - br fixed line with trunk prefix", number: "0 (21) 3456-7890", defaultRegion: "br",
+			want: Number{CountryCode: 55, NationalNumber: "2134567890", Region: "BR", Type: TypeFixedLine}},
+		{name: "success - br toll free", number: "0800 123 4567", defaultRegion: "BR",
+			want: Number{CountryCode: 55, NationalNumber: "8001234567", Region: "BR", Type: TypeTollFree}},
+		{name: "success - br country code without plus", number: "5511987654321", defaultRegion: "BR",
+			want: Number{CountryCode: 55, NationalNumber: "11987654321", Region: "BR", Type: TypeMobile}},
+		{name: "success - br exit code", number: "0055 11 98765 4321", defaultRegion: "PT",
+			want: Number{CountryCode: 55, NationalNumber: "11987654321", Region: "BR", Type: TypeMobile}},
+		{name: "success - us national", number: "(212) 555-0123", defaultRegion: "US",
+			want: Number{CountryCode: 1, NationalNumber: "2125550123", Region: "US", Type: TypeFixedLineOrMobile}},
+		{name: "success - us with trunk prefix", number: "1-212-555-0123", defaultRegion: "US",
+			want: Number{CountryCode: 1, NationalNumber: "2125550123", Region: "US", Type: TypeFixedLineOrMobile}},
+		{name: "success - us exit code", number: "011 44 20 7946 0958", defaultRegion: "US",
+			want: Number{CountryCode: 44, NationalNumber: "2079460958", Region: "GB", Type: TypeFixedLine}},
+		{name: "success - us toll free", number: "+1 800 555 0199",
+			want: Number{CountryCode: 1, NationalNumber: "8005550199", Region: "US", Type: TypeTollFree}},
+		{name: "success - canada by area code", number: "+1 416 555 0123",
+			want: Number{CountryCode: 1, NationalNumber: "4165550123", Region: "CA", Type: TypeFixedLineOrMobile}},
+		{name: "success - canada dialed from us", number: "416.555.0123", defaultRegion: "US",
+			want: Number{CountryCode: 1, NationalNumber: "4165550123", Region: "CA", Type: TypeFixedLineOrMobile}},
+		{name: "success - ar mobile", number: "+54 9 11 2345-6789",
+			want: Number{CountryCode: 54, NationalNumber: "91123456789", Region: "AR", Type: TypeMobile}},
+		{name: "success - gb mobile national", number: "07911 123456", defaultRegion: "GB",
+			want: Number{CountryCode: 44, NationalNumber: "7911123456", Region: "GB", Type: TypeMobile}},
+		{name: "success - gb redundant trunk prefix", number: "+44 (0)20 7946 0958",
+			want: Number{CountryCode: 44, NationalNumber: "2079460958", Region: "GB", Type: TypeFixedLine}},
+		{name: "success - de mobile", number: "+49 151 23456789",
+			want: Number{CountryCode: 49, NationalNumber: "15123456789", Region: "DE", Type: TypeMobile}},
+		{name: "success - fr mobile", number: "06 12 34 56 78", defaultRegion: "FR",
+			want: Number{CountryCode: 33, NationalNumber: "612345678", Region: "FR", Type: TypeMobile}},
+		{name: "success - it fixed keeps leading zero", number: "+39 06 1234 5678",
+			want: Number{CountryCode: 39, NationalNumber: "0612345678", Region: "IT", Type: TypeFixedLine}},
+		{name: "success - es mobile", number: "612 345 678", defaultRegion: "ES",
+			want: Number{CountryCode: 34, NationalNumber: "612345678", Region: "ES", Type: TypeMobile}},
+		{name: "success - hu two digit trunk prefix", number: "06 20 123 4567", defaultRegion: "HU",
+			want: Number{CountryCode: 36, NationalNumber: "201234567", Region: "HU", Type: TypeMobile}},
+		{name: "success - ru mobile", number: "8 (912) 345-67-89", defaultRegion: "RU",
+			want: Number{CountryCode: 7, NationalNumber: "9123456789", Region: "RU", Type: TypeMobile}},
+		{name: "success - kz by leading digits", number: "+7 701 234 5678",
+			want: Number{CountryCode: 7, NationalNumber: "7012345678", Region: "KZ", Type: TypeMobile}},
+		{name: "success - dk fixed or mobile", number: "+45 32 12 34 56",
+			want: Number{CountryCode: 45, NationalNumber: "32123456", Region: "DK", Type: TypeFixedLineOrMobile}},
+		{name: "fail - too short", number: "+55 11 9876", wantErr: ErrInvalidNumber},
+		{name: "fail - invalid for region", number: "(11) 18765-4321", defaultRegion: "BR", wantErr: ErrInvalidNumber},
+		{name: "fail - letters", number: "+1 800 FLOWERS", wantErr: ErrInvalidNumber},
+		{name: "fail - empty", number: " ", defaultRegion: "BR", wantErr: ErrInvalidNumber},
+		{name: "fail - national without region", number: "11987654321", wantErr: ErrUnknownRegion},
+		{name: "fail - unsupported region", number: "03-1234-5678", defaultRegion: "JP", wantErr: ErrUnknownRegion},
+		{name: "fail - unsupported country code", number: "+81 3 1234 5678", wantErr: ErrUnknownRegion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.number, tt.defaultRegion)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name         string
+		number       string
+		wantE164     string
+		wantNational string
+	}{
+		{name: "success - br mobile", number: "+55 11 98765 4321", wantE164: "+5511987654321", wantNational: "(11) 98765-4321"},
+		{name: "success - br fixed line", number: "+55 21 3456 7890", wantE164: "+552134567890", wantNational: "(21) 3456-7890"},
+		{name: "success - br toll free", number: "+55 800 123 4567", wantE164: "+558001234567", wantNational: "0800 123 4567"},
+		{name: "success - us", number: "+1 212 555 0123", wantE164: "+12125550123", wantNational: "(212) 555-0123"},
+		{name: "success - ar mobile", number: "+54 9 11 2345 6789", wantE164: "+5491123456789", wantNational: "011 15-2345-6789"},
+		{name: "success - gb london", number: "+44 20 7946 0958", wantE164: "+442079460958", wantNational: "020 7946 0958"},
+		{name: "success - gb mobile", number: "+44 7911 123456", wantE164: "+447911123456", wantNational: "07911 123456"},
+		{name: "success - fr", number: "+33 6 12 34 56 78", wantE164: "+33612345678", wantNational: "06 12 34 56 78"},
+		{name: "success - de berlin", number: "+49 30 1234567", wantE164: "+49301234567", wantNational: "030 1234567"},
+		{name: "success - de without format", number: "+49 621 1234567", wantE164: "+496211234567", wantNational: "06211234567"},
+		{name: "success - it rome", number: "+39 06 1234 5678", wantE164: "+390612345678", wantNational: "06 1234 5678"},
+		{name: "success - ru", number: "+7 912 345 67 89", wantE164: "+79123456789", wantNational: "8 (912) 345-67-89"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := Parse(tt.number, "")
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := n.FormatE164(); got != tt.wantE164 {
+				t.Errorf("FormatE164() = %v, want %v", got, tt.wantE164)
+			}
+			if got := n.String(); got != tt.wantE164 {
+				t.Errorf("String() = %v, want %v", got, tt.wantE164)
+			}
+			if got := n.FormatNational(); got != tt.wantNational {
+				t.Errorf("FormatNational() = %v, want %v", got, tt.wantNational)
+			}
+		})
+	}
+
+	if got := (Number{NationalNumber: "123"}).FormatNational(); got != "123" {
+		t.Errorf("FormatNational() = %v, want 123 for an unknown region", got)
+	}
+}
+
+func TestIsValid(t *testing.T) {
+	if !IsValid("+55 11 98765-4321", "") {
+		t.Errorf("IsValid() = false, want true")
+	}
+
+	if IsValid("+55 11 9876", "") {
+		t.Errorf("IsValid() = true, want false")
+	}
+}
+
+func TestRegions(t *testing.T) {
+	got := Regions()
+	if len(got) != len(regions) {
+		t.Fatalf("Regions() returned %d regions, want %d", len(got), len(regions))
+	}
+
+	for i := 1; i < len(got); i++ {
+		if got[i-1] >= got[i] {
+			t.Errorf("Regions() = %v, want sorted unique codes", got)
+		}
+	}
+}
+
+func TestTypeString(t *testing.T) {
+	tests := []struct {
+		typ  Type
+		want string
+	}{
+		{typ: TypeFixedLine, want: "fixed line"},
+		{typ: TypeMobile, want: "mobile"},
+		{typ: TypeFixedLineOrMobile, want: "fixed line or mobile"},
+		{typ: TypeTollFree, want: "toll free"},
+		{typ: TypeUnknown, want: "unknown"},
+	}
+	for _, tt := range tests {
+		if got := tt.typ.String(); got != tt.want {
+			t.Errorf("String() = %v, want %v", got, tt.want)
+		}
+	}
+}
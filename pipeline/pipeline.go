@@ -0,0 +1,221 @@
+/*
+Package pipeline defines channel primitives to build concurrent pipelines. Every output channel is closed
+once its inputs are closed or the context is done.
+*/
+package pipeline
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+// From emits values on a channel.
+func From[T any](ctx context.Context, values ...T) <-chan T {
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+
+		for _, value := range values {
+			if !send(ctx, out, value) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Collect receives the values of in until it is closed or ctx is done.
+func Collect[T any](ctx context.Context, in <-chan T) []T {
+	values := make([]T, 0)
+	for {
+		value, ok := receive(ctx, in)
+		if !ok {
+			return values
+		}
+
+		values = append(values, value)
+	}
+}
+
+// Stage applies fn to the values of in on workers goroutines, emitting the results in completion order.
+// A non-positive number of workers starts a single worker.
+func Stage[T, U any](ctx context.Context, in <-chan T, workers int, fn func(ctx context.Context, value T) U) <-chan U {
+	if workers <= 0 {
+		workers = 1
+	}
+
+	out := make(chan U)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+
+			for {
+				value, ok := receive(ctx, in)
+				if !ok {
+					return
+				}
+
+				if !send(ctx, out, fn(ctx, value)) {
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+// Merge emits the values of every input channel on a single channel.
+func Merge[T any](ctx context.Context, chans ...<-chan T) <-chan T {
+	out := make(chan T)
+
+	var wg sync.WaitGroup
+	wg.Add(len(chans))
+	for _, c := range chans {
+		go func(c <-chan T) {
+			defer wg.Done()
+
+			for {
+				value, ok := receive(ctx, c)
+				if !ok || !send(ctx, out, value) {
+					return
+				}
+			}
+		}(c)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+// Split distributes the values of in over n channels, each value going to the first channel ready to receive it.
+// A non-positive n returns a single channel.
+func Split[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	if n <= 0 {
+		n = 1
+	}
+
+	outs := make([]<-chan T, n)
+	for i := range outs {
+		out := make(chan T)
+		outs[i] = out
+
+		go func() {
+			defer close(out)
+
+			for {
+				value, ok := receive(ctx, in)
+				if !ok || !send(ctx, out, value) {
+					return
+				}
+			}
+		}()
+	}
+
+	return outs
+}
+
+// Batch groups the values of in into slices of up to size values, emitting a partial batch once maxWait
+// elapsed since its first value. The last partial batch is emitted when in is closed.
+func Batch[T any](ctx context.Context, in <-chan T, size int, maxWait time.Duration) <-chan []T {
+	return BatchWithClock(ctx, in, size, maxWait, timex.RealClock{})
+}
+
+// BatchWithClock is like Batch measuring maxWait with clock.
+func BatchWithClock[T any](ctx context.Context, in <-chan T, size int, maxWait time.Duration, clock timex.Clock) <-chan []T {
+	if size <= 0 {
+		size = 1
+	}
+
+	out := make(chan []T)
+
+	go func() {
+		defer close(out)
+
+		var (
+			batch   []T
+			timer   timex.Timer
+			timeout <-chan time.Time
+		)
+
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				timer, timeout = nil, nil
+			}
+
+			if len(batch) == 0 {
+				return true
+			}
+
+			ok := send(ctx, out, batch)
+			batch = nil
+
+			return ok
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case value, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+
+				batch = append(batch, value)
+				if len(batch) == 1 && maxWait > 0 {
+					timer = clock.NewTimer(maxWait)
+					timeout = timer.C()
+				}
+
+				if len(batch) >= size && !flush() {
+					return
+				}
+			case <-timeout:
+				if !flush() {
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+func receive[T any](ctx context.Context, in <-chan T) (T, bool) {
+	select {
+	case value, ok := <-in:
+		return value, ok
+	case <-ctx.Done():
+		var zero T
+		return zero, false
+	}
+}
+
+func send[T any](ctx context.Context, out chan<- T, value T) bool {
+	select {
+	case out <- value:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
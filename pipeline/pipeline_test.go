@@ -0,0 +1,132 @@
+package pipeline
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+func TestFromCollect(t *testing.T) {
+	got := Collect(context.Background(), From(context.Background(), 1, 2, 3))
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Collect() = %v, want %v", got, want)
+	}
+}
+
+func TestStage(t *testing.T) {
+	ctx := context.Background()
+	squares := Stage(ctx, From(ctx, 1, 2, 3, 4, 5), 3, func(ctx context.Context, n int) int {
+		return n * n
+	})
+
+	got := Collect(ctx, squares)
+	sort.Ints(got)
+
+	if want := []int{1, 4, 9, 16, 25}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Stage() = %v, want %v", got, want)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	ctx := context.Background()
+
+	got := Collect(ctx, Merge(ctx, From(ctx, 1, 2), From(ctx, 3), From[int](ctx)))
+	sort.Ints(got)
+
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge() = %v, want %v", got, want)
+	}
+
+	if got := Collect(ctx, Merge[int](ctx)); len(got) != 0 {
+		t.Errorf("Merge() = %v, want no values", got)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	ctx := context.Background()
+	outs := Split(ctx, From(ctx, 1, 2, 3, 4, 5, 6), 3)
+
+	if len(outs) != 3 {
+		t.Fatalf("len(Split()) = %v, want %v", len(outs), 3)
+	}
+
+	got := Collect(ctx, Merge(ctx, outs...))
+	sort.Ints(got)
+
+	if want := []int{1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Split() values = %v, want %v", got, want)
+	}
+}
+
+func TestBatchSize(t *testing.T) {
+	ctx := context.Background()
+
+	got := Collect(ctx, Batch(ctx, From(ctx, 1, 2, 3, 4, 5), 2, time.Hour))
+	if want := [][]int{{1, 2}, {3, 4}, {5}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Batch() = %v, want %v", got, want)
+	}
+}
+
+func TestBatchMaxWait(t *testing.T) {
+	ctx := context.Background()
+	clock := timex.NewFakeClock(time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC))
+
+	in := make(chan int)
+	batches := BatchWithClock(ctx, in, 10, time.Second, clock)
+
+	in <- 1
+	in <- 2
+	clock.BlockUntil(1)
+	clock.Advance(time.Second)
+
+	select {
+	case got := <-batches:
+		if want := []int{1, 2}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Batch() = %v, want %v", got, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Batch() did not emit the partial batch after maxWait")
+	}
+
+	close(in)
+	if _, ok := <-batches; ok {
+		t.Errorf("Batch() channel not closed after the input")
+	}
+}
+
+func TestCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// an endless source
+	in := make(chan int)
+	go func() {
+		for i := 0; ; i++ {
+			select {
+			case in <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	out := Batch(ctx, Merge(ctx, Stage(ctx, in, 2, func(ctx context.Context, n int) int { return n })), 5, time.Hour)
+	<-out
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		for range out {
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("pipeline did not close its channels after cancellation")
+	}
+}
@@ -0,0 +1,230 @@
+/*
+Package pool defines a generic worker pool.
+*/
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrClosed is returned when submitting to a closed pool.
+var ErrClosed = errors.New("pool is closed")
+
+// Result is the outcome of a task submitted to a Pool.
+type Result[T, R any] struct {
+	Index int // Position of the task in submission order, starting at 0
+	Input T
+	Value R
+	Err   error
+}
+
+// Options contains options to configure a Pool.
+type Options struct {
+	Ordered bool // Deliver results in submission order instead of completion order
+	Queue   int  // Number of submitted tasks that can wait for a worker without blocking Submit
+}
+
+// Pool runs tasks on a fixed number of workers and delivers their results on a channel.
+// Results that are not received yet are buffered, so submitting never waits for Results to be read.
+type Pool[T, R any] struct {
+	ctx     context.Context
+	fn      func(ctx context.Context, input T) (R, error)
+	tasks   chan task[T]
+	raw     chan Result[T, R]
+	results chan Result[T, R]
+	next    int64
+
+	mu       sync.Mutex
+	closed   bool
+	inflight sync.WaitGroup // Submit calls sending on tasks, which must finish before tasks is closed
+}
+
+type task[T any] struct {
+	index int
+	input T
+}
+
+// New starts a Pool running fn on workers goroutines, delivering results in completion order.
+func New[T, R any](ctx context.Context, workers int, fn func(ctx context.Context, input T) (R, error)) *Pool[T, R] {
+	return NewWithOptions(ctx, workers, fn, Options{})
+}
+
+// NewWithOptions starts a Pool running fn on workers goroutines configured with opts.
+// A non-positive number of workers starts a single worker.
+func NewWithOptions[T, R any](ctx context.Context, workers int, fn func(ctx context.Context, input T) (R, error), opts Options) *Pool[T, R] {
+	if workers <= 0 {
+		workers = 1
+	}
+
+	p := &Pool[T, R]{
+		ctx:     ctx,
+		fn:      fn,
+		tasks:   make(chan task[T], opts.Queue),
+		raw:     make(chan Result[T, R], workers),
+		results: make(chan Result[T, R]),
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			p.work()
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(p.raw)
+	}()
+
+	go p.collect(opts.Ordered)
+
+	return p
+}
+
+// Submit queues a task, blocking while the queue is full. It returns ErrClosed after Close
+// and the context error once the context of the pool is done.
+func (p *Pool[T, R]) Submit(input T) error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+
+		return ErrClosed
+	}
+
+	if err := p.ctx.Err(); err != nil {
+		p.mu.Unlock()
+
+		return err
+	}
+
+	p.inflight.Add(1)
+	p.mu.Unlock()
+
+	defer p.inflight.Done()
+
+	t := task[T]{index: int(atomic.AddInt64(&p.next, 1) - 1), input: input}
+	select {
+	case p.tasks <- t:
+		return nil
+	case <-p.ctx.Done():
+		// the index is lost, so deliver a result to keep ordered results flowing
+		p.raw <- Result[T, R]{Index: t.index, Input: input, Err: p.ctx.Err()}
+		return p.ctx.Err()
+	}
+}
+
+// Results returns the channel delivering the result of each submitted task.
+// It is closed once the pool is closed and every submitted task has completed.
+func (p *Pool[T, R]) Results() <-chan Result[T, R] {
+	return p.results
+}
+
+// Close stops accepting tasks. Submitted tasks keep running and their results are still delivered.
+// It is safe to call Close more than once.
+func (p *Pool[T, R]) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.closed {
+		p.closed = true
+
+		go func() {
+			p.inflight.Wait()
+			close(p.tasks)
+		}()
+	}
+}
+
+// Drain closes the pool and waits for every submitted task, returning the results not yet received.
+func (p *Pool[T, R]) Drain() []Result[T, R] {
+	p.Close()
+
+	results := make([]Result[T, R], 0)
+	for r := range p.results {
+		results = append(results, r)
+	}
+
+	return results
+}
+
+func (p *Pool[T, R]) work() {
+	for t := range p.tasks {
+		result := Result[T, R]{Index: t.index, Input: t.input}
+		if err := p.ctx.Err(); err != nil {
+			result.Err = err
+		} else {
+			result.Value, result.Err = p.run(t.input)
+		}
+
+		p.raw <- result
+	}
+}
+
+// run calls fn converting a panic into an error with the stack trace.
+func (p *Pool[T, R]) run(input T) (value R, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("task panicked: %v\n%s", r, debug.Stack())
+		}
+	}()
+
+	return p.fn(p.ctx, input)
+}
+
+// collect forwards the results of the workers to the results channel, buffering them while they are not
+// received and, when ordered, until the results of every earlier task are delivered.
+func (p *Pool[T, R]) collect(ordered bool) {
+	var ready []Result[T, R]
+	pending := make(map[int]Result[T, R])
+	next := 0
+
+	raw := p.raw
+	for raw != nil || len(ready) > 0 {
+		var (
+			out  chan<- Result[T, R]
+			head Result[T, R]
+		)
+		if len(ready) > 0 {
+			out, head = p.results, ready[0]
+		}
+
+		select {
+		case r, ok := <-raw:
+			if !ok {
+				raw = nil
+
+				continue
+			}
+
+			if !ordered {
+				ready = append(ready, r)
+
+				continue
+			}
+
+			pending[r.Index] = r
+			for {
+				r, ok := pending[next]
+				if !ok {
+					break
+				}
+
+				delete(pending, next)
+				ready = append(ready, r)
+				next++
+			}
+		case out <- head:
+			ready[0] = Result[T, R]{}
+			ready = ready[1:]
+		}
+	}
+
+	close(p.results)
+}
@@ -0,0 +1,179 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func double(ctx context.Context, n int) (int, error) {
+	return n * 2, nil
+}
+
+func TestPoolUnordered(t *testing.T) {
+	p := New(context.Background(), 4, double)
+
+	go func() {
+		for i := 0; i < 10; i++ {
+			if err := p.Submit(i); err != nil {
+				t.Errorf("Submit() error = %v", err)
+			}
+		}
+		p.Close()
+	}()
+
+	got := make([]int, 0)
+	for r := range p.Results() {
+		if r.Value != r.Input*2 {
+			t.Errorf("Result = %+v, want value %d", r, r.Input*2)
+		}
+
+		got = append(got, r.Value)
+	}
+
+	sort.Ints(got)
+	if want := []int{0, 2, 4, 6, 8, 10, 12, 14, 16, 18}; !reflect.DeepEqual(got, want) {
+		t.Errorf("results = %v, want %v", got, want)
+	}
+}
+
+func TestPoolOrdered(t *testing.T) {
+	// later tasks finish first
+	p := NewWithOptions(context.Background(), 4, func(ctx context.Context, n int) (int, error) {
+		time.Sleep(time.Duration(5-n) * time.Millisecond)
+		return n, nil
+	}, Options{Ordered: true, Queue: 5})
+
+	for i := 0; i < 5; i++ {
+		if err := p.Submit(i); err != nil {
+			t.Fatalf("Submit() error = %v", err)
+		}
+	}
+
+	results := p.Drain()
+	got := make([]int, 0, len(results))
+	for _, r := range results {
+		got = append(got, r.Index)
+	}
+
+	if want := []int{0, 1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("result indexes = %v, want %v", got, want)
+	}
+}
+
+func TestPoolSubmitBeforeReading(t *testing.T) {
+	for _, ordered := range []bool{false, true} {
+		p := NewWithOptions(context.Background(), 4, double, Options{Ordered: ordered, Queue: 10})
+
+		done := make(chan []Result[int, int])
+		go func() {
+			// far more tasks than the queue and the workers hold, with nobody reading the results
+			for i := 0; i < 100; i++ {
+				if err := p.Submit(i); err != nil {
+					t.Errorf("Submit() error = %v", err)
+				}
+			}
+
+			done <- p.Drain()
+		}()
+
+		select {
+		case results := <-done:
+			if len(results) != 100 {
+				t.Errorf("Drain() returned %d results, want 100", len(results))
+			}
+
+			for i, r := range results {
+				if ordered && r.Index != i {
+					t.Fatalf("Drain()[%d].Index = %d, want %d", i, r.Index, i)
+				}
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Submit() blocked with ordered = %v while results were not read", ordered)
+		}
+	}
+}
+
+func TestPoolErrorsAndPanics(t *testing.T) {
+	errOdd := errors.New("odd")
+	p := NewWithOptions(context.Background(), 2, func(ctx context.Context, n int) (int, error) {
+		if n == 3 {
+			panic("three")
+		}
+
+		if n%2 == 1 {
+			return 0, errOdd
+		}
+
+		return n, nil
+	}, Options{Ordered: true, Queue: 4})
+
+	for i := 0; i < 4; i++ {
+		_ = p.Submit(i)
+	}
+
+	results := p.Drain()
+	if len(results) != 4 {
+		t.Fatalf("len(Drain()) = %v, want %v", len(results), 4)
+	}
+
+	if results[0].Err != nil || results[2].Err != nil {
+		t.Errorf("unexpected errors for even inputs: %v, %v", results[0].Err, results[2].Err)
+	}
+
+	if !errors.Is(results[1].Err, errOdd) {
+		t.Errorf("Err = %v, want %v", results[1].Err, errOdd)
+	}
+
+	if results[3].Err == nil || !strings.Contains(results[3].Err.Error(), "task panicked: three") {
+		t.Errorf("Err = %v, want a recovered panic", results[3].Err)
+	}
+}
+
+func TestPoolClose(t *testing.T) {
+	p := New(context.Background(), 1, double)
+	p.Close()
+	p.Close()
+
+	if err := p.Submit(1); !errors.Is(err, ErrClosed) {
+		t.Errorf("Submit() error = %v, want %v", err, ErrClosed)
+	}
+
+	if got := p.Drain(); len(got) != 0 {
+		t.Errorf("Drain() = %v, want no results", got)
+	}
+}
+
+func TestPoolCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+
+	p := NewWithOptions(ctx, 1, func(ctx context.Context, n int) (int, error) {
+		if n == 0 {
+			close(started)
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}
+
+		return n, nil
+	}, Options{Queue: 2})
+
+	_ = p.Submit(0)
+	_ = p.Submit(1)
+	<-started
+	cancel()
+
+	if err := p.Submit(2); !errors.Is(err, context.Canceled) {
+		t.Errorf("Submit() error = %v, want %v", err, context.Canceled)
+	}
+
+	for _, r := range p.Drain() {
+		if !errors.Is(r.Err, context.Canceled) {
+			t.Errorf("Result %+v, want a canceled error", r)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package rand
 import (
 	"crypto/rand"
 	"fmt"
+	"io"
 	"math"
 	"math/big"
 	"strings"
@@ -16,8 +17,13 @@ const (
 	DefaultLength = 10
 )
 
+// Reader is the source of randomness used by every function of the package.
+// It defaults to crypto/rand.Reader and can be replaced, e.g. by a deterministic
+// reader in tests. It must not be replaced while other goroutines are using the package.
+var Reader io.Reader = rand.Reader
+
 func Number() (int64, error) {
-	n, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
+	n, err := rand.Int(Reader, big.NewInt(math.MaxInt64))
 	if err != nil {
 		return 0, fmt.Errorf("failed to generate random number: %w", err)
 	}
@@ -40,7 +46,7 @@ func NumberInRange(min, max int64) (int64, error) {
 	limit := math.MaxInt64 - (math.MaxInt64 % rangeSize)
 
 	for {
-		n, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
+		n, err := rand.Int(Reader, big.NewInt(math.MaxInt64))
 		if err != nil {
 			return 0, fmt.Errorf("failed to generate random number in range: %w", err)
 		}
@@ -52,6 +58,20 @@ func NumberInRange(min, max int64) (int64, error) {
 	}
 }
 
+// Bytes returns n random bytes read from Reader
+func Bytes(n int) ([]byte, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("length cannot be negative: %d", n)
+	}
+
+	b := make([]byte, n)
+	if _, err := io.ReadFull(Reader, b); err != nil {
+		return nil, fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+
+	return b, nil
+}
+
 // String generates a random string using the default constants
 func String() (string, error) {
 	return StringWithLength(DefaultLength)
@@ -93,6 +113,27 @@ func Shuffle[T any](slice []T) error {
 	return nil
 }
 
+// Sample returns n distinct elements picked at random from the provided slice
+func Sample[T any](slice []T, n int) ([]T, error) {
+	if n < 0 || n > len(slice) {
+		return nil, fmt.Errorf("cannot sample %d elements from slice of length %d", n, len(slice))
+	}
+
+	// partial Fisher-Yates shuffle over a copy of the slice
+	pool := make([]T, len(slice))
+	copy(pool, slice)
+
+	for i := 0; i < n; i++ {
+		j, err := NumberInRange(int64(i), int64(len(pool)-1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate random index: %w", err)
+		}
+		pool[i], pool[int(j)] = pool[int(j)], pool[i]
+	}
+
+	return pool[:n:n], nil
+}
+
 // StringWithCharset generates a random string with the specified length and character set
 func StringWithCharset(length int, charset string) (string, error) {
 	if length < 0 {
@@ -108,7 +149,7 @@ func StringWithCharset(length int, charset string) (string, error) {
 	charsetLength := big.NewInt(int64(len(trimmedCharset)))
 
 	for i := 0; i < length; i++ {
-		n, err := rand.Int(rand.Reader, charsetLength)
+		n, err := rand.Int(Reader, charsetLength)
 		if err != nil {
 			return "", fmt.Errorf("failed to generate random string: %w", err)
 		}
@@ -1,6 +1,7 @@
 package rand
 
 import (
+	"io"
 	"strings"
 	"testing"
 )
@@ -281,6 +282,137 @@ func TestStringWithCharset(t *testing.T) {
 	}
 }
 
+func TestSample(t *testing.T) {
+	tests := []struct {
+		name    string
+		slice   []int
+		n       int
+		wantErr bool
+	}{
+		{
+			name:  "success - sample some elements",
+			slice: []int{1, 2, 3, 4, 5},
+			n:     3,
+		},
+		{
+			name:  "success - sample all elements",
+			slice: []int{1, 2, 3},
+			n:     3,
+		},
+		{
+			name:  "success - sample zero elements",
+			slice: []int{1, 2, 3},
+			n:     0,
+		},
+		{
+			name:    "fail - sample more elements than available",
+			slice:   []int{1, 2},
+			n:       3,
+			wantErr: true,
+		},
+		{
+			name:    "fail - negative sample size",
+			slice:   []int{1, 2},
+			n:       -1,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Sample(tt.slice, tt.n)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Sample() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if err != nil {
+				return
+			}
+
+			if len(got) != tt.n {
+				t.Errorf("Sample() length = %v, want %v", len(got), tt.n)
+			}
+
+			seen := make(map[int]bool)
+			for _, v := range got {
+				if !contains(tt.slice, v) {
+					t.Errorf("Sample() returned value %v not found in slice", v)
+				}
+				if seen[v] {
+					t.Errorf("Sample() returned value %v more than once", v)
+				}
+				seen[v] = true
+			}
+		})
+	}
+}
+
+func TestBytes(t *testing.T) {
+	tests := []struct {
+		name    string
+		n       int
+		wantErr bool
+	}{
+		{name: "success - bytes", n: 32},
+		{name: "success - empty", n: 0},
+		{name: "fail - negative length", n: -1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Bytes(tt.n)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Bytes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && len(got) != tt.n {
+				t.Errorf("Bytes() length = %v, want %v", len(got), tt.n)
+			}
+		})
+	}
+
+	defer func(r io.Reader) { Reader = r }(Reader)
+	Reader = strings.NewReader("short")
+	if _, err := Bytes(10); err == nil {
+		t.Errorf("Bytes() expected error for an exhausted reader")
+	}
+}
+
+func TestReader(t *testing.T) {
+	defer func(r io.Reader) { Reader = r }(Reader)
+
+	shuffle := func() []int {
+		Reader = &deterministicReader{}
+
+		slice := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+		if err := Shuffle(slice); err != nil {
+			t.Fatalf("Shuffle() error = %v", err)
+		}
+		return slice
+	}
+
+	first, second := shuffle(), shuffle()
+	for i := range first {
+		if first[i] != second[i] {
+			t.Errorf("Shuffle() with the same reader = %v and %v, want equal results", first, second)
+			break
+		}
+	}
+}
+
+// deterministicReader is a reader returning a predictable stream of bytes
+type deterministicReader struct {
+	counter uint64
+}
+
+func (r *deterministicReader) Read(p []byte) (int, error) {
+	for i := range p {
+		r.counter = r.counter*6364136223846793005 + 1442695040888963407
+		p[i] = byte(r.counter >> 56)
+	}
+	return len(p), nil
+}
+
 // TestPick helper
 func contains[T comparable](slice []T, item T) bool {
 	for _, v := range slice {
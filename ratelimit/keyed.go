@@ -0,0 +1,115 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+// Allower is implemented by the limiters of this package.
+type Allower interface {
+	Allow() bool
+}
+
+// Keyed holds one limiter per key, e.g. per user, created on first use. It is safe for concurrent use.
+type Keyed[L Allower] struct {
+	mu      sync.Mutex
+	entries map[string]*keyedEntry[L]
+	factory func() L
+	clock   timex.Clock
+}
+
+type keyedEntry[L Allower] struct {
+	limiter  L
+	lastUsed time.Time
+}
+
+// NewKeyed returns a Keyed creating limiters with factory.
+func NewKeyed[L Allower](factory func() L) *Keyed[L] {
+	return NewKeyedWithClock(factory, timex.RealClock{})
+}
+
+// NewKeyedWithClock returns a Keyed like NewKeyed tracking the last use of each key with clock.
+func NewKeyedWithClock[L Allower](factory func() L, clock timex.Clock) *Keyed[L] {
+	return &Keyed[L]{entries: make(map[string]*keyedEntry[L]), factory: factory, clock: clock}
+}
+
+// NewKeyedLimiter returns a Keyed of token bucket limiters allowing rate events per second with bursts of up to burst events per key.
+func NewKeyedLimiter(rate float64, burst int) (*Keyed[*Limiter], error) {
+	// validate the parameters once so the factory cannot fail
+	if _, err := NewLimiter(rate, burst); err != nil {
+		return nil, err
+	}
+
+	return NewKeyed(func() *Limiter {
+		l, _ := NewLimiter(rate, burst)
+		return l
+	}), nil
+}
+
+// NewKeyedSlidingWindow returns a Keyed of sliding window limiters allowing up to limit events per window per key.
+func NewKeyedSlidingWindow(limit int, window time.Duration) (*Keyed[*SlidingWindow], error) {
+	if _, err := NewSlidingWindow(limit, window); err != nil {
+		return nil, err
+	}
+
+	return NewKeyed(func() *SlidingWindow {
+		w, _ := NewSlidingWindow(limit, window)
+		return w
+	}), nil
+}
+
+// Get returns the limiter of key, creating it if needed.
+func (k *Keyed[L]) Get(key string) L {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	entry, ok := k.entries[key]
+	if !ok {
+		entry = &keyedEntry[L]{limiter: k.factory()}
+		k.entries[key] = entry
+	}
+
+	entry.lastUsed = k.clock.Now()
+
+	return entry.limiter
+}
+
+// Allow reports whether an event for key may happen now.
+func (k *Keyed[L]) Allow(key string) bool {
+	return k.Get(key).Allow()
+}
+
+// Delete removes the limiter of key.
+func (k *Keyed[L]) Delete(key string) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	delete(k.entries, key)
+}
+
+// Len returns the number of keys with a limiter.
+func (k *Keyed[L]) Len() int {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return len(k.entries)
+}
+
+// Prune removes the limiters not used for at least idle and returns how many were removed.
+func (k *Keyed[L]) Prune(idle time.Duration) int {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	now := k.clock.Now()
+	removed := 0
+	for key, entry := range k.entries {
+		if now.Sub(entry.lastUsed) >= idle {
+			delete(k.entries, key)
+			removed++
+		}
+	}
+
+	return removed
+}
@@ -0,0 +1,72 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+func TestKeyed(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+	k := NewKeyedWithClock(func() *Limiter {
+		l, _ := NewLimiterWithClock(1, 1, clock)
+		return l
+	}, clock)
+
+	if !k.Allow("alice") {
+		t.Errorf("Allow(alice) = false for the first event")
+	}
+
+	if k.Allow("alice") {
+		t.Errorf("Allow(alice) = true over the limit")
+	}
+
+	if !k.Allow("bob") {
+		t.Errorf("Allow(bob) = false, keys must be limited independently")
+	}
+
+	if got := k.Len(); got != 2 {
+		t.Errorf("Len() = %v, want %v", got, 2)
+	}
+
+	clock.Advance(time.Minute)
+	k.Get("bob")
+
+	if got := k.Prune(time.Minute); got != 1 {
+		t.Errorf("Prune() = %v, want %v", got, 1)
+	}
+
+	k.Delete("bob")
+	if got := k.Len(); got != 0 {
+		t.Errorf("Len() = %v, want %v", got, 0)
+	}
+}
+
+func TestNewKeyedLimiters(t *testing.T) {
+	if _, err := NewKeyedLimiter(0, 1); err == nil {
+		t.Errorf("NewKeyedLimiter() expected error for zero rate")
+	}
+
+	limiters, err := NewKeyedLimiter(1, 2)
+	if err != nil {
+		t.Fatalf("NewKeyedLimiter() error = %v", err)
+	}
+
+	if !limiters.Allow("user") || !limiters.Allow("user") || limiters.Allow("user") {
+		t.Errorf("NewKeyedLimiter() does not apply the burst per key")
+	}
+
+	if _, err := NewKeyedSlidingWindow(1, 0); err == nil {
+		t.Errorf("NewKeyedSlidingWindow() expected error for zero window")
+	}
+
+	windows, err := NewKeyedSlidingWindow(1, time.Hour)
+	if err != nil {
+		t.Fatalf("NewKeyedSlidingWindow() error = %v", err)
+	}
+
+	if !windows.Allow("user") || windows.Allow("user") {
+		t.Errorf("NewKeyedSlidingWindow() does not apply the limit per key")
+	}
+}
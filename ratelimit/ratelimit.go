@@ -0,0 +1,194 @@
+/*
+Package ratelimit defines token bucket and sliding window rate limiters.
+*/
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+// Limiter is a token bucket rate limiter. The bucket holds up to burst tokens and is refilled at rate tokens
+// per second, each event consuming one token. It is safe for concurrent use.
+type Limiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  int
+	tokens float64
+	last   time.Time
+	clock  timex.Clock
+}
+
+// NewLimiter returns a Limiter allowing rate events per second with bursts of up to burst events.
+// The bucket starts full.
+func NewLimiter(rate float64, burst int) (*Limiter, error) {
+	return NewLimiterWithClock(rate, burst, timex.RealClock{})
+}
+
+// NewLimiterWithClock returns a Limiter like NewLimiter measuring time with clock.
+func NewLimiterWithClock(rate float64, burst int, clock timex.Clock) (*Limiter, error) {
+	if rate <= 0 {
+		return nil, fmt.Errorf("rate must be positive, got %v", rate)
+	}
+
+	if burst <= 0 {
+		return nil, fmt.Errorf("burst must be positive, got %d", burst)
+	}
+
+	return &Limiter{rate: rate, burst: burst, tokens: float64(burst), last: clock.Now(), clock: clock}, nil
+}
+
+// Allow reports whether an event may happen now, consuming a token if so.
+func (l *Limiter) Allow() bool {
+	return l.AllowN(1)
+}
+
+// AllowN reports whether n events may happen now, consuming n tokens if so.
+func (l *Limiter) AllowN(n int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance(l.clock.Now())
+	if l.tokens < float64(n) {
+		return false
+	}
+
+	l.tokens -= float64(n)
+
+	return true
+}
+
+// Tokens returns the number of tokens currently available, negative while reservations are pending.
+func (l *Limiter) Tokens() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance(l.clock.Now())
+
+	return l.tokens
+}
+
+// Reserve reserves a token and returns a Reservation telling how long to wait before the event may happen.
+func (l *Limiter) Reserve() *Reservation {
+	return l.ReserveN(1)
+}
+
+// ReserveN reserves n tokens. The reservation is not OK if n exceeds the burst.
+func (l *Limiter) ReserveN(n int) *Reservation {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock.Now()
+	if n > l.burst {
+		return &Reservation{limiter: l}
+	}
+
+	l.advance(now)
+	l.tokens -= float64(n)
+
+	var wait time.Duration
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+
+	return &Reservation{ok: true, limiter: l, tokens: n, timeToAct: now.Add(wait)}
+}
+
+// Wait blocks until an event may happen or ctx is done.
+func (l *Limiter) Wait(ctx context.Context) error {
+	return l.WaitN(ctx, 1)
+}
+
+// WaitN blocks until n events may happen or ctx is done. On cancellation the reserved tokens are returned.
+func (l *Limiter) WaitN(ctx context.Context, n int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r := l.ReserveN(n)
+	if !r.OK() {
+		return fmt.Errorf("cannot wait for %d events, burst is %d", n, l.burst)
+	}
+
+	delay := r.Delay()
+	if delay == 0 {
+		return nil
+	}
+
+	timer := l.clock.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C():
+		return nil
+	case <-ctx.Done():
+		r.Cancel()
+		return ctx.Err()
+	}
+}
+
+// advance refills the bucket up to now, the caller must hold l.mu.
+func (l *Limiter) advance(now time.Time) {
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.rate
+		if l.tokens > float64(l.burst) {
+			l.tokens = float64(l.burst)
+		}
+	}
+
+	l.last = now
+}
+
+// Reservation holds tokens reserved by Limiter.Reserve for an event happening after Delay.
+type Reservation struct {
+	ok        bool
+	limiter   *Limiter
+	tokens    int
+	timeToAct time.Time
+}
+
+// OK reports whether the tokens could be reserved.
+func (r *Reservation) OK() bool {
+	return r.ok
+}
+
+// Delay returns how long to wait before the reserved event may happen.
+func (r *Reservation) Delay() time.Duration {
+	if !r.ok {
+		return 0
+	}
+
+	if d := r.timeToAct.Sub(r.limiter.clock.Now()); d > 0 {
+		return d
+	}
+
+	return 0
+}
+
+// Cancel returns the reserved tokens to the limiter if the event has not happened yet.
+func (r *Reservation) Cancel() {
+	if !r.ok {
+		return
+	}
+
+	l := r.limiter
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock.Now()
+	if !r.timeToAct.After(now) {
+		return
+	}
+
+	l.advance(now)
+	l.tokens += float64(r.tokens)
+	if l.tokens > float64(l.burst) {
+		l.tokens = float64(l.burst)
+	}
+
+	r.ok = false
+}
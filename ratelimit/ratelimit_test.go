@@ -0,0 +1,130 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+var epoch = time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+func TestNewLimiter(t *testing.T) {
+	tests := []struct {
+		name    string
+		rate    float64
+		burst   int
+		wantErr bool
+	}{
+		{name: "success - valid parameters", rate: 10, burst: 5},
+		{name: "fail - zero rate", rate: 0, burst: 5, wantErr: true},
+		{name: "fail - negative burst", rate: 1, burst: -1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewLimiter(tt.rate, tt.burst)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewLimiter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLimiterAllow(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+	l, err := NewLimiterWithClock(2, 3, clock)
+	if err != nil {
+		t.Fatalf("NewLimiterWithClock() error = %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if !l.Allow() {
+			t.Fatalf("Allow() = false for event %d within burst", i)
+		}
+	}
+
+	if l.Allow() {
+		t.Errorf("Allow() = true with an empty bucket")
+	}
+
+	clock.Advance(500 * time.Millisecond)
+	if !l.Allow() {
+		t.Errorf("Allow() = false after refilling one token")
+	}
+
+	clock.Advance(time.Hour)
+	if got := l.Tokens(); got != 3 {
+		t.Errorf("Tokens() = %v, want %v", got, 3)
+	}
+
+	if l.AllowN(4) {
+		t.Errorf("AllowN() = true for more events than the burst")
+	}
+}
+
+func TestLimiterReserve(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+	l, _ := NewLimiterWithClock(1, 1, clock)
+
+	if r := l.Reserve(); !r.OK() || r.Delay() != 0 {
+		t.Errorf("Reserve() = ok %v delay %v, want ok without delay", r.OK(), r.Delay())
+	}
+
+	r := l.Reserve()
+	if !r.OK() || r.Delay() != time.Second {
+		t.Errorf("Reserve() = ok %v delay %v, want ok with %v delay", r.OK(), r.Delay(), time.Second)
+	}
+
+	r.Cancel()
+	if got := l.Tokens(); got != 0 {
+		t.Errorf("Tokens() after Cancel() = %v, want %v", got, 0)
+	}
+
+	if r := l.ReserveN(2); r.OK() {
+		t.Errorf("ReserveN() = ok for more tokens than the burst")
+	}
+}
+
+func TestLimiterWait(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+	l, _ := NewLimiterWithClock(1, 1, clock)
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- l.Wait(context.Background())
+	}()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Second)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Wait() error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Wait() did not return after the clock advanced")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		done <- l.Wait(ctx)
+	}()
+
+	clock.BlockUntil(1)
+	cancel()
+
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Wait() error = %v, want %v", err, context.Canceled)
+	}
+
+	if err := l.WaitN(context.Background(), 2); err == nil {
+		t.Errorf("WaitN() expected error for more events than the burst")
+	}
+}
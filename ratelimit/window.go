@@ -0,0 +1,96 @@
+package ratelimit
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+// SlidingWindow is a sliding window counter rate limiter allowing up to limit events in any window.
+// The count of the previous window is weighted by its overlap with the sliding window. It is safe for concurrent use.
+type SlidingWindow struct {
+	mu       sync.Mutex
+	limit    int
+	window   time.Duration
+	start    time.Time // start of the current fixed window
+	current  int
+	previous int
+	clock    timex.Clock
+}
+
+// NewSlidingWindow returns a SlidingWindow allowing up to limit events per window.
+func NewSlidingWindow(limit int, window time.Duration) (*SlidingWindow, error) {
+	return NewSlidingWindowWithClock(limit, window, timex.RealClock{})
+}
+
+// NewSlidingWindowWithClock returns a SlidingWindow like NewSlidingWindow measuring time with clock.
+func NewSlidingWindowWithClock(limit int, window time.Duration, clock timex.Clock) (*SlidingWindow, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("limit must be positive, got %d", limit)
+	}
+
+	if window <= 0 {
+		return nil, fmt.Errorf("window must be positive, got %v", window)
+	}
+
+	return &SlidingWindow{limit: limit, window: window, start: clock.Now(), clock: clock}, nil
+}
+
+// Allow reports whether an event may happen now, counting it if so.
+func (w *SlidingWindow) Allow() bool {
+	return w.AllowN(1)
+}
+
+// AllowN reports whether n events may happen now, counting them if so.
+func (w *SlidingWindow) AllowN(n int) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := w.clock.Now()
+	w.advance(now)
+
+	if w.estimate(now)+float64(n) > float64(w.limit) {
+		return false
+	}
+
+	w.current += n
+
+	return true
+}
+
+// Count returns the estimated number of events in the sliding window ending now.
+func (w *SlidingWindow) Count() float64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := w.clock.Now()
+	w.advance(now)
+
+	return w.estimate(now)
+}
+
+// advance moves the fixed windows up to now, the caller must hold w.mu.
+func (w *SlidingWindow) advance(now time.Time) {
+	elapsed := now.Sub(w.start)
+	if elapsed < w.window {
+		return
+	}
+
+	windows := elapsed / w.window
+	if windows == 1 {
+		w.previous = w.current
+	} else {
+		w.previous = 0
+	}
+
+	w.current = 0
+	w.start = w.start.Add(windows * w.window)
+}
+
+func (w *SlidingWindow) estimate(now time.Time) float64 {
+	weight := 1 - float64(now.Sub(w.start))/float64(w.window)
+
+	return float64(w.previous)*weight + float64(w.current)
+}
@@ -0,0 +1,56 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+func TestNewSlidingWindow(t *testing.T) {
+	if _, err := NewSlidingWindow(0, time.Second); err == nil {
+		t.Errorf("NewSlidingWindow() expected error for zero limit")
+	}
+
+	if _, err := NewSlidingWindow(1, 0); err == nil {
+		t.Errorf("NewSlidingWindow() expected error for zero window")
+	}
+}
+
+func TestSlidingWindowAllow(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+	w, err := NewSlidingWindowWithClock(4, time.Minute, clock)
+	if err != nil {
+		t.Fatalf("NewSlidingWindowWithClock() error = %v", err)
+	}
+
+	for i := 0; i < 4; i++ {
+		if !w.Allow() {
+			t.Fatalf("Allow() = false for event %d within limit", i)
+		}
+	}
+
+	if w.Allow() {
+		t.Errorf("Allow() = true over the limit")
+	}
+
+	// halfway through the next window the previous one still counts for half
+	clock.Advance(90 * time.Second)
+	if got := w.Count(); got != 2 {
+		t.Errorf("Count() = %v, want %v", got, 2)
+	}
+
+	if !w.AllowN(2) {
+		t.Errorf("AllowN() = false with room for two events")
+	}
+
+	if w.Allow() {
+		t.Errorf("Allow() = true over the limit")
+	}
+
+	// after two full windows nothing is counted
+	clock.Advance(2 * time.Minute)
+	if got := w.Count(); got != 0 {
+		t.Errorf("Count() = %v, want %v", got, 0)
+	}
+}
@@ -0,0 +1,126 @@
+package retry
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+)
+
+type permanentError struct {
+	err error
+}
+
+func (p *permanentError) Error() string { return p.err.Error() }
+func (p *permanentError) Unwrap() error { return p.err }
+
+// Permanent wraps err so Do returns it immediately without retrying. Do returns err itself, not the wrapper.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether err was wrapped with Permanent.
+func IsPermanent(err error) bool {
+	_, ok := asPermanent(err)
+	return ok
+}
+
+func asPermanent(err error) (*permanentError, bool) {
+	var p *permanentError
+	ok := errors.As(err, &p)
+
+	return p, ok
+}
+
+// Any returns a classifier accepting the errors accepted by any of the classifiers.
+func Any(classifiers ...func(err error) bool) func(err error) bool {
+	return func(err error) bool {
+		for _, classifier := range classifiers {
+			if classifier(err) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// IsTemporaryNetError reports whether err is a network timeout, a reset or refused connection,
+// a broken pipe or an unexpected EOF.
+func IsTemporaryNetError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNABORTED) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// HTTPError is an error carrying the status code of an HTTP response.
+type HTTPError struct {
+	StatusCode int
+	Status     string
+}
+
+func (e *HTTPError) Error() string {
+	if e.Status != "" {
+		return "unexpected http status: " + e.Status
+	}
+
+	return "unexpected http status: " + http.StatusText(e.StatusCode)
+}
+
+// CheckResponse returns an *HTTPError if the status code of resp is 400 or above, and nil otherwise.
+func CheckResponse(resp *http.Response) error {
+	if resp.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+
+	return &HTTPError{StatusCode: resp.StatusCode, Status: resp.Status}
+}
+
+// IsRetryableHTTPError reports whether err carries an HTTP status code worth retrying: 408, 429 or any 5xx except 501.
+// Any error in the chain with a StatusCode() int method or of type *HTTPError is recognized.
+func IsRetryableHTTPError(err error) bool {
+	code, ok := statusCode(err)
+	if !ok {
+		return false
+	}
+
+	return IsRetryableStatus(code)
+}
+
+// IsRetryableStatus reports whether an HTTP status code is worth retrying: 408, 429 or any 5xx except 501.
+func IsRetryableStatus(code int) bool {
+	switch {
+	case code == http.StatusRequestTimeout, code == http.StatusTooManyRequests:
+		return true
+	case code == http.StatusNotImplemented:
+		return false
+	default:
+		return code >= 500 && code <= 599
+	}
+}
+
+func statusCode(err error) (int, bool) {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode, true
+	}
+
+	var coder interface{ StatusCode() int }
+	if errors.As(err, &coder) {
+		return coder.StatusCode(), true
+	}
+
+	return 0, false
+}
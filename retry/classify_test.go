@@ -0,0 +1,133 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"syscall"
+	"testing"
+)
+
+type statusCoder int
+
+func (s statusCoder) Error() string   { return fmt.Sprintf("status %d", int(s)) }
+func (s statusCoder) StatusCode() int { return int(s) }
+
+func TestPermanent(t *testing.T) {
+	if Permanent(nil) != nil {
+		t.Errorf("Permanent(nil) != nil")
+	}
+
+	wrapped := fmt.Errorf("context: %w", Permanent(errTest))
+	if !IsPermanent(wrapped) || !errors.Is(wrapped, errTest) {
+		t.Errorf("IsPermanent() = false or errors.Is() = false for a wrapped permanent error")
+	}
+
+	if IsPermanent(errTest) {
+		t.Errorf("IsPermanent() = true for a regular error")
+	}
+
+	calls := 0
+	err := Do(context.Background(), func(ctx context.Context) error {
+		calls++
+		return Permanent(errTest)
+	}, Delay(0))
+
+	if err != errTest || calls != 1 {
+		t.Errorf("Do() = %v with %d calls, want %v with 1 call", err, calls, errTest)
+	}
+}
+
+func TestIf(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		wantCalls int
+	}{
+		{name: "success - retryable status is retried", err: &HTTPError{StatusCode: http.StatusServiceUnavailable}, wantCalls: 3},
+		{name: "success - bad request is not retried", err: &HTTPError{StatusCode: http.StatusBadRequest}, wantCalls: 1},
+		{name: "success - temporary net error is retried", err: syscall.ECONNRESET, wantCalls: 3},
+		{name: "success - other errors are not retried", err: errTest, wantCalls: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := Do(context.Background(), func(ctx context.Context) error {
+				calls++
+				return tt.err
+			}, Delay(0), If(Any(IsRetryableHTTPError, IsTemporaryNetError)))
+
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Do() error = %v, want %v", err, tt.err)
+			}
+
+			if calls != tt.wantCalls {
+				t.Errorf("Do() calls = %v, want %v", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestIsTemporaryNetError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "success - timeout", err: &net.OpError{Op: "dial", Err: os.ErrDeadlineExceeded}, want: true},
+		{name: "success - connection refused", err: &net.OpError{Op: "dial", Err: &os.SyscallError{Syscall: "connect", Err: syscall.ECONNREFUSED}}, want: true},
+		{name: "success - unexpected eof", err: fmt.Errorf("read body: %w", io.ErrUnexpectedEOF), want: true},
+		{name: "success - dns not found", err: &net.DNSError{Err: "no such host", IsNotFound: true}, want: false},
+		{name: "success - other error", err: errTest, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTemporaryNetError(tt.err); got != tt.want {
+				t.Errorf("IsTemporaryNetError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsRetryableHTTPError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "success - too many requests", err: &HTTPError{StatusCode: http.StatusTooManyRequests}, want: true},
+		{name: "success - internal server error", err: &HTTPError{StatusCode: http.StatusInternalServerError}, want: true},
+		{name: "success - not implemented", err: &HTTPError{StatusCode: http.StatusNotImplemented}, want: false},
+		{name: "success - not found", err: &HTTPError{StatusCode: http.StatusNotFound}, want: false},
+		{name: "success - wrapped status coder", err: fmt.Errorf("call: %w", statusCoder(http.StatusBadGateway)), want: true},
+		{name: "success - without status", err: errTest, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryableHTTPError(tt.err); got != tt.want {
+				t.Errorf("IsRetryableHTTPError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckResponse(t *testing.T) {
+	if err := CheckResponse(&http.Response{StatusCode: http.StatusOK}); err != nil {
+		t.Errorf("CheckResponse() = %v, want nil", err)
+	}
+
+	err := CheckResponse(&http.Response{StatusCode: http.StatusTooManyRequests, Status: "429 Too Many Requests"})
+
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("CheckResponse() = %v, want an *HTTPError with status 429", err)
+	}
+
+	if got, want := err.Error(), "unexpected http status: 429 Too Many Requests"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
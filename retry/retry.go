@@ -0,0 +1,239 @@
+/*
+Package retry defines helpers to retry operations with exponential backoff.
+*/
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kashifkhan0771/utils/rand"
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+// Jitter is the strategy used to randomize the delay between attempts.
+type Jitter int
+
+const (
+	FullJitter  Jitter = iota // random delay between zero and the backoff, the default
+	EqualJitter               // half of the backoff plus a random delay up to the other half
+	NoJitter                  // exactly the backoff
+)
+
+// Default values of the options.
+const (
+	DefaultAttempts   = 3
+	DefaultDelay      = 100 * time.Millisecond
+	DefaultMaxDelay   = 10 * time.Second
+	DefaultMultiplier = 2
+)
+
+type config struct {
+	attempts       int
+	delay          time.Duration
+	maxDelay       time.Duration
+	multiplier     float64
+	jitter         Jitter
+	attemptTimeout time.Duration
+	onRetry        func(attempt int, err error, delay time.Duration)
+	retryable      func(err error) bool
+	clock          timex.Clock
+}
+
+// Option configures Do.
+type Option func(*config)
+
+// Attempts sets the maximum number of attempts, including the first one. Zero retries until the context is done.
+func Attempts(n int) Option {
+	return func(c *config) { c.attempts = n }
+}
+
+// Delay sets the backoff before the first retry.
+func Delay(d time.Duration) Option {
+	return func(c *config) { c.delay = d }
+}
+
+// MaxDelay caps the backoff between attempts.
+func MaxDelay(d time.Duration) Option {
+	return func(c *config) { c.maxDelay = d }
+}
+
+// Multiplier sets the factor by which the backoff grows after each retry.
+func Multiplier(m float64) Option {
+	return func(c *config) { c.multiplier = m }
+}
+
+// WithJitter sets the jitter strategy.
+func WithJitter(j Jitter) Option {
+	return func(c *config) { c.jitter = j }
+}
+
+// AttemptTimeout bounds the duration of each attempt through its context.
+func AttemptTimeout(d time.Duration) Option {
+	return func(c *config) { c.attemptTimeout = d }
+}
+
+// OnRetry sets a hook called after each failed attempt that will be retried, with the attempt number
+// starting at 1, its error and the delay before the next attempt.
+func OnRetry(hook func(attempt int, err error, delay time.Duration)) Option {
+	return func(c *config) { c.onRetry = hook }
+}
+
+// If only retries the errors for which classifier returns true, other errors are returned immediately.
+func If(classifier func(err error) bool) Option {
+	return func(c *config) { c.retryable = classifier }
+}
+
+// WithClock sets the clock used to wait between attempts.
+func WithClock(clock timex.Clock) Option {
+	return func(c *config) { c.clock = clock }
+}
+
+// Do calls fn until it succeeds, the attempts are exhausted or ctx is done, waiting with exponential backoff
+// between attempts. It returns nil on success and otherwise the error of the last attempt.
+// Errors wrapped with Permanent or rejected by the If classifier are returned without retrying.
+func Do(ctx context.Context, fn func(ctx context.Context) error, opts ...Option) error {
+	_, err := DoWithResult(ctx, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, fn(ctx)
+	}, opts...)
+
+	return err
+}
+
+// DoWithResult is like Do for functions returning a value.
+func DoWithResult[T any](ctx context.Context, fn func(ctx context.Context) (T, error), opts ...Option) (T, error) {
+	c := config{
+		attempts:   DefaultAttempts,
+		delay:      DefaultDelay,
+		maxDelay:   DefaultMaxDelay,
+		multiplier: DefaultMultiplier,
+		clock:      timex.RealClock{},
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	var zero T
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+
+		value, err := callAttempt(ctx, c.attemptTimeout, fn)
+		if err == nil {
+			return value, nil
+		}
+
+		if p, ok := asPermanent(err); ok {
+			return zero, p.err
+		}
+
+		if c.retryable != nil && !c.retryable(err) {
+			return zero, err
+		}
+
+		if c.attempts > 0 && attempt >= c.attempts {
+			return zero, fmt.Errorf("all %d attempts failed: %w", attempt, err)
+		}
+
+		delay := c.backoff(attempt)
+		if c.onRetry != nil {
+			c.onRetry(attempt, err, delay)
+		}
+
+		if waitErr := wait(ctx, c.clock, delay); waitErr != nil {
+			return zero, &stoppedError{cause: waitErr, attempts: attempt, last: err}
+		}
+	}
+}
+
+func callAttempt[T any](ctx context.Context, timeout time.Duration, fn func(ctx context.Context) (T, error)) (T, error) {
+	if timeout <= 0 {
+		return fn(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return fn(ctx)
+}
+
+// backoff returns the delay after the given failed attempt, starting at 1.
+func (c *config) backoff(attempt int) time.Duration {
+	d := float64(c.delay) * math.Pow(c.multiplier, float64(attempt-1))
+	if c.maxDelay > 0 && d > float64(c.maxDelay) {
+		d = float64(c.maxDelay)
+	}
+
+	backoff := time.Duration(math.MaxInt64)
+	if d < math.MaxInt64 {
+		backoff = time.Duration(d)
+	}
+
+	if backoff <= 0 {
+		return 0
+	}
+
+	switch c.jitter {
+	case FullJitter:
+		return randomDuration(backoff)
+	case EqualJitter:
+		return backoff/2 + randomDuration(backoff-backoff/2)
+	default:
+		return backoff
+	}
+}
+
+// randomDuration returns a random duration in [0, max], or max if randomness is unavailable.
+func randomDuration(max time.Duration) time.Duration {
+	// the size of [0, MaxInt64] overflows int64, drop the last value so NumberInRange can draw from it
+	if max == math.MaxInt64 {
+		max--
+	}
+
+	n, err := rand.NumberInRange(0, int64(max))
+	if err != nil {
+		return max
+	}
+
+	return time.Duration(n)
+}
+
+func wait(ctx context.Context, clock timex.Clock, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := clock.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// stoppedError is returned when the context ends while waiting between attempts.
+// It matches both the error of the context and the error of the last attempt.
+type stoppedError struct {
+	cause    error
+	attempts int
+	last     error
+}
+
+func (e *stoppedError) Error() string {
+	return fmt.Sprintf("%v after %d attempts, last error: %v", e.cause, e.attempts, e.last)
+}
+
+func (e *stoppedError) Unwrap() error { return e.cause }
+
+// Is reports whether target matches the error of the last attempt, the cause is matched through Unwrap.
+func (e *stoppedError) Is(target error) bool { return errors.Is(e.last, target) }
+
+// As finds the first error of the last attempt matching target, the cause is matched through Unwrap.
+func (e *stoppedError) As(target any) bool { return errors.As(e.last, target) }
@@ -0,0 +1,188 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+var errTest = errors.New("test error")
+
+// failing returns a function failing the first n calls and counting all calls.
+func failing(n int, calls *int) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		*calls++
+		if *calls <= n {
+			return errTest
+		}
+
+		return nil
+	}
+}
+
+func TestDo(t *testing.T) {
+	tests := []struct {
+		name      string
+		failures  int
+		attempts  int
+		wantCalls int
+		wantErr   bool
+	}{
+		{name: "success - first attempt", failures: 0, attempts: 3, wantCalls: 1},
+		{name: "success - after retries", failures: 2, attempts: 3, wantCalls: 3},
+		{name: "success - unlimited attempts", failures: 5, attempts: 0, wantCalls: 6},
+		{name: "fail - attempts exhausted", failures: 5, attempts: 3, wantCalls: 3, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := Do(context.Background(), failing(tt.failures, &calls), Attempts(tt.attempts), Delay(0))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Do() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr && !errors.Is(err, errTest) {
+				t.Errorf("Do() error = %v, want it to wrap %v", err, errTest)
+			}
+
+			if calls != tt.wantCalls {
+				t.Errorf("Do() calls = %v, want %v", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestDoWithResult(t *testing.T) {
+	calls := 0
+	got, err := DoWithResult(context.Background(), func(ctx context.Context) (string, error) {
+		calls++
+		if calls < 2 {
+			return "", errTest
+		}
+
+		return "done", nil
+	}, Delay(0))
+
+	if err != nil || got != "done" {
+		t.Errorf("DoWithResult() = %q, %v, want %q, nil", got, err, "done")
+	}
+}
+
+func TestOnRetryBackoff(t *testing.T) {
+	var delays []time.Duration
+	var attempts []int
+
+	calls := 0
+	_ = Do(context.Background(), failing(10, &calls),
+		Attempts(5),
+		Delay(time.Nanosecond),
+		Multiplier(3),
+		MaxDelay(20*time.Nanosecond),
+		WithJitter(NoJitter),
+		OnRetry(func(attempt int, err error, delay time.Duration) {
+			attempts = append(attempts, attempt)
+			delays = append(delays, delay)
+		}),
+	)
+
+	if want := []int{1, 2, 3, 4}; !reflect.DeepEqual(attempts, want) {
+		t.Errorf("OnRetry() attempts = %v, want %v", attempts, want)
+	}
+
+	if want := []time.Duration{1, 3, 9, 20}; !reflect.DeepEqual(delays, want) {
+		t.Errorf("OnRetry() delays = %v, want %v", delays, want)
+	}
+}
+
+func TestBackoffJitter(t *testing.T) {
+	tests := []struct {
+		name     string
+		jitter   Jitter
+		min, max time.Duration
+	}{
+		{name: "success - full jitter", jitter: FullJitter, min: 0, max: time.Second},
+		{name: "success - equal jitter", jitter: EqualJitter, min: 500 * time.Millisecond, max: time.Second},
+		{name: "success - no jitter", jitter: NoJitter, min: time.Second, max: time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := config{delay: time.Second, multiplier: 2, maxDelay: time.Minute, jitter: tt.jitter}
+			for i := 0; i < 100; i++ {
+				if got := c.backoff(1); got < tt.min || got > tt.max {
+					t.Fatalf("backoff() = %v, want within [%v, %v]", got, tt.min, tt.max)
+				}
+			}
+		})
+	}
+
+	c := config{delay: time.Second, multiplier: 2, jitter: NoJitter}
+	if got := c.backoff(1000); got <= 0 {
+		t.Errorf("backoff() = %v, want a positive delay without overflow", got)
+	}
+
+	for _, jitter := range []Jitter{FullJitter, EqualJitter} {
+		c := config{delay: time.Second, multiplier: 2, jitter: jitter}
+		if got := c.backoff(1000); got < 0 {
+			t.Errorf("backoff() = %v, want a delay within [0, %v]", got, time.Duration(math.MaxInt64))
+		}
+	}
+}
+
+func TestDoWaitsWithClock(t *testing.T) {
+	clock := timex.NewFakeClock(time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC))
+	done := make(chan error, 1)
+
+	calls := 0
+	go func() {
+		done <- Do(context.Background(), failing(1, &calls), Delay(time.Minute), WithJitter(NoJitter), WithClock(clock))
+	}()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Do() error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Do() did not retry after the clock advanced")
+	}
+}
+
+func TestDoContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	if err := Do(ctx, failing(0, &calls)); !errors.Is(err, context.Canceled) || calls != 0 {
+		t.Errorf("Do() = %v with %d calls, want %v without calls", err, calls, context.Canceled)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	calls = 0
+	err := Do(ctx, failing(10, &calls), Attempts(0), Delay(time.Hour), OnRetry(func(int, error, time.Duration) { cancel() }))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Do() error = %v, want %v", err, context.Canceled)
+	}
+
+	if !errors.Is(err, errTest) {
+		t.Errorf("Do() error = %v, want it to match the last error %v", err, errTest)
+	}
+}
+
+func TestAttemptTimeout(t *testing.T) {
+	err := Do(context.Background(), func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}, Attempts(2), Delay(0), AttemptTimeout(time.Millisecond))
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Do() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
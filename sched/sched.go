@@ -0,0 +1,207 @@
+/*
+Package sched defines an in-process scheduler for periodic tasks.
+*/
+package sched
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/kashifkhan0771/utils/rand"
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+// Overlap is the policy applied when a run is due while the previous one is still running.
+type Overlap int
+
+const (
+	OverlapSkip  Overlap = iota // skip the run, the default
+	OverlapQueue                // run again as soon as the previous run ends, keeping at most one pending run
+)
+
+// Options contains options to configure a Job.
+type Options struct {
+	Jitter  time.Duration   // Maximum random delay before the schedule starts, to spread jobs started together
+	Overlap Overlap         // Policy for runs due while the previous one is still running
+	OnError func(err error) // Called with the errors and recovered panics of the runs
+	Clock   timex.Clock     // Clock driving the schedule, the real clock by default
+}
+
+// Job is a scheduled task.
+type Job struct {
+	fn   func(ctx context.Context) error
+	next func(after time.Time) time.Time
+	opts Options
+
+	ctx    context.Context // cancelled when a shutdown gives up waiting
+	cancel context.CancelFunc
+	stop   chan struct{}
+	once   sync.Once
+	runs   sync.WaitGroup
+
+	mu      sync.Mutex
+	stopped bool
+	running bool
+	pending bool
+}
+
+// Every runs fn every d until the job is stopped.
+func Every(d time.Duration, fn func(ctx context.Context) error, opts Options) (*Job, error) {
+	if d <= 0 {
+		return nil, fmt.Errorf("interval must be positive, got %v", d)
+	}
+
+	return start(fn, func(after time.Time) time.Time { return after.Add(d) }, opts), nil
+}
+
+// Cron runs fn on the schedule of a cron expression parsed by timex.ParseCron, in the location of the clock.
+func Cron(expr string, fn func(ctx context.Context) error, opts Options) (*Job, error) {
+	schedule, err := timex.ParseCron(expr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cron expression: %w", err)
+	}
+
+	return start(fn, schedule.Next, opts), nil
+}
+
+func start(fn func(ctx context.Context) error, next func(time.Time) time.Time, opts Options) *Job {
+	if opts.Clock == nil {
+		opts.Clock = timex.RealClock{}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	j := &Job{fn: fn, next: next, opts: opts, ctx: ctx, cancel: cancel, stop: make(chan struct{})}
+
+	go j.loop()
+
+	return j
+}
+
+// Stop stops scheduling new runs without waiting for the running one.
+func (j *Job) Stop() {
+	j.once.Do(func() {
+		j.mu.Lock()
+		j.stopped = true
+		j.mu.Unlock()
+
+		close(j.stop)
+	})
+}
+
+// Shutdown stops scheduling new runs and waits for the running one. If ctx is done first,
+// the context of the running run is cancelled and the context error is returned.
+func (j *Job) Shutdown(ctx context.Context) error {
+	j.Stop()
+
+	done := make(chan struct{})
+	go func() {
+		j.runs.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		j.cancel()
+		return nil
+	case <-ctx.Done():
+		j.cancel()
+		return ctx.Err()
+	}
+}
+
+func (j *Job) loop() {
+	if j.opts.Jitter > 0 {
+		if n, err := rand.NumberInRange(0, int64(j.opts.Jitter)); err == nil && !j.sleep(time.Duration(n)) {
+			return
+		}
+	}
+
+	scheduled := j.opts.Clock.Now()
+	for {
+		now := j.opts.Clock.Now()
+		scheduled = j.next(scheduled)
+		// after falling behind, skip the missed runs
+		if scheduled.Before(now) {
+			scheduled = j.next(now)
+		}
+
+		if scheduled.IsZero() || !j.sleep(scheduled.Sub(now)) {
+			return
+		}
+
+		j.trigger()
+	}
+}
+
+// sleep waits for d and reports whether the job is still active.
+func (j *Job) sleep(d time.Duration) bool {
+	timer := j.opts.Clock.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C():
+		return true
+	case <-j.stop:
+		return false
+	}
+}
+
+func (j *Job) trigger() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.stopped {
+		return
+	}
+
+	if j.running {
+		if j.opts.Overlap == OverlapQueue {
+			j.pending = true
+		}
+
+		return
+	}
+
+	j.running = true
+	j.runs.Add(1)
+
+	go func() {
+		defer j.runs.Done()
+
+		for {
+			j.run()
+
+			j.mu.Lock()
+			if !j.pending || j.stopped {
+				j.running, j.pending = false, false
+				j.mu.Unlock()
+
+				return
+			}
+
+			j.pending = false
+			j.mu.Unlock()
+		}
+	}()
+}
+
+func (j *Job) run() {
+	defer func() {
+		if r := recover(); r != nil {
+			j.report(fmt.Errorf("job panicked: %v\n%s", r, debug.Stack()))
+		}
+	}()
+
+	if err := j.fn(j.ctx); err != nil {
+		j.report(err)
+	}
+}
+
+func (j *Job) report(err error) {
+	if j.opts.OnError != nil {
+		j.opts.OnError(err)
+	}
+}
@@ -0,0 +1,212 @@
+package sched
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+var epoch = time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+// waitFor polls cond until it holds or a second elapsed.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("condition not met in time")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestEvery(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+
+	var runs int64
+	job, err := Every(time.Minute, func(ctx context.Context) error {
+		atomic.AddInt64(&runs, 1)
+		return nil
+	}, Options{Clock: clock})
+	if err != nil {
+		t.Fatalf("Every() error = %v", err)
+	}
+
+	for i := int64(1); i <= 3; i++ {
+		clock.BlockUntil(1)
+		clock.Advance(time.Minute)
+		waitFor(t, func() bool { return atomic.LoadInt64(&runs) == i })
+	}
+
+	if err := job.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown() error = %v", err)
+	}
+
+	clock.Advance(time.Hour)
+	time.Sleep(10 * time.Millisecond)
+	if got := atomic.LoadInt64(&runs); got != 3 {
+		t.Errorf("runs = %v after Shutdown(), want %v", got, 3)
+	}
+
+	if _, err := Every(0, nil, Options{}); err == nil {
+		t.Errorf("Every() expected error for zero interval")
+	}
+}
+
+func TestCron(t *testing.T) {
+	clock := timex.NewFakeClock(time.Date(2024, time.October, 1, 10, 59, 30, 0, time.UTC))
+
+	ran := make(chan time.Time, 1)
+	job, err := Cron("@hourly", func(ctx context.Context) error {
+		ran <- clock.Now()
+		return nil
+	}, Options{Clock: clock})
+	if err != nil {
+		t.Fatalf("Cron() error = %v", err)
+	}
+	defer job.Stop()
+
+	clock.BlockUntil(1)
+	clock.Advance(30 * time.Second)
+
+	select {
+	case got := <-ran:
+		if want := time.Date(2024, time.October, 1, 11, 0, 0, 0, time.UTC); !got.Equal(want) {
+			t.Errorf("run at %v, want %v", got, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("cron job did not run")
+	}
+
+	if _, err := Cron("not a cron", nil, Options{}); err == nil {
+		t.Errorf("Cron() expected error for an invalid expression")
+	}
+}
+
+func TestOverlap(t *testing.T) {
+	tests := []struct {
+		name    string
+		overlap Overlap
+		want    int64
+	}{
+		{name: "success - skip", overlap: OverlapSkip, want: 1},
+		{name: "success - queue", overlap: OverlapQueue, want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := timex.NewFakeClock(epoch)
+			release := make(chan struct{})
+
+			var runs int64
+			job, _ := Every(time.Second, func(ctx context.Context) error {
+				atomic.AddInt64(&runs, 1)
+				<-release
+				return nil
+			}, Options{Clock: clock, Overlap: tt.overlap})
+
+			// three ticks while the first run is blocked
+			for i := 0; i < 3; i++ {
+				clock.BlockUntil(1)
+				clock.Advance(time.Second)
+				waitFor(t, func() bool { return atomic.LoadInt64(&runs) == 1 })
+			}
+
+			job.Stop()
+			close(release)
+
+			if err := job.Shutdown(context.Background()); err != nil {
+				t.Fatalf("Shutdown() error = %v", err)
+			}
+
+			if got := atomic.LoadInt64(&runs); got > tt.want {
+				t.Errorf("runs = %v, want at most %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorsAndPanics(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+	errs := make(chan error, 2)
+
+	calls := 0
+	job, _ := Every(time.Second, func(ctx context.Context) error {
+		calls++
+		if calls == 1 {
+			return errors.New("failed")
+		}
+
+		panic("boom")
+	}, Options{Clock: clock, OnError: func(err error) { errs <- err }})
+	defer job.Stop()
+
+	for _, want := range []string{"failed", "job panicked: boom"} {
+		clock.BlockUntil(1)
+		clock.Advance(time.Second)
+
+		select {
+		case err := <-errs:
+			if !strings.HasPrefix(err.Error(), want) {
+				t.Errorf("OnError() err = %v, want %q", err, want)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("OnError() not called")
+		}
+	}
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+	started := make(chan struct{})
+	cancelled := make(chan struct{})
+
+	job, _ := Every(time.Second, func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		close(cancelled)
+		return nil
+	}, Options{Clock: clock})
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Second)
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := job.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Shutdown() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Errorf("run context not cancelled after Shutdown() gave up")
+	}
+}
+
+func TestJitter(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+
+	var runs int64
+	job, _ := Every(time.Minute, func(ctx context.Context) error {
+		atomic.AddInt64(&runs, 1)
+		return nil
+	}, Options{Clock: clock, Jitter: time.Second})
+	defer job.Stop()
+
+	// the jitter delay, then the interval
+	clock.BlockUntil(1)
+	clock.Advance(time.Second)
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+
+	waitFor(t, func() bool { return atomic.LoadInt64(&runs) == 1 })
+}
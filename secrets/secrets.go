@@ -0,0 +1,82 @@
+/*
+Package secrets defines loading and sealing of encrypted configuration files.
+*/
+package secrets
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/kashifkhan0771/utils/cryptox"
+)
+
+// ErrRedacted is returned by Seal when v encodes to cryptox.Redacted, which happens with cryptox.Secret values.
+var ErrRedacted = errors.New("cannot seal redacted values")
+
+// Seal encodes v as JSON, encrypts it with key using the cryptox envelope format and writes it to path
+// as base64 text, so the file can be committed to version control. The file is created with mode 0600.
+//
+// A cryptox.Secret always encodes as cryptox.Redacted, so sealing a struct with Secret fields would lose
+// their values. Seal returns ErrRedacted instead: seal the exposed values, for example in a map[string]string
+// or a struct with string fields, and load them back into Secret fields with LoadEncryptedInto.
+func Seal(path string, key []byte, v interface{}) error {
+	plaintext, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode secrets: %w", err)
+	}
+
+	if bytes.Contains(plaintext, redacted) {
+		return ErrRedacted
+	}
+
+	envelope, err := cryptox.Encrypt(key, plaintext)
+	if err != nil {
+		return err
+	}
+
+	data := base64.StdEncoding.EncodeToString(envelope) + "\n"
+
+	return os.WriteFile(path, []byte(data), 0o600)
+}
+
+// redacted is cryptox.Redacted encoded as a JSON string.
+var redacted, _ = json.Marshal(cryptox.Redacted)
+
+// LoadEncrypted decrypts a file written by Seal into a map of names to values.
+func LoadEncrypted(path string, key []byte) (map[string]string, error) {
+	var secrets map[string]string
+	if err := LoadEncryptedInto(path, key, &secrets); err != nil {
+		return nil, err
+	}
+
+	return secrets, nil
+}
+
+// LoadEncryptedInto decrypts a file written by Seal and decodes its JSON into v, a pointer to a map or struct.
+// Errors wrap cryptox.ErrDecrypt when the key is wrong or the file was modified.
+func LoadEncryptedInto(path string, key []byte, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	envelope, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data)))
+	if err != nil {
+		return fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+
+	plaintext, err := cryptox.Decrypt(key, envelope)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt %s: %w", path, err)
+	}
+
+	if err := json.Unmarshal(plaintext, v); err != nil {
+		return fmt.Errorf("failed to decode secrets: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package secrets
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kashifkhan0771/utils/cryptox"
+)
+
+type config struct {
+	DatabaseURL string `json:"database_url"`
+	APIKeys     []string
+}
+
+func TestSealLoad(t *testing.T) {
+	key, _ := cryptox.GenerateKey()
+	path := filepath.Join(t.TempDir(), "secrets.enc")
+	want := map[string]string{"DB_PASSWORD": "hunter2", "API_TOKEN": "abc"}
+
+	if err := Seal(path, key, want); err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("Seal() file mode = %v, %v, want 0600", info.Mode().Perm(), err)
+	}
+
+	got, err := LoadEncrypted(path, key)
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("LoadEncrypted() = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestSealLoadStruct(t *testing.T) {
+	key, _ := cryptox.GenerateKey()
+	path := filepath.Join(t.TempDir(), "config.enc")
+	want := config{DatabaseURL: "postgres://app:secret@db/app", APIKeys: []string{"k1", "k2"}}
+
+	if err := Seal(path, key, want); err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+
+	var got config
+	if err := LoadEncryptedInto(path, key, &got); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("LoadEncryptedInto() = %+v, %v, want %+v", got, err, want)
+	}
+}
+
+func TestSealSecrets(t *testing.T) {
+	type credentials struct {
+		Password cryptox.Secret `json:"password"`
+	}
+
+	key, _ := cryptox.GenerateKey()
+	path := filepath.Join(t.TempDir(), "credentials.enc")
+
+	if err := Seal(path, key, credentials{Password: cryptox.NewSecret("hunter2")}); !errors.Is(err, ErrRedacted) {
+		t.Errorf("Seal() error = %v, want %v", err, ErrRedacted)
+	}
+
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Seal() wrote a redacted file, stat error = %v", err)
+	}
+
+	password := cryptox.NewSecret("hunter2")
+	if err := Seal(path, key, map[string]string{"password": password.Expose()}); err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+
+	var got credentials
+	if err := LoadEncryptedInto(path, key, &got); err != nil || got.Password.Expose() != "hunter2" {
+		t.Errorf("LoadEncryptedInto() = %q, %v, want %q", got.Password.Expose(), err, "hunter2")
+	}
+}
+
+func TestLoadEncryptedErrors(t *testing.T) {
+	key, _ := cryptox.GenerateKey()
+	otherKey, _ := cryptox.GenerateKey()
+	dir := t.TempDir()
+
+	sealed := filepath.Join(dir, "sealed.enc")
+	if err := Seal(sealed, key, map[string]int{"port": 5432}); err != nil {
+		t.Fatal(err)
+	}
+
+	garbage := filepath.Join(dir, "garbage.enc")
+	if err := os.WriteFile(garbage, []byte("not base64!"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		key     []byte
+		wantErr error
+	}{
+		{name: "fail - wrong key", path: sealed, key: otherKey, wantErr: cryptox.ErrDecrypt},
+		{name: "fail - not a map of strings", path: sealed, key: key},
+		{name: "fail - not base64", path: garbage, key: key},
+		{name: "fail - missing file", path: filepath.Join(dir, "missing.enc"), key: key, wantErr: os.ErrNotExist},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadEncrypted(tt.path, tt.key)
+			if err == nil {
+				t.Fatalf("LoadEncrypted() expected error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("LoadEncrypted() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := Seal(filepath.Join(dir, "bad.enc"), []byte("short"), "x"); err == nil {
+		t.Errorf("Seal() expected error for an invalid key")
+	}
+
+	if err := Seal(filepath.Join(dir, "bad.enc"), key, func() {}); err == nil {
+		t.Errorf("Seal() expected error for an unencodable value")
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Package set defines a generic set type with set algebra.
+*/
+package set
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/kashifkhan0771/utils/slice"
+)
+
+// Set is an unordered collection of unique elements. The zero value is an empty set ready to use.
+// A Set is not safe for concurrent use.
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+// New creates a set holding the given elements.
+func New[T comparable](items ...T) *Set[T] {
+	s := &Set[T]{items: make(map[T]struct{}, len(items))}
+	s.Add(items...)
+
+	return s
+}
+
+// Add adds the elements to the set.
+func (s *Set[T]) Add(items ...T) {
+	if s.items == nil {
+		s.items = make(map[T]struct{}, len(items))
+	}
+
+	for _, item := range items {
+		s.items[item] = struct{}{}
+	}
+}
+
+// Remove removes the elements from the set.
+func (s *Set[T]) Remove(items ...T) {
+	for _, item := range items {
+		delete(s.items, item)
+	}
+}
+
+// Contains reports whether the element is in the set.
+func (s *Set[T]) Contains(item T) bool {
+	_, ok := s.items[item]
+	return ok
+}
+
+// Len returns the number of elements.
+func (s *Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Clear removes every element.
+func (s *Set[T]) Clear() {
+	s.items = nil
+}
+
+// Items returns the elements in no particular order.
+func (s *Set[T]) Items() []T {
+	items := make([]T, 0, len(s.items))
+	for item := range s.items {
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// SortedFunc returns the elements sorted by less.
+func (s *Set[T]) SortedFunc(less func(a, b T) bool) []T {
+	items := s.Items()
+	sort.Slice(items, func(i, j int) bool { return less(items[i], items[j]) })
+
+	return items
+}
+
+// Each calls fn for every element in no particular order, until fn returns false.
+func (s *Set[T]) Each(fn func(item T) bool) {
+	for item := range s.items {
+		if !fn(item) {
+			return
+		}
+	}
+}
+
+// Clone returns a copy of the set.
+func (s *Set[T]) Clone() *Set[T] {
+	clone := &Set[T]{items: make(map[T]struct{}, len(s.items))}
+	for item := range s.items {
+		clone.items[item] = struct{}{}
+	}
+
+	return clone
+}
+
+// Union returns a new set with the elements present in either set.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	union := s.Clone()
+	for item := range other.items {
+		union.items[item] = struct{}{}
+	}
+
+	return union
+}
+
+// Intersect returns a new set with the elements present in both sets.
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	small, large := s, other
+	if small.Len() > large.Len() {
+		small, large = large, small
+	}
+
+	intersection := New[T]()
+	for item := range small.items {
+		if large.Contains(item) {
+			intersection.items[item] = struct{}{}
+		}
+	}
+
+	return intersection
+}
+
+// Difference returns a new set with the elements of s that are not in other.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	difference := New[T]()
+	for item := range s.items {
+		if !other.Contains(item) {
+			difference.items[item] = struct{}{}
+		}
+	}
+
+	return difference
+}
+
+// SymmetricDifference returns a new set with the elements present in only one of the sets.
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	difference := s.Difference(other)
+	for item := range other.items {
+		if !s.Contains(item) {
+			difference.items[item] = struct{}{}
+		}
+	}
+
+	return difference
+}
+
+// Equal reports whether both sets hold the same elements.
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+// IsSubset reports whether every element of s is in other.
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+
+	for item := range s.items {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuperset reports whether every element of other is in s.
+func (s *Set[T]) IsSuperset(other *Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// String returns the elements formatted like a slice, in no particular order.
+func (s *Set[T]) String() string {
+	return fmt.Sprint(s.Items())
+}
+
+// MarshalJSON encodes the set as a JSON array. The elements are sorted by their encoding,
+// so equal sets always produce the same output.
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	encoded := make([][]byte, 0, len(s.items))
+	for item := range s.items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal set element: %w", err)
+		}
+
+		encoded = append(encoded, data)
+	}
+
+	sort.Slice(encoded, func(i, j int) bool { return bytes.Compare(encoded[i], encoded[j]) < 0 })
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	buf.Write(bytes.Join(encoded, []byte{','}))
+	buf.WriteByte(']')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON array into the set, replacing its elements. Duplicates are merged.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return fmt.Errorf("failed to unmarshal set: %w", err)
+	}
+
+	s.items = make(map[T]struct{}, len(items))
+	s.Add(items...)
+
+	return nil
+}
+
+// Sorted returns the elements of the set in ascending order.
+func Sorted[T slice.Ordered](s *Set[T]) []T {
+	return s.SortedFunc(func(a, b T) bool { return a < b })
+}
@@ -0,0 +1,16 @@
+//go:build go1.23
+
+package set
+
+import "iter"
+
+// All returns a sequence yielding the elements of the set in no particular order.
+func (s *Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range s.items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
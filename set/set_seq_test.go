@@ -0,0 +1,32 @@
+//go:build go1.23
+
+package set
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	s := New(3, 1, 2)
+
+	var got []int
+	for item := range s.All() {
+		got = append(got, item)
+	}
+	sort.Ints(got)
+
+	if len(got) != 3 || got[0] != 1 || got[2] != 3 {
+		t.Errorf("All() = %v, want [1 2 3]", got)
+	}
+
+	count := 0
+	for range s.All() {
+		count++
+		break
+	}
+
+	if count != 1 {
+		t.Errorf("All() yielded %v times after break, want %v", count, 1)
+	}
+}
@@ -0,0 +1,153 @@
+package set
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSetBasics(t *testing.T) {
+	var s Set[string]
+	if s.Contains("a") || s.Len() != 0 {
+		t.Errorf("zero value is not an empty set")
+	}
+
+	s.Add("a", "b", "a")
+	if s.Len() != 2 || !s.Contains("a") || !s.Contains("b") {
+		t.Errorf("Add() = %v, want [a b]", Sorted(&s))
+	}
+
+	s.Remove("a", "missing")
+	if s.Contains("a") || s.Len() != 1 {
+		t.Errorf("Remove() = %v, want [b]", Sorted(&s))
+	}
+
+	s.Clear()
+	if s.Len() != 0 {
+		t.Errorf("Clear() left %v", s.Items())
+	}
+
+	s.Add("c")
+	if !s.Contains("c") {
+		t.Errorf("Add() after Clear() = %v, want [c]", s.Items())
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a := New(1, 2, 3)
+	b := New(2, 3, 4)
+
+	tests := []struct {
+		name string
+		got  *Set[int]
+		want []int
+	}{
+		{name: "success - union", got: a.Union(b), want: []int{1, 2, 3, 4}},
+		{name: "success - intersect", got: a.Intersect(b), want: []int{2, 3}},
+		{name: "success - difference", got: a.Difference(b), want: []int{1}},
+		{name: "success - symmetric difference", got: a.SymmetricDifference(b), want: []int{1, 4}},
+		{name: "success - empty intersection", got: a.Intersect(New(9)), want: []int{}},
+		{name: "success - clone", got: a.Clone(), want: []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sorted(tt.got); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := Sorted(a); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("operations modified the receiver: %v", got)
+	}
+}
+
+func TestSetComparison(t *testing.T) {
+	tests := []struct {
+		name         string
+		a, b         *Set[int]
+		wantEqual    bool
+		wantSubset   bool
+		wantSuperset bool
+	}{
+		{name: "success - equal", a: New(1, 2), b: New(2, 1), wantEqual: true, wantSubset: true, wantSuperset: true},
+		{name: "success - subset", a: New(1), b: New(1, 2), wantSubset: true},
+		{name: "success - superset", a: New(1, 2), b: New(2), wantSuperset: true},
+		{name: "success - empty sets", a: New[int](), b: &Set[int]{}, wantEqual: true, wantSubset: true, wantSuperset: true},
+		{name: "fail - disjoint", a: New(1), b: New(2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Equal(tt.b); got != tt.wantEqual {
+				t.Errorf("Equal() = %v, want %v", got, tt.wantEqual)
+			}
+
+			if got := tt.a.IsSubset(tt.b); got != tt.wantSubset {
+				t.Errorf("IsSubset() = %v, want %v", got, tt.wantSubset)
+			}
+
+			if got := tt.a.IsSuperset(tt.b); got != tt.wantSuperset {
+				t.Errorf("IsSuperset() = %v, want %v", got, tt.wantSuperset)
+			}
+		})
+	}
+}
+
+func TestSetIteration(t *testing.T) {
+	s := New("pear", "apple", "fig")
+
+	if got, want := Sorted(s), []string{"apple", "fig", "pear"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sorted() = %v, want %v", got, want)
+	}
+
+	byLength := s.SortedFunc(func(a, b string) bool { return len(a) < len(b) })
+	if got, want := byLength, []string{"fig", "pear", "apple"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedFunc() = %v, want %v", got, want)
+	}
+
+	count := 0
+	s.Each(func(string) bool {
+		count++
+		return false
+	})
+	if count != 1 {
+		t.Errorf("Each() called fn %v times after it returned false, want %v", count, 1)
+	}
+}
+
+func TestSetJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []string
+		wantErr bool
+	}{
+		{name: "success - array", input: `["b","a","b"]`, want: []string{"a", "b"}},
+		{name: "success - empty array", input: `[]`, want: []string{}},
+		{name: "fail - not an array", input: `{"a":1}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New("old")
+			err := json.Unmarshal([]byte(tt.input), s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err == nil && !reflect.DeepEqual(Sorted(s), tt.want) {
+				t.Errorf("Unmarshal() = %v, want %v", Sorted(s), tt.want)
+			}
+		})
+	}
+
+	data, err := json.Marshal(struct {
+		Tags *Set[string] `json:"tags"`
+	}{New("go", "cache", "api")})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	if want := `{"tags":["api","cache","go"]}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Package single defines a typed singleflight to deduplicate concurrent calls.
+*/
+package single
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/kashifkhan0771/utils/ctxutils"
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+// Options contains options to configure a Group.
+type Options struct {
+	TTL   time.Duration // How long successful results are reused after the call, not at all if zero
+	Clock timex.Clock   // Clock measuring the TTL, the real clock by default
+}
+
+// Group deduplicates concurrent calls with the same key. The zero value is ready to use without caching.
+type Group[K comparable, V any] struct {
+	opts      Options
+	mu        sync.Mutex
+	calls     map[K]*call[V]
+	nextSweep time.Time // when Do next drops the expired results of other keys
+}
+
+type call[V any] struct {
+	done    chan struct{}
+	value   V
+	err     error
+	expires time.Time
+}
+
+// New returns a Group configured with opts.
+func New[K comparable, V any](opts Options) *Group[K, V] {
+	return &Group[K, V]{opts: opts}
+}
+
+// Do calls fn once for all the concurrent callers of the same key and returns its result to each of them.
+// The shared call does not observe the cancellation of any caller, but a caller whose ctx is done
+// stops waiting and gets the context error. A panic in fn is returned as an error.
+func (g *Group[K, V]) Do(ctx context.Context, key K, fn func(ctx context.Context) (V, error)) (V, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[K]*call[V])
+	}
+	g.sweep()
+
+	c, ok := g.calls[key]
+	if !ok || g.expired(c) {
+		c = &call[V]{done: make(chan struct{})}
+		g.calls[key] = c
+		go g.run(ctxutils.Detach(ctx), key, c, fn)
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-c.done:
+		return c.value, c.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// Forget drops the in-flight call or cached result of key, so the next Do calls fn again.
+func (g *Group[K, V]) Forget(key K) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	delete(g.calls, key)
+}
+
+func (g *Group[K, V]) run(ctx context.Context, key K, c *call[V], fn func(ctx context.Context) (V, error)) {
+	defer func() {
+		if r := recover(); r != nil {
+			c.err = fmt.Errorf("call panicked: %v\n%s", r, debug.Stack())
+		}
+
+		g.mu.Lock()
+		if c.err == nil && g.opts.TTL > 0 {
+			c.expires = g.clock().Now().Add(g.opts.TTL)
+		} else if g.calls[key] == c {
+			delete(g.calls, key)
+		}
+		close(c.done)
+		g.mu.Unlock()
+	}()
+
+	c.value, c.err = fn(ctx)
+}
+
+// sweep drops the expired results at most once per TTL, so keys never requested again
+// do not stay in calls while each Do remains amortized constant time. The caller must hold g.mu.
+func (g *Group[K, V]) sweep() {
+	if g.opts.TTL <= 0 {
+		return
+	}
+
+	now := g.clock().Now()
+	if now.Before(g.nextSweep) {
+		return
+	}
+
+	for key, c := range g.calls {
+		if g.expired(c) {
+			delete(g.calls, key)
+		}
+	}
+	g.nextSweep = now.Add(g.opts.TTL)
+}
+
+// expired reports whether c is a cached result past its TTL, the caller must hold g.mu.
+func (g *Group[K, V]) expired(c *call[V]) bool {
+	select {
+	case <-c.done:
+		return !g.clock().Now().Before(c.expires)
+	default:
+		return false
+	}
+}
+
+func (g *Group[K, V]) clock() timex.Clock {
+	if g.opts.Clock == nil {
+		return timex.RealClock{}
+	}
+
+	return g.opts.Clock
+}
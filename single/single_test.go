@@ -0,0 +1,177 @@
+package single
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+var errTest = errors.New("test error")
+
+func TestGroupDeduplicates(t *testing.T) {
+	var g Group[string, int]
+	var calls int64
+	release := make(chan struct{})
+
+	fn := func(ctx context.Context) (int, error) {
+		atomic.AddInt64(&calls, 1)
+		<-release
+		return 42, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]int, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = g.Do(context.Background(), "key", fn)
+		}(i)
+	}
+
+	// let the callers join the call before releasing it
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("fn calls = %v, want %v", calls, 1)
+	}
+
+	for _, got := range results {
+		if got != 42 {
+			t.Errorf("Do() = %v, want %v", got, 42)
+		}
+	}
+
+	// without a TTL the next call runs fn again
+	release = make(chan struct{})
+	close(release)
+	_, _ = g.Do(context.Background(), "key", fn)
+	if calls != 2 {
+		t.Errorf("fn calls = %v, want %v", calls, 2)
+	}
+}
+
+func TestGroupCallerCancellation(t *testing.T) {
+	var g Group[string, string]
+	release := make(chan struct{})
+	sharedErr := make(chan error, 2)
+
+	fn := func(ctx context.Context) (string, error) {
+		<-release
+		sharedErr <- ctx.Err()
+		return "done", nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelled := make(chan error, 1)
+	go func() {
+		_, err := g.Do(ctx, "key", fn)
+		cancelled <- err
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	if err := <-cancelled; !errors.Is(err, context.Canceled) {
+		t.Errorf("Do() error = %v, want %v", err, context.Canceled)
+	}
+
+	done := make(chan string, 1)
+	go func() {
+		v, _ := g.Do(context.Background(), "key", fn)
+		done <- v
+	}()
+
+	close(release)
+	if got := <-done; got != "done" {
+		t.Errorf("Do() = %v, want %v", got, "done")
+	}
+
+	if err := <-sharedErr; err != nil {
+		t.Errorf("shared call context error = %v, want nil", err)
+	}
+}
+
+func TestGroupTTL(t *testing.T) {
+	clock := timex.NewFakeClock(time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC))
+	g := New[int, int](Options{TTL: time.Minute, Clock: clock})
+
+	calls := 0
+	fn := func(ctx context.Context) (int, error) {
+		calls++
+		return calls, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		if got, _ := g.Do(context.Background(), 1, fn); got != 1 {
+			t.Errorf("Do() = %v, want the cached %v", got, 1)
+		}
+	}
+
+	clock.Advance(time.Minute)
+	if got, _ := g.Do(context.Background(), 1, fn); got != 2 {
+		t.Errorf("Do() = %v after the TTL, want %v", got, 2)
+	}
+
+	g.Forget(1)
+	if got, _ := g.Do(context.Background(), 1, fn); got != 3 {
+		t.Errorf("Do() = %v after Forget(), want %v", got, 3)
+	}
+}
+
+func TestGroupTTLCleanup(t *testing.T) {
+	clock := timex.NewFakeClock(time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC))
+	g := New[int, int](Options{TTL: time.Minute, Clock: clock})
+
+	fn := func(ctx context.Context) (int, error) { return 1, nil }
+	for key := 0; key < 100; key++ {
+		_, _ = g.Do(context.Background(), key, fn)
+	}
+
+	clock.Advance(time.Minute)
+	_, _ = g.Do(context.Background(), -1, fn)
+
+	g.mu.Lock()
+	size := len(g.calls)
+	g.mu.Unlock()
+
+	if size != 1 {
+		t.Errorf("len(calls) = %v after the TTL, want %v", size, 1)
+	}
+}
+
+func TestGroupErrors(t *testing.T) {
+	g := New[string, int](Options{TTL: time.Hour})
+
+	calls := 0
+	_, err := g.Do(context.Background(), "key", func(ctx context.Context) (int, error) {
+		calls++
+		return 0, errTest
+	})
+	if err != errTest {
+		t.Errorf("Do() error = %v, want %v", err, errTest)
+	}
+
+	// errors are not cached
+	_, _ = g.Do(context.Background(), "key", func(ctx context.Context) (int, error) {
+		calls++
+		return 0, nil
+	})
+	if calls != 2 {
+		t.Errorf("fn calls = %v, want %v", calls, 2)
+	}
+
+	_, err = g.Do(context.Background(), "panic", func(ctx context.Context) (int, error) {
+		panic("boom")
+	})
+	if err == nil || !strings.Contains(err.Error(), "call panicked: boom") {
+		t.Errorf("Do() error = %v, want a recovered panic", err)
+	}
+}
@@ -0,0 +1,58 @@
+package slice
+
+import "fmt"
+
+// Chunk splits the slice into chunks of the given size. The last chunk holds the
+// remaining elements and may be smaller than size.
+// The chunks share the underlying array of the slice.
+func Chunk[T any](slice []T, size int) ([][]T, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("chunk size must be greater than zero: %d", size)
+	}
+
+	chunks := make([][]T, 0, (len(slice)+size-1)/size)
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunks = append(chunks, slice[start:end:end])
+	}
+
+	return chunks, nil
+}
+
+// Partition splits the slice into the elements for which pred returns true and the rest,
+// preserving their order.
+func Partition[T any](slice []T, pred func(T) bool) (matched, rest []T) {
+	matched, rest = make([]T, 0), make([]T, 0)
+	for _, value := range slice {
+		if pred(value) {
+			matched = append(matched, value)
+		} else {
+			rest = append(rest, value)
+		}
+	}
+
+	return matched, rest
+}
+
+// Window returns the sliding windows of the given size over the slice, moving step
+// elements at a time. Only full windows are returned.
+// The windows share the underlying array of the slice.
+func Window[T any](slice []T, size, step int) ([][]T, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("window size must be greater than zero: %d", size)
+	}
+
+	if step <= 0 {
+		return nil, fmt.Errorf("window step must be greater than zero: %d", step)
+	}
+
+	windows := make([][]T, 0)
+	for start := 0; start+size <= len(slice); start += step {
+		windows = append(windows, slice[start:start+size:start+size])
+	}
+
+	return windows, nil
+}
@@ -0,0 +1,161 @@
+package slice
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChunk(t *testing.T) {
+	type args struct {
+		slice []int
+		size  int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    [][]int
+		wantErr bool
+	}{
+		{
+			name: "success - even chunks",
+			args: args{slice: []int{1, 2, 3, 4}, size: 2},
+			want: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name: "success - final partial chunk",
+			args: args{slice: []int{1, 2, 3, 4, 5}, size: 2},
+			want: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name: "success - size larger than slice",
+			args: args{slice: []int{1, 2}, size: 5},
+			want: [][]int{{1, 2}},
+		},
+		{
+			name: "success - empty slice",
+			args: args{slice: []int{}, size: 3},
+			want: [][]int{},
+		},
+		{
+			name:    "fail - zero size",
+			args:    args{slice: []int{1, 2}, size: 0},
+			wantErr: true,
+		},
+		{
+			name:    "fail - negative size",
+			args:    args{slice: []int{1, 2}, size: -1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Chunk(tt.args.slice, tt.args.size)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Chunk() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Chunk() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChunk_AppendDoesNotOverwrite(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+
+	chunks, err := Chunk(input, 2)
+	if err != nil {
+		t.Fatalf("Chunk() error = %v", err)
+	}
+
+	_ = append(chunks[0], 99)
+
+	if input[2] != 3 {
+		t.Errorf("appending to a chunk overwrote the next chunk: %v", input)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       []int
+		wantMatched []int
+		wantRest    []int
+	}{
+		{
+			name:        "success - split even and odd numbers",
+			input:       []int{1, 2, 3, 4, 5},
+			wantMatched: []int{2, 4},
+			wantRest:    []int{1, 3, 5},
+		},
+		{
+			name:        "success - empty slice",
+			input:       []int{},
+			wantMatched: []int{},
+			wantRest:    []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, rest := Partition(tt.input, func(i int) bool { return i%2 == 0 })
+			if !reflect.DeepEqual(matched, tt.wantMatched) || !reflect.DeepEqual(rest, tt.wantRest) {
+				t.Errorf("Partition() = %v, %v, want %v, %v", matched, rest, tt.wantMatched, tt.wantRest)
+			}
+		})
+	}
+}
+
+func TestWindow(t *testing.T) {
+	type args struct {
+		slice []int
+		size  int
+		step  int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    [][]int
+		wantErr bool
+	}{
+		{
+			name: "success - sliding window with step one",
+			args: args{slice: []int{1, 2, 3, 4}, size: 2, step: 1},
+			want: [][]int{{1, 2}, {2, 3}, {3, 4}},
+		},
+		{
+			name: "success - tumbling window",
+			args: args{slice: []int{1, 2, 3, 4, 5}, size: 2, step: 2},
+			want: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name: "success - window larger than slice",
+			args: args{slice: []int{1, 2}, size: 3, step: 1},
+			want: [][]int{},
+		},
+		{
+			name:    "fail - zero size",
+			args:    args{slice: []int{1, 2}, size: 0, step: 1},
+			wantErr: true,
+		},
+		{
+			name:    "fail - zero step",
+			args:    args{slice: []int{1, 2}, size: 1, step: 0},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Window(tt.args.slice, tt.args.size, tt.args.step)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Window() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Window() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
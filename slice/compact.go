@@ -0,0 +1,40 @@
+package slice
+
+// Compact returns a new slice where consecutive runs of equal elements are replaced by a single copy.
+func Compact[T comparable](slice []T) []T {
+	return CompactBy(slice, func(a, b T) bool { return a == b })
+}
+
+// CompactBy returns a new slice where consecutive runs of elements considered equal by eq
+// are replaced by the first element of the run.
+func CompactBy[T any](slice []T, eq func(a, b T) bool) []T {
+	result := make([]T, 0, len(slice))
+	for i, value := range slice {
+		if i > 0 && eq(result[len(result)-1], value) {
+			continue
+		}
+		result = append(result, value)
+	}
+
+	return result
+}
+
+// WithoutZero returns a new slice without the zero values of the slice, like empty strings or zero numbers.
+func WithoutZero[T comparable](slice []T) []T {
+	var zero T
+
+	return Without(slice, zero)
+}
+
+// Without returns a new slice without any of the provided values.
+func Without[T comparable](slice []T, values ...T) []T {
+	exclude := make(map[T]struct{}, len(values))
+	for _, value := range values {
+		exclude[value] = struct{}{}
+	}
+
+	return Filter(slice, func(value T) bool {
+		_, ok := exclude[value]
+		return !ok
+	})
+}
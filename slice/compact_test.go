@@ -0,0 +1,72 @@
+package slice
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCompact(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  []int
+	}{
+		{name: "success - remove consecutive duplicates", input: []int{1, 1, 2, 2, 2, 1, 3, 3}, want: []int{1, 2, 1, 3}},
+		{name: "success - no duplicates", input: []int{1, 2, 3}, want: []int{1, 2, 3}},
+		{name: "success - empty slice", input: []int{}, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Compact(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Compact() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompactBy(t *testing.T) {
+	input := []string{"Go", "go", "GO", "rust", "Go"}
+
+	got := CompactBy(input, strings.EqualFold)
+	if want := []string{"Go", "rust", "Go"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CompactBy() = %v, want %v", got, want)
+	}
+}
+
+func TestWithoutZero(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+		want  []string
+	}{
+		{name: "success - remove empty strings", input: []string{"a", "", "b", ""}, want: []string{"a", "b"}},
+		{name: "success - only empty strings", input: []string{"", ""}, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WithoutZero(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WithoutZero() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithout(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []string
+		values []string
+		want   []string
+	}{
+		{name: "success - remove placeholder values", input: []string{"a", "N/A", "b", "-", "c"}, values: []string{"N/A", "-"}, want: []string{"a", "b", "c"}},
+		{name: "success - no values to remove", input: []string{"a", "b"}, values: nil, want: []string{"a", "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Without(tt.input, tt.values...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Without() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
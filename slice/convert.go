@@ -0,0 +1,32 @@
+package slice
+
+// ToSet returns a set holding the elements of the slice.
+func ToSet[T comparable](slice []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(slice))
+	for _, value := range slice {
+		set[value] = struct{}{}
+	}
+
+	return set
+}
+
+// ToMap builds a map from the slice using key and value to compute each entry.
+// When several elements share the same key, the last one wins.
+func ToMap[T any, K comparable, V any](slice []T, key func(T) K, value func(T) V) map[K]V {
+	result := make(map[K]V, len(slice))
+	for _, element := range slice {
+		result[key(element)] = value(element)
+	}
+
+	return result
+}
+
+// FromMap builds a slice from the entries of the map using f. The order of the result is not specified.
+func FromMap[K comparable, V, T any](m map[K]V, f func(K, V) T) []T {
+	result := make([]T, 0, len(m))
+	for k, v := range m {
+		result = append(result, f(k, v))
+	}
+
+	return result
+}
@@ -0,0 +1,43 @@
+package slice
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func TestToSet(t *testing.T) {
+	got := ToSet([]string{"a", "b", "a"})
+	want := map[string]struct{}{"a": {}, "b": {}}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToSet() = %v, want %v", got, want)
+	}
+}
+
+func TestToMap(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	users := []user{{1, "alice"}, {2, "bob"}, {1, "alice v2"}}
+
+	got := ToMap(users, func(u user) int { return u.ID }, func(u user) string { return u.Name })
+	want := map[int]string{1: "alice v2", 2: "bob"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToMap() = %v, want %v", got, want)
+	}
+}
+
+func TestFromMap(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+
+	got := FromMap(m, func(k string, v int) string { return k + "=" + strconv.Itoa(v) })
+	sort.Strings(got)
+
+	if want := []string{"a=1", "b=2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FromMap() = %v, want %v", got, want)
+	}
+}
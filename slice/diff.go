@@ -0,0 +1,115 @@
+package slice
+
+// EditKind is the kind of an operation of an edit script.
+type EditKind int
+
+const (
+	// EditKeep keeps an element present in both slices
+	EditKeep EditKind = iota
+	// EditInsert inserts an element present only in the new slice
+	EditInsert
+	// EditDelete deletes an element present only in the old slice
+	EditDelete
+)
+
+// String returns the name of the edit kind.
+func (k EditKind) String() string {
+	switch k {
+	case EditKeep:
+		return "keep"
+	case EditInsert:
+		return "insert"
+	case EditDelete:
+		return "delete"
+	default:
+		return "unknown"
+	}
+}
+
+// Edit is an operation of an edit script.
+type Edit[T any] struct {
+	Kind  EditKind
+	Value T
+}
+
+// Diff returns the elements of new that are not in old and the elements of old that are not in new.
+// Repeated elements are matched one to one, so going from [a a] to [a] removes one a.
+func Diff[T comparable](old, new []T) (added, removed []T) {
+	counts := make(map[T]int, len(old))
+	for _, value := range old {
+		counts[value]++
+	}
+
+	added = make([]T, 0)
+	for _, value := range new {
+		if counts[value] > 0 {
+			counts[value]--
+			continue
+		}
+		added = append(added, value)
+	}
+
+	removed = make([]T, 0)
+	for i := len(old) - 1; i >= 0; i-- {
+		if counts[old[i]] > 0 {
+			counts[old[i]]--
+			removed = append(removed, old[i])
+		}
+	}
+	Reverse(removed)
+
+	return added, removed
+}
+
+// EditScript returns the minimal list of keep, insert and delete operations that turn old into new,
+// based on their longest common subsequence. Deletions are listed before insertions at each position.
+// It uses O(len(old) * len(new)) time and memory.
+func EditScript[T comparable](old, new []T) []Edit[T] {
+	n, m := len(old), len(new)
+
+	// lcs[i][j] holds the length of the longest common subsequence of old[i:] and new[j:]
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			switch {
+			case old[i] == new[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	script := make([]Edit[T], 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case old[i] == new[j]:
+			script = append(script, Edit[T]{Kind: EditKeep, Value: old[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			script = append(script, Edit[T]{Kind: EditDelete, Value: old[i]})
+			i++
+		default:
+			script = append(script, Edit[T]{Kind: EditInsert, Value: new[j]})
+			j++
+		}
+	}
+
+	for ; i < n; i++ {
+		script = append(script, Edit[T]{Kind: EditDelete, Value: old[i]})
+	}
+
+	for ; j < m; j++ {
+		script = append(script, Edit[T]{Kind: EditInsert, Value: new[j]})
+	}
+
+	return script
+}
@@ -0,0 +1,122 @@
+package slice
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name        string
+		old         []string
+		new         []string
+		wantAdded   []string
+		wantRemoved []string
+	}{
+		{
+			name:        "success - added and removed elements",
+			old:         []string{"a", "b", "c"},
+			new:         []string{"b", "c", "d"},
+			wantAdded:   []string{"d"},
+			wantRemoved: []string{"a"},
+		},
+		{
+			name:        "success - repeated elements",
+			old:         []string{"a", "a", "b"},
+			new:         []string{"a", "b", "b"},
+			wantAdded:   []string{"b"},
+			wantRemoved: []string{"a"},
+		},
+		{
+			name:        "success - no changes",
+			old:         []string{"a", "b"},
+			new:         []string{"b", "a"},
+			wantAdded:   []string{},
+			wantRemoved: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed := Diff(tt.old, tt.new)
+			if !reflect.DeepEqual(added, tt.wantAdded) || !reflect.DeepEqual(removed, tt.wantRemoved) {
+				t.Errorf("Diff() = %v, %v, want %v, %v", added, removed, tt.wantAdded, tt.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestEditScript(t *testing.T) {
+	keep := func(v string) Edit[string] { return Edit[string]{Kind: EditKeep, Value: v} }
+	ins := func(v string) Edit[string] { return Edit[string]{Kind: EditInsert, Value: v} }
+	del := func(v string) Edit[string] { return Edit[string]{Kind: EditDelete, Value: v} }
+
+	tests := []struct {
+		name string
+		old  []string
+		new  []string
+		want []Edit[string]
+	}{
+		{
+			name: "success - replace middle element",
+			old:  []string{"a", "b", "c"},
+			new:  []string{"a", "x", "c"},
+			want: []Edit[string]{keep("a"), del("b"), ins("x"), keep("c")},
+		},
+		{
+			name: "success - insert at the end",
+			old:  []string{"a"},
+			new:  []string{"a", "b"},
+			want: []Edit[string]{keep("a"), ins("b")},
+		},
+		{
+			name: "success - delete everything",
+			old:  []string{"a", "b"},
+			new:  nil,
+			want: []Edit[string]{del("a"), del("b")},
+		},
+		{
+			name: "success - empty slices",
+			old:  nil,
+			new:  nil,
+			want: []Edit[string]{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EditScript(tt.old, tt.new); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EditScript() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEditScript_Minimal(t *testing.T) {
+	old := []rune("ABCBDAB")
+	new := []rune("BDCABA")
+
+	kept := 0
+	var rebuilt []rune
+	for _, edit := range EditScript(old, new) {
+		if edit.Kind == EditKeep {
+			kept++
+		}
+		if edit.Kind != EditDelete {
+			rebuilt = append(rebuilt, edit.Value)
+		}
+	}
+
+	if string(rebuilt) != string(new) {
+		t.Errorf("EditScript() rebuilt %q, want %q", string(rebuilt), string(new))
+	}
+
+	// the longest common subsequence of both inputs, e.g. "BCBA", has length 4
+	if kept != 4 {
+		t.Errorf("EditScript() kept %d elements, want 4", kept)
+	}
+}
+
+func TestEditKind_String(t *testing.T) {
+	if EditInsert.String() != "insert" || EditDelete.String() != "delete" || EditKeep.String() != "keep" {
+		t.Errorf("EditKind.String() returned unexpected names")
+	}
+}
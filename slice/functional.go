@@ -0,0 +1,79 @@
+package slice
+
+import "fmt"
+
+// Map returns a new slice with the results of applying f to each element of the slice.
+func Map[T, U any](slice []T, f func(T) U) []U {
+	result := make([]U, len(slice))
+	for i, value := range slice {
+		result[i] = f(value)
+	}
+
+	return result
+}
+
+// MapErr returns a new slice with the results of applying f to each element of the slice.
+// It stops on the first error returned by f.
+func MapErr[T, U any](slice []T, f func(T) (U, error)) ([]U, error) {
+	result := make([]U, len(slice))
+	for i, value := range slice {
+		mapped, err := f(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map element at index %d: %w", i, err)
+		}
+		result[i] = mapped
+	}
+
+	return result, nil
+}
+
+// Filter returns a new slice with the elements of the slice for which keep returns true.
+func Filter[T any](slice []T, keep func(T) bool) []T {
+	result := make([]T, 0)
+	for _, value := range slice {
+		if keep(value) {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
+
+// FilterErr returns a new slice with the elements of the slice for which keep returns true.
+// It stops on the first error returned by keep.
+func FilterErr[T any](slice []T, keep func(T) (bool, error)) ([]T, error) {
+	result := make([]T, 0)
+	for i, value := range slice {
+		ok, err := keep(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter element at index %d: %w", i, err)
+		}
+
+		if ok {
+			result = append(result, value)
+		}
+	}
+
+	return result, nil
+}
+
+// Reduce reduces the slice to a single value by applying f to an accumulator,
+// starting with initial, and each element of the slice in order.
+func Reduce[T, U any](slice []T, initial U, f func(U, T) U) U {
+	result := initial
+	for _, value := range slice {
+		result = f(result, value)
+	}
+
+	return result
+}
+
+// FlatMap applies f to each element of the slice and concatenates the resulting slices.
+func FlatMap[T, U any](slice []T, f func(T) []U) []U {
+	result := make([]U, 0, len(slice))
+	for _, value := range slice {
+		result = append(result, f(value)...)
+	}
+
+	return result
+}
@@ -0,0 +1,191 @@
+package slice
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  []string
+	}{
+		{
+			name:  "success - map integers to strings",
+			input: []int{1, 2, 3},
+			want:  []string{"1", "2", "3"},
+		},
+		{
+			name:  "success - map empty slice",
+			input: []int{},
+			want:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Map(tt.input, strconv.Itoa); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Map() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapErr(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []string
+		want    []int
+		wantErr bool
+	}{
+		{
+			name:  "success - parse all integers",
+			input: []string{"1", "2", "3"},
+			want:  []int{1, 2, 3},
+		},
+		{
+			name:    "fail - stop on invalid integer",
+			input:   []string{"1", "two", "3"},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MapErr(tt.input, strconv.Atoi)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MapErr() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MapErr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapErr_StopsOnFirstError(t *testing.T) {
+	calls := 0
+	errBoom := errors.New("boom")
+
+	_, err := MapErr([]int{1, 2, 3}, func(i int) (int, error) {
+		calls++
+		if i == 2 {
+			return 0, errBoom
+		}
+		return i, nil
+	})
+
+	if !errors.Is(err, errBoom) {
+		t.Errorf("MapErr() error = %v, want %v", err, errBoom)
+	}
+
+	if calls != 2 {
+		t.Errorf("MapErr() called f %d times, want 2", calls)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  []int
+	}{
+		{
+			name:  "success - keep even numbers",
+			input: []int{1, 2, 3, 4, 5, 6},
+			want:  []int{2, 4, 6},
+		},
+		{
+			name:  "success - nothing to keep",
+			input: []int{1, 3, 5},
+			want:  []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Filter(tt.input, func(i int) bool { return i%2 == 0 }); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Filter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterErr(t *testing.T) {
+	keepPositive := func(s string) (bool, error) {
+		n, err := strconv.Atoi(s)
+		return n > 0, err
+	}
+
+	got, err := FilterErr([]string{"1", "-2", "3"}, keepPositive)
+	if err != nil {
+		t.Fatalf("FilterErr() error = %v", err)
+	}
+
+	if want := []string{"1", "3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterErr() = %v, want %v", got, want)
+	}
+
+	if _, err := FilterErr([]string{"1", "x"}, keepPositive); err == nil {
+		t.Errorf("FilterErr() expected error for invalid input")
+	}
+}
+
+func TestReduce(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []int
+		initial int
+		want    int
+	}{
+		{
+			name:    "success - sum integers",
+			input:   []int{1, 2, 3, 4},
+			initial: 0,
+			want:    10,
+		},
+		{
+			name:    "success - empty slice returns initial value",
+			input:   []int{},
+			initial: 42,
+			want:    42,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Reduce(tt.input, tt.initial, func(acc, i int) int { return acc + i }); got != tt.want {
+				t.Errorf("Reduce() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+		want  []string
+	}{
+		{
+			name:  "success - split and flatten",
+			input: []string{"a,b", "c", "d,e"},
+			want:  []string{"a", "b", "c", "d", "e"},
+		},
+		{
+			name:  "success - flatten empty slice",
+			input: []string{},
+			want:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FlatMap(tt.input, func(s string) []string { return strings.Split(s, ",") }); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FlatMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
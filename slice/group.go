@@ -0,0 +1,50 @@
+package slice
+
+import "fmt"
+
+// GroupBy groups the elements of the slice by the key returned by key, preserving their order within each group.
+func GroupBy[T any, K comparable](slice []T, key func(T) K) map[K][]T {
+	result := make(map[K][]T)
+	for _, value := range slice {
+		k := key(value)
+		result[k] = append(result[k], value)
+	}
+
+	return result
+}
+
+// CountBy counts the elements of the slice by the key returned by key.
+func CountBy[T any, K comparable](slice []T, key func(T) K) map[K]int {
+	result := make(map[K]int)
+	for _, value := range slice {
+		result[key(value)]++
+	}
+
+	return result
+}
+
+// IndexBy indexes the elements of the slice by the key returned by key.
+// When several elements share the same key, the last one wins.
+func IndexBy[T any, K comparable](slice []T, key func(T) K) map[K]T {
+	result := make(map[K]T, len(slice))
+	for _, value := range slice {
+		result[key(value)] = value
+	}
+
+	return result
+}
+
+// IndexByUnique indexes the elements of the slice by the key returned by key.
+// It returns an error when several elements share the same key.
+func IndexByUnique[T any, K comparable](slice []T, key func(T) K) (map[K]T, error) {
+	result := make(map[K]T, len(slice))
+	for i, value := range slice {
+		k := key(value)
+		if _, ok := result[k]; ok {
+			return nil, fmt.Errorf("duplicate key %v for element at index %d", k, i)
+		}
+		result[k] = value
+	}
+
+	return result, nil
+}
@@ -0,0 +1,124 @@
+package slice
+
+import (
+	"reflect"
+	"testing"
+)
+
+type person struct {
+	Name string
+	City string
+}
+
+var people = []person{
+	{Name: "alice", City: "lisbon"},
+	{Name: "bob", City: "porto"},
+	{Name: "carol", City: "lisbon"},
+}
+
+func TestGroupBy(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []person
+		want  map[string][]person
+	}{
+		{
+			name:  "success - group people by city",
+			input: people,
+			want: map[string][]person{
+				"lisbon": {{Name: "alice", City: "lisbon"}, {Name: "carol", City: "lisbon"}},
+				"porto":  {{Name: "bob", City: "porto"}},
+			},
+		},
+		{
+			name:  "success - empty slice",
+			input: []person{},
+			want:  map[string][]person{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GroupBy(tt.input, func(p person) string { return p.City }); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GroupBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	got := CountBy(people, func(p person) string { return p.City })
+	want := map[string]int{"lisbon": 2, "porto": 1}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CountBy() = %v, want %v", got, want)
+	}
+}
+
+func TestIndexBy(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []person
+		key   func(person) string
+		want  map[string]person
+	}{
+		{
+			name:  "success - index by unique name",
+			input: people,
+			key:   func(p person) string { return p.Name },
+			want: map[string]person{
+				"alice": {Name: "alice", City: "lisbon"},
+				"bob":   {Name: "bob", City: "porto"},
+				"carol": {Name: "carol", City: "lisbon"},
+			},
+		},
+		{
+			name:  "success - last element wins on duplicate key",
+			input: people,
+			key:   func(p person) string { return p.City },
+			want: map[string]person{
+				"lisbon": {Name: "carol", City: "lisbon"},
+				"porto":  {Name: "bob", City: "porto"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IndexBy(tt.input, tt.key); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IndexBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexByUnique(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     func(person) string
+		wantLen int
+		wantErr bool
+	}{
+		{
+			name:    "success - index by unique name",
+			key:     func(p person) string { return p.Name },
+			wantLen: 3,
+		},
+		{
+			name:    "fail - duplicate city",
+			key:     func(p person) string { return p.City },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IndexByUnique(people, tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IndexByUnique() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if len(got) != tt.wantLen {
+				t.Errorf("IndexByUnique() len = %v, want %v", len(got), tt.wantLen)
+			}
+		})
+	}
+}
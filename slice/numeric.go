@@ -0,0 +1,66 @@
+package slice
+
+import "fmt"
+
+// Sum returns the sum of the elements of the slice.
+func Sum[T Number](slice []T) T {
+	var sum T
+	for _, value := range slice {
+		sum += value
+	}
+
+	return sum
+}
+
+// SumBy returns the sum of the values returned by f for each element of the slice.
+func SumBy[T any, N Number](slice []T, f func(T) N) N {
+	var sum N
+	for _, value := range slice {
+		sum += f(value)
+	}
+
+	return sum
+}
+
+// Product returns the product of the elements of the slice. The product of an empty slice is 1.
+func Product[T Number](slice []T) T {
+	product := T(1)
+	for _, value := range slice {
+		product *= value
+	}
+
+	return product
+}
+
+// Average returns the arithmetic mean of the elements of the slice.
+func Average[T Number](slice []T) (float64, error) {
+	if len(slice) == 0 {
+		return 0, fmt.Errorf("cannot compute average of empty slice")
+	}
+
+	var sum float64
+	for _, value := range slice {
+		sum += float64(value)
+	}
+
+	return sum / float64(len(slice)), nil
+}
+
+// MinMax returns the smallest and the greatest elements of the slice.
+func MinMax[T Ordered](slice []T) (min, max T, err error) {
+	if len(slice) == 0 {
+		return min, max, fmt.Errorf("cannot find minimum and maximum of empty slice")
+	}
+
+	min, max = slice[0], slice[0]
+	for _, value := range slice[1:] {
+		if value < min {
+			min = value
+		}
+		if value > max {
+			max = value
+		}
+	}
+
+	return min, max, nil
+}
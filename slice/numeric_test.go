@@ -0,0 +1,104 @@
+package slice
+
+import "testing"
+
+func TestSum(t *testing.T) {
+	if got := Sum([]int{1, 2, 3, 4}); got != 10 {
+		t.Errorf("Sum() = %v, want %v", got, 10)
+	}
+
+	if got := Sum([]float64{0.5, 0.25}); got != 0.75 {
+		t.Errorf("Sum() = %v, want %v", got, 0.75)
+	}
+
+	if got := Sum([]int{}); got != 0 {
+		t.Errorf("Sum() = %v, want %v", got, 0)
+	}
+}
+
+func TestSumBy(t *testing.T) {
+	type item struct {
+		Price    float64
+		Quantity int
+	}
+	items := []item{{Price: 2.5, Quantity: 2}, {Price: 1, Quantity: 3}}
+
+	if got := SumBy(items, func(i item) float64 { return i.Price * float64(i.Quantity) }); got != 8 {
+		t.Errorf("SumBy() = %v, want %v", got, 8)
+	}
+
+	if got := SumBy(items, func(i item) int { return i.Quantity }); got != 5 {
+		t.Errorf("SumBy() = %v, want %v", got, 5)
+	}
+}
+
+func TestProduct(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  int
+	}{
+		{name: "success - product of integers", input: []int{1, 2, 3, 4}, want: 24},
+		{name: "success - product with zero", input: []int{5, 0, 2}, want: 0},
+		{name: "success - empty slice", input: []int{}, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Product(tt.input); got != tt.want {
+				t.Errorf("Product() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAverage(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []int
+		want    float64
+		wantErr bool
+	}{
+		{name: "success - average of integers", input: []int{1, 2, 3, 4}, want: 2.5},
+		{name: "fail - empty slice", input: []int{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Average(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Average() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("Average() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []float64
+		wantMin float64
+		wantMax float64
+		wantErr bool
+	}{
+		{name: "success - min and max", input: []float64{3, -1.5, 7, 2}, wantMin: -1.5, wantMax: 7},
+		{name: "success - single element", input: []float64{4}, wantMin: 4, wantMax: 4},
+		{name: "fail - empty slice", input: []float64{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			min, max, err := MinMax(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MinMax() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if min != tt.wantMin || max != tt.wantMax {
+				t.Errorf("MinMax() = %v, %v, want %v, %v", min, max, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package slice
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const cursorPrefix = "offset:"
+
+// PageInfo describes a page returned by Page.
+type PageInfo struct {
+	Page       int  // Current page, starting at 1
+	PerPage    int  // Maximum number of elements per page
+	Total      int  // Total number of elements
+	TotalPages int  // Total number of pages
+	HasNext    bool // Whether there is a page after the current one
+	HasPrev    bool // Whether there is a page before the current one
+}
+
+// CursorInfo describes a page returned by PageByCursor.
+type CursorInfo struct {
+	NextCursor string // Opaque cursor of the next page, empty if there is none
+	HasNext    bool   // Whether there is a page after the current one
+	Total      int    // Total number of elements
+}
+
+// Page returns the elements of the given page, starting at 1, with perPage elements per page.
+// Pages beyond the last one return no elements. The page shares the underlying array of the slice.
+func Page[T any](slice []T, page, perPage int) ([]T, PageInfo, error) {
+	if page < 1 {
+		return nil, PageInfo{}, fmt.Errorf("page must be greater than zero: %d", page)
+	}
+
+	if perPage <= 0 {
+		return nil, PageInfo{}, fmt.Errorf("per page must be greater than zero: %d", perPage)
+	}
+
+	total := len(slice)
+	info := PageInfo{
+		Page:       page,
+		PerPage:    perPage,
+		Total:      total,
+		TotalPages: (total + perPage - 1) / perPage,
+		HasPrev:    page > 1,
+	}
+	info.HasNext = page < info.TotalPages
+
+	start := (page - 1) * perPage
+	if start >= total || start < 0 {
+		return []T{}, info, nil
+	}
+
+	end := start + perPage
+	if end > total {
+		end = total
+	}
+
+	return slice[start:end:end], info, nil
+}
+
+// PageByCursor returns up to limit elements starting at the position encoded in the cursor.
+// An empty cursor starts at the beginning of the slice. The returned CursorInfo holds the
+// opaque cursor to request the next page.
+func PageByCursor[T any](slice []T, cursor string, limit int) ([]T, CursorInfo, error) {
+	if limit <= 0 {
+		return nil, CursorInfo{}, fmt.Errorf("limit must be greater than zero: %d", limit)
+	}
+
+	start := 0
+	if cursor != "" {
+		offset, err := decodeCursor(cursor)
+		if err != nil {
+			return nil, CursorInfo{}, err
+		}
+		start = offset
+	}
+
+	info := CursorInfo{Total: len(slice)}
+	if start >= len(slice) {
+		return []T{}, info, nil
+	}
+
+	end := start + limit
+	if end > len(slice) {
+		end = len(slice)
+	}
+
+	if end < len(slice) {
+		info.HasNext = true
+		info.NextCursor = encodeCursor(end)
+	}
+
+	return slice[start:end:end], info, nil
+}
+
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cursor %q: %w", cursor, err)
+	}
+
+	value := string(decoded)
+	if !strings.HasPrefix(value, cursorPrefix) {
+		return 0, fmt.Errorf("invalid cursor %q", cursor)
+	}
+
+	offset, err := strconv.Atoi(strings.TrimPrefix(value, cursorPrefix))
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("invalid cursor %q", cursor)
+	}
+
+	return offset, nil
+}
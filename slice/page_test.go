@@ -0,0 +1,121 @@
+package slice
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPage(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6, 7}
+
+	type args struct {
+		page    int
+		perPage int
+	}
+	tests := []struct {
+		name     string
+		args     args
+		want     []int
+		wantInfo PageInfo
+		wantErr  bool
+	}{
+		{
+			name:     "success - first page",
+			args:     args{page: 1, perPage: 3},
+			want:     []int{1, 2, 3},
+			wantInfo: PageInfo{Page: 1, PerPage: 3, Total: 7, TotalPages: 3, HasNext: true, HasPrev: false},
+		},
+		{
+			name:     "success - last partial page",
+			args:     args{page: 3, perPage: 3},
+			want:     []int{7},
+			wantInfo: PageInfo{Page: 3, PerPage: 3, Total: 7, TotalPages: 3, HasNext: false, HasPrev: true},
+		},
+		{
+			name:     "success - page beyond the last one",
+			args:     args{page: 5, perPage: 3},
+			want:     []int{},
+			wantInfo: PageInfo{Page: 5, PerPage: 3, Total: 7, TotalPages: 3, HasNext: false, HasPrev: true},
+		},
+		{
+			name:    "fail - zero page",
+			args:    args{page: 0, perPage: 3},
+			wantErr: true,
+		},
+		{
+			name:    "fail - zero per page",
+			args:    args{page: 1, perPage: 0},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, info, err := Page(input, tt.args.page, tt.args.perPage)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Page() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Page() = %v, want %v", got, tt.want)
+			}
+
+			if info != tt.wantInfo {
+				t.Errorf("Page() info = %+v, want %+v", info, tt.wantInfo)
+			}
+		})
+	}
+}
+
+func TestPageByCursor(t *testing.T) {
+	input := []string{"a", "b", "c", "d", "e"}
+
+	var pages [][]string
+	cursor := ""
+	for {
+		page, info, err := PageByCursor(input, cursor, 2)
+		if err != nil {
+			t.Fatalf("PageByCursor() error = %v", err)
+		}
+
+		if info.Total != len(input) {
+			t.Errorf("PageByCursor() total = %v, want %v", info.Total, len(input))
+		}
+
+		pages = append(pages, page)
+		if !info.HasNext {
+			if info.NextCursor != "" {
+				t.Errorf("PageByCursor() next cursor = %q on last page", info.NextCursor)
+			}
+			break
+		}
+		cursor = info.NextCursor
+	}
+
+	if want := [][]string{{"a", "b"}, {"c", "d"}, {"e"}}; !reflect.DeepEqual(pages, want) {
+		t.Errorf("PageByCursor() pages = %v, want %v", pages, want)
+	}
+}
+
+func TestPageByCursor_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+		limit  int
+	}{
+		{name: "fail - zero limit", cursor: "", limit: 0},
+		{name: "fail - cursor is not base64", cursor: "not a cursor!", limit: 2},
+		{name: "fail - cursor with unknown content", cursor: "aGVsbG8", limit: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := PageByCursor([]int{1, 2, 3}, tt.cursor, tt.limit); err == nil {
+				t.Errorf("PageByCursor() expected error")
+			}
+		})
+	}
+}
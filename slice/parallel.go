@@ -0,0 +1,88 @@
+package slice
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ParallelMap applies f to each element of the slice using up to workers goroutines and
+// returns the results in the same order as the input.
+// The first error, or panic, returned by f cancels the context passed to the remaining calls
+// and is returned once all the running calls have finished.
+func ParallelMap[T, U any](ctx context.Context, slice []T, workers int, f func(context.Context, T) (U, error)) ([]U, error) {
+	if workers <= 0 {
+		return nil, fmt.Errorf("workers must be greater than zero: %d", workers)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		once     sync.Once
+		firstErr error
+		wg       sync.WaitGroup
+	)
+
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	result := make([]U, len(slice))
+	indexes := make(chan int)
+
+	if workers > len(slice) {
+		workers = len(slice)
+	}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				value, err := safeCall(ctx, slice[i], f)
+				if err != nil {
+					fail(fmt.Errorf("failed to map element at index %d: %w", i, err))
+					continue
+				}
+				result[i] = value
+			}
+		}()
+	}
+
+feed:
+	for i := range slice {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	// the parent context may have been cancelled while feeding the workers
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// safeCall calls f and converts a panic into an error.
+func safeCall[T, U any](ctx context.Context, value T, f func(context.Context, T) (U, error)) (result U, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return f(ctx, value)
+}
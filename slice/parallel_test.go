@@ -0,0 +1,113 @@
+package slice
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallelMap(t *testing.T) {
+	input := make([]int, 100)
+	for i := range input {
+		input[i] = i
+	}
+
+	got, err := ParallelMap(context.Background(), input, 8, func(_ context.Context, i int) (int, error) {
+		return i * 2, nil
+	})
+	if err != nil {
+		t.Fatalf("ParallelMap() error = %v", err)
+	}
+
+	want := Map(input, func(i int) int { return i * 2 })
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParallelMap() = %v, want %v", got, want)
+	}
+}
+
+func TestParallelMap_BoundedWorkers(t *testing.T) {
+	var running, maxRunning int32
+
+	_, err := ParallelMap(context.Background(), make([]int, 50), 3, func(_ context.Context, _ int) (int, error) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return 0, nil
+	})
+	if err != nil {
+		t.Fatalf("ParallelMap() error = %v", err)
+	}
+
+	if maxRunning > 3 {
+		t.Errorf("ParallelMap() ran %d calls concurrently, want at most 3", maxRunning)
+	}
+}
+
+func TestParallelMap_FirstErrorCancels(t *testing.T) {
+	errBoom := errors.New("boom")
+	var calls int32
+
+	_, err := ParallelMap(context.Background(), make([]int, 1000), 4, func(ctx context.Context, _ int) (int, error) {
+		if atomic.AddInt32(&calls, 1) == 5 {
+			return 0, errBoom
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(time.Millisecond):
+			return 0, nil
+		}
+	})
+
+	if !errors.Is(err, errBoom) {
+		t.Errorf("ParallelMap() error = %v, want %v", err, errBoom)
+	}
+
+	if calls >= 1000 {
+		t.Errorf("ParallelMap() did not stop after the first error, %d calls", calls)
+	}
+}
+
+func TestParallelMap_RecoversPanic(t *testing.T) {
+	_, err := ParallelMap(context.Background(), []int{1, 2, 3}, 2, func(_ context.Context, i int) (int, error) {
+		if i == 2 {
+			panic("something went wrong")
+		}
+		return i, nil
+	})
+
+	if err == nil {
+		t.Errorf("ParallelMap() expected error from panic")
+	}
+}
+
+func TestParallelMap_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ParallelMap(ctx, []int{1, 2, 3}, 2, func(ctx context.Context, i int) (int, error) {
+		return i, nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ParallelMap() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestParallelMap_InvalidWorkers(t *testing.T) {
+	if _, err := ParallelMap(context.Background(), []int{1}, 0, func(_ context.Context, i int) (int, error) {
+		return i, nil
+	}); err == nil {
+		t.Errorf("ParallelMap() expected error for zero workers")
+	}
+}
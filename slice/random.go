@@ -0,0 +1,28 @@
+package slice
+
+import (
+	"github.com/kashifkhan0771/utils/rand"
+)
+
+// Pick returns a random element of the slice.
+// It uses the rand package, so it shares its source of randomness.
+func Pick[T any](slice []T) (T, error) {
+	return rand.Pick(slice)
+}
+
+// Sample returns n distinct elements picked at random from the slice.
+// It uses the rand package, so it shares its source of randomness.
+func Sample[T any](slice []T, n int) ([]T, error) {
+	return rand.Sample(slice, n)
+}
+
+// Shuffled returns a shuffled copy of the slice.
+// It uses the rand package, so it shares its source of randomness.
+func Shuffled[T any](slice []T) ([]T, error) {
+	result := clone(slice)
+	if err := rand.Shuffle(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
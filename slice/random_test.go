@@ -0,0 +1,58 @@
+package slice
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestPick(t *testing.T) {
+	input := []string{"a", "b", "c"}
+
+	got, err := Pick(input)
+	if err != nil {
+		t.Fatalf("Pick() error = %v", err)
+	}
+
+	if _, ok := ToSet(input)[got]; !ok {
+		t.Errorf("Pick() returned value %v not found in slice", got)
+	}
+
+	if _, err := Pick([]string{}); err == nil {
+		t.Errorf("Pick() expected error for empty slice")
+	}
+}
+
+func TestSample(t *testing.T) {
+	got, err := Sample([]int{1, 2, 3, 4, 5}, 2)
+	if err != nil {
+		t.Fatalf("Sample() error = %v", err)
+	}
+
+	if len(got) != 2 || got[0] == got[1] {
+		t.Errorf("Sample() = %v, want 2 distinct elements", got)
+	}
+
+	if _, err := Sample([]int{1}, 2); err == nil {
+		t.Errorf("Sample() expected error when sampling more elements than available")
+	}
+}
+
+func TestShuffled(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5}
+	original := clone(input)
+
+	got, err := Shuffled(input)
+	if err != nil {
+		t.Fatalf("Shuffled() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(input, original) {
+		t.Errorf("Shuffled() modified the input: %v", input)
+	}
+
+	sort.Ints(got)
+	if !reflect.DeepEqual(got, original) {
+		t.Errorf("Shuffled() = %v, want a permutation of %v", got, original)
+	}
+}
@@ -0,0 +1,93 @@
+package slice
+
+import "fmt"
+
+// Reverse reverses the order of the elements of the slice in place.
+func Reverse[T any](slice []T) {
+	for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+		slice[i], slice[j] = slice[j], slice[i]
+	}
+}
+
+// Reversed returns a reversed copy of the slice.
+func Reversed[T any](slice []T) []T {
+	result := clone(slice)
+	Reverse(result)
+
+	return result
+}
+
+// RotateLeft rotates the elements of the slice k positions to the left in place,
+// so the element at index k becomes the first one. Negative values rotate to the right.
+func RotateLeft[T any](slice []T, k int) {
+	n := len(slice)
+	if n == 0 {
+		return
+	}
+
+	k %= n
+	if k < 0 {
+		k += n
+	}
+
+	if k == 0 {
+		return
+	}
+
+	Reverse(slice[:k])
+	Reverse(slice[k:])
+	Reverse(slice)
+}
+
+// RotateRight rotates the elements of the slice k positions to the right in place,
+// so the last k elements become the first ones. Negative values rotate to the left.
+func RotateRight[T any](slice []T, k int) {
+	n := len(slice)
+	if n == 0 {
+		return
+	}
+
+	RotateLeft(slice, n-k%n)
+}
+
+// RotatedLeft returns a copy of the slice rotated k positions to the left.
+func RotatedLeft[T any](slice []T, k int) []T {
+	result := clone(slice)
+	RotateLeft(result, k)
+
+	return result
+}
+
+// RotatedRight returns a copy of the slice rotated k positions to the right.
+func RotatedRight[T any](slice []T, k int) []T {
+	result := clone(slice)
+	RotateRight(result, k)
+
+	return result
+}
+
+// SwapRange swaps in place the n elements starting at index i with the n elements starting at index j.
+// It returns an error if the ranges are out of bounds or overlap.
+func SwapRange[T any](slice []T, i, j, n int) error {
+	if i < 0 || j < 0 || n < 0 || i+n > len(slice) || j+n > len(slice) {
+		return fmt.Errorf("ranges [%d:%d] and [%d:%d] are out of bounds for length %d", i, i+n, j, j+n, len(slice))
+	}
+
+	if i < j+n && j < i+n && n > 0 && i != j {
+		return fmt.Errorf("ranges [%d:%d] and [%d:%d] overlap", i, i+n, j, j+n)
+	}
+
+	for k := 0; k < n; k++ {
+		slice[i+k], slice[j+k] = slice[j+k], slice[i+k]
+	}
+
+	return nil
+}
+
+func clone[T any](slice []T) []T {
+	if slice == nil {
+		return nil
+	}
+
+	return append(make([]T, 0, len(slice)), slice...)
+}
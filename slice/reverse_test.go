@@ -0,0 +1,121 @@
+package slice
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  []int
+	}{
+		{name: "success - odd length", input: []int{1, 2, 3}, want: []int{3, 2, 1}},
+		{name: "success - even length", input: []int{1, 2, 3, 4}, want: []int{4, 3, 2, 1}},
+		{name: "success - empty slice", input: []int{}, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := clone(tt.input)
+
+			if got := Reversed(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Reversed() = %v, want %v", got, tt.want)
+			}
+
+			if !reflect.DeepEqual(tt.input, original) {
+				t.Errorf("Reversed() modified the input: %v", tt.input)
+			}
+
+			Reverse(tt.input)
+			if !reflect.DeepEqual(tt.input, tt.want) {
+				t.Errorf("Reverse() = %v, want %v", tt.input, tt.want)
+			}
+		})
+	}
+}
+
+func TestRotateLeft(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		k     int
+		want  []int
+	}{
+		{name: "success - rotate by two", input: []int{1, 2, 3, 4, 5}, k: 2, want: []int{3, 4, 5, 1, 2}},
+		{name: "success - rotate by length", input: []int{1, 2, 3}, k: 3, want: []int{1, 2, 3}},
+		{name: "success - rotate by more than length", input: []int{1, 2, 3}, k: 4, want: []int{2, 3, 1}},
+		{name: "success - negative rotation", input: []int{1, 2, 3}, k: -1, want: []int{3, 1, 2}},
+		{name: "success - empty slice", input: []int{}, k: 2, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RotatedLeft(tt.input, tt.k); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RotatedLeft() = %v, want %v", got, tt.want)
+			}
+
+			RotateLeft(tt.input, tt.k)
+			if !reflect.DeepEqual(tt.input, tt.want) {
+				t.Errorf("RotateLeft() = %v, want %v", tt.input, tt.want)
+			}
+		})
+	}
+}
+
+func TestRotateRight(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		k     int
+		want  []int
+	}{
+		{name: "success - rotate by two", input: []int{1, 2, 3, 4, 5}, k: 2, want: []int{4, 5, 1, 2, 3}},
+		{name: "success - rotate by more than length", input: []int{1, 2, 3}, k: 4, want: []int{3, 1, 2}},
+		{name: "success - negative rotation", input: []int{1, 2, 3}, k: -1, want: []int{2, 3, 1}},
+		{name: "success - empty slice", input: []int{}, k: 2, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RotatedRight(tt.input, tt.k); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RotatedRight() = %v, want %v", got, tt.want)
+			}
+
+			RotateRight(tt.input, tt.k)
+			if !reflect.DeepEqual(tt.input, tt.want) {
+				t.Errorf("RotateRight() = %v, want %v", tt.input, tt.want)
+			}
+		})
+	}
+}
+
+func TestSwapRange(t *testing.T) {
+	type args struct {
+		i, j, n int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []int
+		wantErr bool
+	}{
+		{name: "success - swap two ranges", args: args{i: 0, j: 3, n: 2}, want: []int{4, 5, 3, 1, 2, 6}},
+		{name: "success - swap empty ranges", args: args{i: 0, j: 1, n: 0}, want: []int{1, 2, 3, 4, 5, 6}},
+		{name: "fail - overlapping ranges", args: args{i: 0, j: 1, n: 2}, wantErr: true},
+		{name: "fail - out of bounds", args: args{i: 0, j: 5, n: 2}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := []int{1, 2, 3, 4, 5, 6}
+
+			err := SwapRange(input, tt.args.i, tt.args.j, tt.args.n)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SwapRange() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(input, tt.want) {
+				t.Errorf("SwapRange() = %v, want %v", input, tt.want)
+			}
+		})
+	}
+}
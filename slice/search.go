@@ -0,0 +1,51 @@
+package slice
+
+// BinarySearchBy searches for target in a slice sorted according to cmp, which must return
+// a negative number when the element is before the target, zero when it matches and a positive
+// number when it is after it. It returns the position where target is found, or where it would
+// be inserted, and whether it was found.
+func BinarySearchBy[T, K any](slice []T, target K, cmp func(T, K) int) (int, bool) {
+	low, high := 0, len(slice)
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if cmp(slice[mid], target) < 0 {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+
+	return low, low < len(slice) && cmp(slice[low], target) == 0
+}
+
+// InsertSorted inserts value in a slice sorted according to cmp, keeping it sorted, and returns the
+// updated slice. The value is inserted after any elements equal to it.
+func InsertSorted[T any](slice []T, value T, cmp func(a, b T) int) []T {
+	low, high := 0, len(slice)
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if cmp(slice[mid], value) <= 0 {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+
+	var zero T
+	slice = append(slice, zero)
+	copy(slice[low+1:], slice[low:])
+	slice[low] = value
+
+	return slice
+}
+
+// IsSortedBy checks if the slice is sorted in ascending order according to cmp.
+func IsSortedBy[T any](slice []T, cmp func(a, b T) int) bool {
+	for i := 1; i < len(slice); i++ {
+		if cmp(slice[i-1], slice[i]) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
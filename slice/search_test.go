@@ -0,0 +1,100 @@
+package slice
+
+import (
+	"reflect"
+	"testing"
+)
+
+type event struct {
+	ID   int
+	Name string
+}
+
+func compareEventID(e event, id int) int { return e.ID - id }
+
+func compareEvents(a, b event) int { return a.ID - b.ID }
+
+func TestBinarySearchBy(t *testing.T) {
+	events := []event{{1, "a"}, {3, "b"}, {5, "c"}, {7, "d"}}
+
+	tests := []struct {
+		name      string
+		target    int
+		wantIndex int
+		wantFound bool
+	}{
+		{name: "success - find first element", target: 1, wantIndex: 0, wantFound: true},
+		{name: "success - find middle element", target: 5, wantIndex: 2, wantFound: true},
+		{name: "success - missing element position", target: 4, wantIndex: 2, wantFound: false},
+		{name: "success - missing element after the end", target: 10, wantIndex: 4, wantFound: false},
+		{name: "success - missing element before the start", target: 0, wantIndex: 0, wantFound: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index, found := BinarySearchBy(events, tt.target, compareEventID)
+			if index != tt.wantIndex || found != tt.wantFound {
+				t.Errorf("BinarySearchBy() = %v, %v, want %v, %v", index, found, tt.wantIndex, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestInsertSorted(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []event
+		value event
+		want  []event
+	}{
+		{
+			name:  "success - insert in the middle",
+			input: []event{{1, "a"}, {5, "c"}},
+			value: event{3, "b"},
+			want:  []event{{1, "a"}, {3, "b"}, {5, "c"}},
+		},
+		{
+			name:  "success - insert after equal elements",
+			input: []event{{1, "a"}, {3, "b"}, {5, "c"}},
+			value: event{3, "b2"},
+			want:  []event{{1, "a"}, {3, "b"}, {3, "b2"}, {5, "c"}},
+		},
+		{
+			name:  "success - insert in empty slice",
+			input: nil,
+			value: event{1, "a"},
+			want:  []event{{1, "a"}},
+		},
+		{
+			name:  "success - insert at the end",
+			input: []event{{1, "a"}},
+			value: event{2, "b"},
+			want:  []event{{1, "a"}, {2, "b"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InsertSorted(tt.input, tt.value, compareEvents); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InsertSorted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsSortedBy(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []event
+		want  bool
+	}{
+		{name: "success - sorted slice", input: []event{{1, "a"}, {1, "b"}, {2, "c"}}, want: true},
+		{name: "success - empty slice", input: []event{}, want: true},
+		{name: "fail - unsorted slice", input: []event{{2, "a"}, {1, "b"}}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsSortedBy(tt.input, compareEvents); got != tt.want {
+				t.Errorf("IsSortedBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
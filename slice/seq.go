@@ -0,0 +1,70 @@
+//go:build go1.23
+
+package slice
+
+import "iter"
+
+// ToSeq returns a sequence yielding the elements of the slice in order.
+func ToSeq[T any](slice []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range slice {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq collects the values of the sequence into a new slice.
+func FromSeq[T any](seq iter.Seq[T]) []T {
+	result := make([]T, 0)
+	for value := range seq {
+		result = append(result, value)
+	}
+
+	return result
+}
+
+// MapSeq returns a sequence lazily yielding the results of applying f to each value of seq.
+func MapSeq[T, U any](seq iter.Seq[T], f func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for value := range seq {
+			if !yield(f(value)) {
+				return
+			}
+		}
+	}
+}
+
+// FilterSeq returns a sequence lazily yielding the values of seq for which keep returns true.
+func FilterSeq[T any](seq iter.Seq[T], keep func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value := range seq {
+			if keep(value) && !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// TakeSeq returns a sequence yielding at most the first n values of seq.
+// The underlying sequence is not consumed past the n-th value.
+func TakeSeq[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+
+		taken := 0
+		for value := range seq {
+			if !yield(value) {
+				return
+			}
+
+			taken++
+			if taken == n {
+				return
+			}
+		}
+	}
+}
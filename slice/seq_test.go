@@ -0,0 +1,67 @@
+//go:build go1.23
+
+package slice
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestToSeqFromSeq(t *testing.T) {
+	input := []int{1, 2, 3}
+
+	if got := FromSeq(ToSeq(input)); !reflect.DeepEqual(got, input) {
+		t.Errorf("FromSeq(ToSeq()) = %v, want %v", got, input)
+	}
+
+	if got := FromSeq(ToSeq([]int{})); !reflect.DeepEqual(got, []int{}) {
+		t.Errorf("FromSeq(ToSeq()) = %v, want %v", got, []int{})
+	}
+}
+
+func TestSeqPipeline(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want []int
+	}{
+		{name: "success - take some values", n: 3, want: []int{0, 4, 16}},
+		{name: "success - take more values than available", n: 100, want: []int{0, 4, 16, 36, 64}},
+		{name: "success - take zero values", n: 0, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seq := TakeSeq(MapSeq(FilterSeq(ToSeq([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}), func(i int) bool {
+				return i%2 == 0
+			}), func(i int) int {
+				return i * i
+			}), tt.n)
+
+			if got := FromSeq(seq); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pipeline = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTakeSeq_IsLazy(t *testing.T) {
+	pulled := 0
+	infinite := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	got := FromSeq(TakeSeq(MapSeq(infinite, func(i int) int { return i + 1 }), 3))
+
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TakeSeq() = %v, want %v", got, want)
+	}
+
+	if pulled != 3 {
+		t.Errorf("TakeSeq() pulled %d values from the source, want 3", pulled)
+	}
+}
@@ -0,0 +1,68 @@
+package slice
+
+func identity[T any](value T) T { return value }
+
+// Intersection returns the unique elements present in both slices, in the order of the first slice.
+func Intersection[T comparable](a, b []T) []T {
+	return IntersectionBy(a, b, identity[T])
+}
+
+// IntersectionBy returns the unique elements of a whose key is also present in b, in the order of a.
+func IntersectionBy[T any, K comparable](a, b []T, key func(T) K) []T {
+	inB := keySet(b, key)
+
+	return UniqueBy(Filter(a, func(value T) bool {
+		_, ok := inB[key(value)]
+		return ok
+	}), key)
+}
+
+// Union returns the unique elements present in either slice, in the order of a followed by b.
+func Union[T comparable](a, b []T) []T {
+	return UnionBy(a, b, identity[T])
+}
+
+// UnionBy returns the unique elements by key present in either slice, in the order of a followed by b.
+func UnionBy[T any, K comparable](a, b []T, key func(T) K) []T {
+	combined := make([]T, 0, len(a)+len(b))
+	combined = append(combined, a...)
+	combined = append(combined, b...)
+
+	return UniqueBy(combined, key)
+}
+
+// Difference returns the unique elements of a that are not present in b, in the order of a.
+func Difference[T comparable](a, b []T) []T {
+	return DifferenceBy(a, b, identity[T])
+}
+
+// DifferenceBy returns the unique elements of a whose key is not present in b, in the order of a.
+func DifferenceBy[T any, K comparable](a, b []T, key func(T) K) []T {
+	inB := keySet(b, key)
+
+	return UniqueBy(Filter(a, func(value T) bool {
+		_, ok := inB[key(value)]
+		return !ok
+	}), key)
+}
+
+// SymmetricDifference returns the unique elements present in only one of the slices,
+// the ones from a first followed by the ones from b.
+func SymmetricDifference[T comparable](a, b []T) []T {
+	return SymmetricDifferenceBy(a, b, identity[T])
+}
+
+// SymmetricDifferenceBy returns the unique elements whose key is present in only one of the slices,
+// the ones from a first followed by the ones from b.
+func SymmetricDifferenceBy[T any, K comparable](a, b []T, key func(T) K) []T {
+	return append(DifferenceBy(a, b, key), DifferenceBy(b, a, key)...)
+}
+
+func keySet[T any, K comparable](slice []T, key func(T) K) map[K]struct{} {
+	set := make(map[K]struct{}, len(slice))
+	for _, value := range slice {
+		set[key(value)] = struct{}{}
+	}
+
+	return set
+}
@@ -0,0 +1,76 @@
+package slice
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSetOperations(t *testing.T) {
+	a := []int{1, 2, 2, 3, 4}
+	b := []int{5, 4, 3, 3, 6}
+
+	tests := []struct {
+		name string
+		got  []int
+		want []int
+	}{
+		{name: "success - intersection", got: Intersection(a, b), want: []int{3, 4}},
+		{name: "success - union", got: Union(a, b), want: []int{1, 2, 3, 4, 5, 6}},
+		{name: "success - difference", got: Difference(a, b), want: []int{1, 2}},
+		{name: "success - symmetric difference", got: SymmetricDifference(a, b), want: []int{1, 2, 5, 6}},
+		{name: "success - intersection with empty slice", got: Intersection(a, []int{}), want: []int{}},
+		{name: "success - difference with empty slice", got: Difference(a, nil), want: []int{1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetOperationsBy(t *testing.T) {
+	type account struct {
+		ID      string
+		Balance int
+	}
+	key := func(a account) string { return a.ID }
+
+	local := []account{{"a", 10}, {"b", 20}, {"c", 30}}
+	remote := []account{{"b", 25}, {"c", 30}, {"d", 40}}
+
+	tests := []struct {
+		name string
+		got  []account
+		want []account
+	}{
+		{
+			name: "success - intersection by id keeps elements of the first slice",
+			got:  IntersectionBy(local, remote, key),
+			want: []account{{"b", 20}, {"c", 30}},
+		},
+		{
+			name: "success - union by id",
+			got:  UnionBy(local, remote, key),
+			want: []account{{"a", 10}, {"b", 20}, {"c", 30}, {"d", 40}},
+		},
+		{
+			name: "success - difference by id",
+			got:  DifferenceBy(local, remote, key),
+			want: []account{{"a", 10}},
+		},
+		{
+			name: "success - symmetric difference by id",
+			got:  SymmetricDifferenceBy(local, remote, key),
+			want: []account{{"a", 10}, {"d", 40}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
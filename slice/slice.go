@@ -1,43 +1,50 @@
 package slice
 
-import (
-	"fmt"
-
-	"github.com/kashifkhan0771/utils/maps"
-)
-
 // RemoveDuplicateStr removes all the duplicate strings and return a new slice without any duplicate values.
 func RemoveDuplicateStr(strSlice []string) []string {
-	duplicate := maps.NewStateMap()
+	return Unique(strSlice)
+}
+
+// RemoveDuplicateInt removes all the duplicate integers and return a new slice without any duplicate values.
+func RemoveDuplicateInt(strSlice []int) []int {
+	return Unique(strSlice)
+}
 
-	newSlice := make([]string, 0)
+// Unique returns a new slice without duplicate values, preserving the order in which they were first seen.
+func Unique[T comparable](slice []T) []T {
+	return UniqueBy(slice, func(value T) T { return value })
+}
+
+// UniqueBy returns a new slice without elements sharing the same key, keeping the first element seen for each key.
+func UniqueBy[T any, K comparable](slice []T, key func(T) K) []T {
+	seen := make(map[K]struct{}, len(slice))
+	result := make([]T, 0)
 
-	for _, value := range strSlice {
-		if duplicate.HasState(value) {
+	for _, value := range slice {
+		k := key(value)
+		if _, ok := seen[k]; ok {
 			continue
 		}
 
-		duplicate.SetState(value, true)
-		newSlice = append(newSlice, value)
+		seen[k] = struct{}{}
+		result = append(result, value)
 	}
 
-	return newSlice
+	return result
 }
 
-// RemoveDuplicateInt removes all the duplicate integers and return a new slice without any duplicate values.
-func RemoveDuplicateInt(strSlice []int) []int {
-	duplicate := maps.NewStateMap()
+// Duplicates returns the values that appear more than once in the slice.
+// Each repeated value is returned once, in the order in which it was first repeated.
+func Duplicates[T comparable](slice []T) []T {
+	counts := make(map[T]int, len(slice))
+	result := make([]T, 0)
 
-	newSlice := make([]int, 0)
-
-	for _, value := range strSlice {
-		if duplicate.HasState(fmt.Sprintf("%d", value)) {
-			continue
+	for _, value := range slice {
+		counts[value]++
+		if counts[value] == 2 {
+			result = append(result, value)
 		}
-
-		duplicate.SetState(fmt.Sprintf("%d", value), true)
-		newSlice = append(newSlice, value)
 	}
 
-	return newSlice
+	return result
 }
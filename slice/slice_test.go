@@ -52,3 +52,85 @@ func TestRemoveDuplicateInt(t *testing.T) {
 		})
 	}
 }
+
+func TestUnique(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+		want  []string
+	}{
+		{
+			name:  "success - remove duplicates preserving order",
+			input: []string{"b", "a", "b", "c", "a"},
+			want:  []string{"b", "a", "c"},
+		},
+		{
+			name:  "success - empty slice",
+			input: []string{},
+			want:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unique(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Unique() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUniqueBy(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	tests := []struct {
+		name  string
+		input []user
+		want  []user
+	}{
+		{
+			name:  "success - keep first user for each id",
+			input: []user{{1, "alice"}, {2, "bob"}, {1, "alice v2"}, {3, "carol"}, {2, "bob v2"}},
+			want:  []user{{1, "alice"}, {2, "bob"}, {3, "carol"}},
+		},
+		{
+			name:  "success - no duplicates",
+			input: []user{{1, "alice"}, {2, "bob"}},
+			want:  []user{{1, "alice"}, {2, "bob"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UniqueBy(tt.input, func(u user) int { return u.ID }); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UniqueBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDuplicates(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  []int
+	}{
+		{
+			name:  "success - return repeated values once",
+			input: []int{3, 1, 2, 1, 3, 3, 4},
+			want:  []int{1, 3},
+		},
+		{
+			name:  "success - no duplicates",
+			input: []int{1, 2, 3},
+			want:  []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Duplicates(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Duplicates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package slice
+
+import (
+	"fmt"
+	"sort"
+)
+
+// TopN returns the n greatest elements of the slice according to less, from the greatest to the smallest.
+// It keeps a bounded heap of n elements instead of sorting the whole slice, which makes it
+// O(len(slice) * log(n)).
+func TopN[T any](slice []T, n int, less func(a, b T) bool) []T {
+	if n <= 0 {
+		return []T{}
+	}
+
+	// h is a min-heap holding the n greatest elements seen so far
+	h := make([]T, 0, n)
+	for _, value := range slice {
+		if len(h) < n {
+			h = append(h, value)
+			siftUp(h, len(h)-1, less)
+			continue
+		}
+
+		if less(h[0], value) {
+			h[0] = value
+			siftDown(h, 0, less)
+		}
+	}
+
+	// popping the min-heap into the back of the slice leaves it sorted from greatest to smallest
+	for end := len(h) - 1; end > 0; end-- {
+		h[0], h[end] = h[end], h[0]
+		siftDown(h[:end], 0, less)
+	}
+
+	return h
+}
+
+func siftUp[T any](h []T, i int, less func(a, b T) bool) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !less(h[i], h[parent]) {
+			return
+		}
+		h[i], h[parent] = h[parent], h[i]
+		i = parent
+	}
+}
+
+func siftDown[T any](h []T, i int, less func(a, b T) bool) {
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+		if left < len(h) && less(h[left], h[smallest]) {
+			smallest = left
+		}
+		if right < len(h) && less(h[right], h[smallest]) {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+		h[i], h[smallest] = h[smallest], h[i]
+		i = smallest
+	}
+}
+
+// MinBy returns the first element of the slice with the smallest key.
+func MinBy[T any, K Ordered](slice []T, key func(T) K) (T, error) {
+	var zero T
+	if len(slice) == 0 {
+		return zero, fmt.Errorf("cannot find minimum of empty slice")
+	}
+
+	result, resultKey := slice[0], key(slice[0])
+	for _, value := range slice[1:] {
+		if k := key(value); k < resultKey {
+			result, resultKey = value, k
+		}
+	}
+
+	return result, nil
+}
+
+// MaxBy returns the first element of the slice with the greatest key.
+func MaxBy[T any, K Ordered](slice []T, key func(T) K) (T, error) {
+	var zero T
+	if len(slice) == 0 {
+		return zero, fmt.Errorf("cannot find maximum of empty slice")
+	}
+
+	result, resultKey := slice[0], key(slice[0])
+	for _, value := range slice[1:] {
+		if k := key(value); k > resultKey {
+			result, resultKey = value, k
+		}
+	}
+
+	return result, nil
+}
+
+// SortBy sorts the slice in place in ascending order of the key returned by key.
+func SortBy[T any, K Ordered](slice []T, key func(T) K) {
+	sort.Slice(slice, func(i, j int) bool { return key(slice[i]) < key(slice[j]) })
+}
+
+// SortStableBy sorts the slice in place in ascending order of the key returned by key,
+// keeping the original order of elements with equal keys.
+func SortStableBy[T any, K Ordered](slice []T, key func(T) K) {
+	sort.SliceStable(slice, func(i, j int) bool { return key(slice[i]) < key(slice[j]) })
+}
@@ -0,0 +1,110 @@
+package slice
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestTopN(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	type args struct {
+		slice []int
+		n     int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int
+	}{
+		{
+			name: "success - top three",
+			args: args{slice: []int{5, 1, 9, 3, 7, 2, 8}, n: 3},
+			want: []int{9, 8, 7},
+		},
+		{
+			name: "success - n larger than slice",
+			args: args{slice: []int{2, 3, 1}, n: 10},
+			want: []int{3, 2, 1},
+		},
+		{
+			name: "success - with duplicates",
+			args: args{slice: []int{4, 4, 1, 4}, n: 2},
+			want: []int{4, 4},
+		},
+		{
+			name: "success - zero n",
+			args: args{slice: []int{1, 2}, n: 0},
+			want: []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TopN(tt.args.slice, tt.args.n, less); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TopN() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTopN_MatchesFullSort(t *testing.T) {
+	input := make([]int, 1000)
+	for i := range input {
+		input[i] = (i * 7919) % 1009
+	}
+
+	got := TopN(input, 10, func(a, b int) bool { return a < b })
+
+	sorted := append([]int(nil), input...)
+	sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+
+	if !reflect.DeepEqual(got, sorted[:10]) {
+		t.Errorf("TopN() = %v, want %v", got, sorted[:10])
+	}
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	type product struct {
+		Name  string
+		Price float64
+	}
+	products := []product{{"b", 20}, {"a", 10}, {"c", 30}, {"d", 10}}
+	price := func(p product) float64 { return p.Price }
+
+	min, err := MinBy(products, price)
+	if err != nil || min.Name != "a" {
+		t.Errorf("MinBy() = %v, %v, want %v", min, err, "a")
+	}
+
+	max, err := MaxBy(products, price)
+	if err != nil || max.Name != "c" {
+		t.Errorf("MaxBy() = %v, %v, want %v", max, err, "c")
+	}
+
+	if _, err := MinBy([]product{}, price); err == nil {
+		t.Errorf("MinBy() expected error for empty slice")
+	}
+
+	if _, err := MaxBy([]product{}, price); err == nil {
+		t.Errorf("MaxBy() expected error for empty slice")
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	input := []string{"banana", "kiwi", "apple"}
+	SortBy(input, func(s string) int { return len(s) })
+
+	if want := []string{"kiwi", "apple", "banana"}; !reflect.DeepEqual(input, want) {
+		t.Errorf("SortBy() = %v, want %v", input, want)
+	}
+}
+
+func TestSortStableBy(t *testing.T) {
+	input := []string{"bb", "a", "cc", "d", "aa"}
+	SortStableBy(input, func(s string) int { return len(s) })
+
+	if want := []string{"a", "d", "bb", "cc", "aa"}; !reflect.DeepEqual(input, want) {
+		t.Errorf("SortStableBy() = %v, want %v", input, want)
+	}
+}
@@ -0,0 +1,70 @@
+package slice
+
+import "fmt"
+
+// Pair holds two values of possibly different types.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip pairs the elements of a and b by index.
+// If the slices have different lengths, the result is truncated to the shortest one.
+func Zip[A, B any](a []A, b []B) []Pair[A, B] {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+
+	result := make([]Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		result[i] = Pair[A, B]{First: a[i], Second: b[i]}
+	}
+
+	return result
+}
+
+// ZipStrict pairs the elements of a and b by index.
+// It returns an error if the slices have different lengths.
+func ZipStrict[A, B any](a []A, b []B) ([]Pair[A, B], error) {
+	if len(a) != len(b) {
+		return nil, fmt.Errorf("slices have different lengths: %d and %d", len(a), len(b))
+	}
+
+	return Zip(a, b), nil
+}
+
+// Unzip splits a slice of pairs into a slice of the first values and a slice of the second values.
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	a := make([]A, len(pairs))
+	b := make([]B, len(pairs))
+	for i, pair := range pairs {
+		a[i], b[i] = pair.First, pair.Second
+	}
+
+	return a, b
+}
+
+// Interleave merges the slices by taking one element of each in turn.
+// When a slice runs out of elements, the remaining slices keep being interleaved.
+// For example, Interleave([]int{1, 2, 3}, []int{4}, []int{5, 6}) returns [1 4 5 2 6 3].
+func Interleave[T any](slices ...[]T) []T {
+	total, longest := 0, 0
+	for _, s := range slices {
+		total += len(s)
+		if len(s) > longest {
+			longest = len(s)
+		}
+	}
+
+	result := make([]T, 0, total)
+	for i := 0; i < longest; i++ {
+		for _, s := range slices {
+			if i < len(s) {
+				result = append(result, s[i])
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,99 @@
+package slice
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	type args struct {
+		a []string
+		b []int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []Pair[string, int]
+	}{
+		{
+			name: "success - zip slices of equal length",
+			args: args{a: []string{"a", "b"}, b: []int{1, 2}},
+			want: []Pair[string, int]{{"a", 1}, {"b", 2}},
+		},
+		{
+			name: "success - truncate to the shortest slice",
+			args: args{a: []string{"a", "b", "c"}, b: []int{1}},
+			want: []Pair[string, int]{{"a", 1}},
+		},
+		{
+			name: "success - zip with empty slice",
+			args: args{a: []string{"a"}, b: nil},
+			want: []Pair[string, int]{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Zip(tt.args.a, tt.args.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Zip() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestZipStrict(t *testing.T) {
+	got, err := ZipStrict([]string{"a", "b"}, []int{1, 2})
+	if err != nil {
+		t.Fatalf("ZipStrict() error = %v", err)
+	}
+
+	if want := []Pair[string, int]{{"a", 1}, {"b", 2}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ZipStrict() = %v, want %v", got, want)
+	}
+
+	if _, err := ZipStrict([]string{"a"}, []int{1, 2}); err == nil {
+		t.Errorf("ZipStrict() expected error for slices of different lengths")
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	a, b := Unzip([]Pair[string, int]{{"a", 1}, {"b", 2}})
+
+	if want := []string{"a", "b"}; !reflect.DeepEqual(a, want) {
+		t.Errorf("Unzip() first = %v, want %v", a, want)
+	}
+
+	if want := []int{1, 2}; !reflect.DeepEqual(b, want) {
+		t.Errorf("Unzip() second = %v, want %v", b, want)
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	tests := []struct {
+		name  string
+		input [][]int
+		want  []int
+	}{
+		{
+			name:  "success - interleave slices of equal length",
+			input: [][]int{{1, 2}, {3, 4}},
+			want:  []int{1, 3, 2, 4},
+		},
+		{
+			name:  "success - interleave slices of different lengths",
+			input: [][]int{{1, 2, 3}, {4}, {5, 6}},
+			want:  []int{1, 4, 5, 2, 6, 3},
+		},
+		{
+			name:  "success - no slices",
+			input: nil,
+			want:  []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Interleave(tt.input...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Interleave() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
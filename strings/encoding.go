@@ -0,0 +1,101 @@
+package strings
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/transform"
+)
+
+// Encoding is the name of a character encoding reported by DetectEncoding.
+type Encoding string
+
+// Encodings reported by DetectEncoding.
+const (
+	EncodingASCII       Encoding = "ASCII"
+	EncodingUTF8        Encoding = "UTF-8"
+	EncodingUTF16LE     Encoding = "UTF-16LE"
+	EncodingUTF16BE     Encoding = "UTF-16BE"
+	EncodingISO88591    Encoding = "ISO-8859-1"
+	EncodingWindows1252 Encoding = "windows-1252"
+)
+
+var (
+	bomUTF8    = []byte{0xEF, 0xBB, 0xBF}
+	bomUTF16LE = []byte{0xFF, 0xFE}
+	bomUTF16BE = []byte{0xFE, 0xFF}
+)
+
+// IsUTF8 checks if the input is a valid UTF-8 encoded text.
+func IsUTF8(input []byte) bool {
+	return utf8.Valid(input)
+}
+
+// DetectEncoding makes a best-effort guess of the character encoding of the input.
+// Byte order marks are honoured first; otherwise the input is reported as ASCII or
+// UTF-8 when valid, and as a single byte Latin encoding when it is not. Input using
+// the 0x80-0x9F range, which is unused by ISO-8859-1, is reported as windows-1252.
+func DetectEncoding(input []byte) Encoding {
+	switch {
+	case bytes.HasPrefix(input, bomUTF8):
+		return EncodingUTF8
+	case bytes.HasPrefix(input, bomUTF16LE):
+		return EncodingUTF16LE
+	case bytes.HasPrefix(input, bomUTF16BE):
+		return EncodingUTF16BE
+	}
+
+	ascii := true
+	for _, b := range input {
+		if b >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+
+	if ascii {
+		return EncodingASCII
+	}
+
+	if utf8.Valid(input) {
+		return EncodingUTF8
+	}
+
+	for _, b := range input {
+		if b >= 0x80 && b <= 0x9F {
+			return EncodingWindows1252
+		}
+	}
+
+	return EncodingISO88591
+}
+
+// Latin1ToUTF8 converts an ISO-8859-1 encoded input to UTF-8.
+func Latin1ToUTF8(input []byte) ([]byte, error) {
+	result, _, err := transform.Bytes(charmap.ISO8859_1.NewDecoder(), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode ISO-8859-1 input: %w", err)
+	}
+
+	return result, nil
+}
+
+// UTF8ToLatin1 converts a UTF-8 encoded input to ISO-8859-1.
+// It returns an error if the input contains characters that cannot be represented in ISO-8859-1.
+func UTF8ToLatin1(input []byte) ([]byte, error) {
+	result, _, err := transform.Bytes(charmap.ISO8859_1.NewEncoder(), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode input to ISO-8859-1: %w", err)
+	}
+
+	return result, nil
+}
+
+// Latin1Reader returns a reader that converts the ISO-8859-1 encoded reader to UTF-8 while reading.
+// It is useful to stream large files, like CSV exports, without loading them in memory.
+func Latin1Reader(r io.Reader) io.Reader {
+	return transform.NewReader(r, charmap.ISO8859_1.NewDecoder())
+}
@@ -0,0 +1,99 @@
+package strings
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestIsUTF8(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		want  bool
+	}{
+		{name: "success - valid utf-8", input: []byte("ação"), want: true},
+		{name: "success - empty input", input: []byte{}, want: true},
+		{name: "fail - latin-1 input", input: []byte{'a', 0xE7, 0xE3, 'o'}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsUTF8(tt.input); got != tt.want {
+				t.Errorf("IsUTF8() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectEncoding(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		want  Encoding
+	}{
+		{name: "success - ascii", input: []byte("hello"), want: EncodingASCII},
+		{name: "success - utf-8", input: []byte("ação"), want: EncodingUTF8},
+		{name: "success - utf-8 with bom", input: append([]byte{0xEF, 0xBB, 0xBF}, "hi"...), want: EncodingUTF8},
+		{name: "success - utf-16le with bom", input: []byte{0xFF, 0xFE, 'h', 0}, want: EncodingUTF16LE},
+		{name: "success - utf-16be with bom", input: []byte{0xFE, 0xFF, 0, 'h'}, want: EncodingUTF16BE},
+		{name: "success - latin-1", input: []byte{'a', 0xE7, 0xE3, 'o'}, want: EncodingISO88591},
+		{name: "success - windows-1252", input: []byte{0x93, 'h', 'i', 0x94}, want: EncodingWindows1252},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectEncoding(tt.input); got != tt.want {
+				t.Errorf("DetectEncoding() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLatin1ToUTF8(t *testing.T) {
+	got, err := Latin1ToUTF8([]byte{'a', 0xE7, 0xE3, 'o'})
+	if err != nil {
+		t.Fatalf("Latin1ToUTF8() error = %v", err)
+	}
+
+	if string(got) != "ação" {
+		t.Errorf("Latin1ToUTF8() = %v, want %v", string(got), "ação")
+	}
+}
+
+func TestUTF8ToLatin1(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []byte
+		wantErr bool
+	}{
+		{name: "success - convert to latin-1", input: "ação", want: []byte{'a', 0xE7, 0xE3, 'o'}},
+		{name: "fail - character outside latin-1", input: "€", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UTF8ToLatin1([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UTF8ToLatin1() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UTF8ToLatin1() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLatin1Reader(t *testing.T) {
+	r := Latin1Reader(bytes.NewReader([]byte("nome;cidade\nJo\xe3o;S\xe3o Paulo\n")))
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("Latin1Reader() error = %v", err)
+	}
+
+	if want := "nome;cidade\nJoão;São Paulo\n"; string(got) != want {
+		t.Errorf("Latin1Reader() = %v, want %v", string(got), want)
+	}
+}
@@ -0,0 +1,87 @@
+package strings
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	emailPattern = regexp.MustCompile(`[a-zA-Z0-9._%+-]+@[a-zA-Z0-9-]+(?:\.[a-zA-Z0-9-]+)*\.[a-zA-Z]{2,}`)
+	urlPattern   = regexp.MustCompile(`(?i)\b(?:https?|ftp)://[^\s<>"'` + "`" + `]+`)
+	// a sign only counts at the start or after a character that is neither a letter nor a digit,
+	// the first group holds a signed number and the second one an unsigned number
+	numberPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}])([-+](?:\d+(?:\.\d+)?|\.\d+))|(\d+(?:\.\d+)?|\.\d+)`)
+)
+
+// ExtractOptions contains options for the Extract functions.
+type ExtractOptions struct {
+	Unique bool // Remove repeated matches, keeping the first occurrence
+}
+
+// ExtractEmails returns all the email addresses found in the input.
+func ExtractEmails(input string, options ExtractOptions) []string {
+	return extract(emailPattern, input, options, nil)
+}
+
+// ExtractURLs returns all the http, https and ftp URLs found in the input.
+// Trailing punctuation like a sentence-ending period is not included in the URL.
+func ExtractURLs(input string, options ExtractOptions) []string {
+	return extract(urlPattern, input, options, trimURL)
+}
+
+// ExtractNumbers returns all the integer and decimal numbers found in the input. A leading - or +
+// is part of the number only at the start of the input or after a character that is neither a letter
+// nor a digit, so "2024-01-02" holds 2024, 01 and 02.
+func ExtractNumbers(input string, options ExtractOptions) []string {
+	submatches := numberPattern.FindAllStringSubmatch(input, -1)
+	matches := make([]string, 0, len(submatches))
+	for _, submatch := range submatches {
+		matches = append(matches, submatch[1]+submatch[2])
+	}
+
+	return collect(matches, options, nil)
+}
+
+func extract(pattern *regexp.Regexp, input string, options ExtractOptions, clean func(string) string) []string {
+	return collect(pattern.FindAllString(input, -1), options, clean)
+}
+
+// collect cleans the matches and removes the repeated ones if requested.
+func collect(matches []string, options ExtractOptions, clean func(string) string) []string {
+	result := make([]string, 0, len(matches))
+	seen := make(map[string]bool)
+
+	for _, match := range matches {
+		if clean != nil {
+			match = clean(match)
+		}
+
+		if options.Unique {
+			if seen[match] {
+				continue
+			}
+			seen[match] = true
+		}
+
+		result = append(result, match)
+	}
+
+	return result
+}
+
+// trimURL removes trailing punctuation and unbalanced closing parentheses from a matched URL.
+func trimURL(url string) string {
+	for len(url) > 0 {
+		last := url[len(url)-1]
+		switch {
+		case strings.ContainsRune(".,;:!?'\"", rune(last)):
+			url = url[:len(url)-1]
+		case last == ')' && strings.Count(url, "(") < strings.Count(url, ")"):
+			url = url[:len(url)-1]
+		default:
+			return url
+		}
+	}
+
+	return url
+}
@@ -0,0 +1,136 @@
+package strings
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtractEmails(t *testing.T) {
+	type args struct {
+		input   string
+		options ExtractOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "success - extract emails from a sentence",
+			args: args{input: "Contact john.doe@example.com or support@mail.example.org.", options: ExtractOptions{}},
+			want: []string{"john.doe@example.com", "support@mail.example.org"},
+		},
+		{
+			name: "success - extract repeated emails",
+			args: args{input: "a@test.com, b@test.com, a@test.com", options: ExtractOptions{}},
+			want: []string{"a@test.com", "b@test.com", "a@test.com"},
+		},
+		{
+			name: "success - extract unique emails",
+			args: args{input: "a@test.com, b@test.com, a@test.com", options: ExtractOptions{Unique: true}},
+			want: []string{"a@test.com", "b@test.com"},
+		},
+		{
+			name: "success - no emails found",
+			args: args{input: "user@localhost is not an email", options: ExtractOptions{}},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractEmails(tt.args.input, tt.args.options); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractEmails() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractURLs(t *testing.T) {
+	type args struct {
+		input   string
+		options ExtractOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "success - extract urls from a sentence",
+			args: args{input: "Visit https://example.com/path?q=1. Or http://test.org!", options: ExtractOptions{}},
+			want: []string{"https://example.com/path?q=1", "http://test.org"},
+		},
+		{
+			name: "success - extract url inside parentheses",
+			args: args{input: "see (https://en.wikipedia.org/wiki/Go_(language))", options: ExtractOptions{}},
+			want: []string{"https://en.wikipedia.org/wiki/Go_(language)"},
+		},
+		{
+			name: "success - extract unique urls",
+			args: args{input: "ftp://files.example.com ftp://files.example.com", options: ExtractOptions{Unique: true}},
+			want: []string{"ftp://files.example.com"},
+		},
+		{
+			name: "success - no urls found",
+			args: args{input: "example.com without scheme", options: ExtractOptions{}},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractURLs(tt.args.input, tt.args.options); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractURLs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractNumbers(t *testing.T) {
+	type args struct {
+		input   string
+		options ExtractOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "success - extract integers and decimals",
+			args: args{input: "took 12 requests in 3.5s, delta -4 and .25", options: ExtractOptions{}},
+			want: []string{"12", "3.5", "-4", ".25"},
+		},
+		{
+			name: "success - extract unique numbers",
+			args: args{input: "1 2 1 3 2", options: ExtractOptions{Unique: true}},
+			want: []string{"1", "2", "3"},
+		},
+		{
+			name: "success - dashes between digits are not signs",
+			args: args{input: "2024-01-02", options: ExtractOptions{}},
+			want: []string{"2024", "01", "02"},
+		},
+		{
+			name: "success - dash after a letter is not a sign",
+			args: args{input: "item-5 and item+6", options: ExtractOptions{}},
+			want: []string{"5", "6"},
+		},
+		{
+			name: "success - signs at the start and after punctuation",
+			args: args{input: "-3,+4 (-5) x=-6", options: ExtractOptions{}},
+			want: []string{"-3", "+4", "-5", "-6"},
+		},
+		{
+			name: "success - no numbers found",
+			args: args{input: "no digits here", options: ExtractOptions{}},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractNumbers(tt.args.input, tt.args.options); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractNumbers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package strings
+
+import (
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizationForm is a Unicode normalization form.
+type NormalizationForm = norm.Form
+
+// Unicode normalization forms accepted by Normalize.
+const (
+	NFC  NormalizationForm = norm.NFC
+	NFD  NormalizationForm = norm.NFD
+	NFKC NormalizationForm = norm.NFKC
+	NFKD NormalizationForm = norm.NFKD
+)
+
+// Normalize returns the input normalized to the given Unicode normalization form.
+func Normalize(input string, form NormalizationForm) string {
+	return form.String(input)
+}
+
+// RemoveAccents removes diacritical marks from the input, so "ação" becomes "acao".
+// Characters without a decomposition, like "ø" or "ß", are left untouched.
+func RemoveAccents(input string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+	result, _, err := transform.String(t, input)
+	if err != nil {
+		return input
+	}
+
+	return result
+}
@@ -0,0 +1,74 @@
+package strings
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	type args struct {
+		input string
+		form  NormalizationForm
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "success - compose a decomposed string",
+			args: args{input: "a\u0301", form: NFC},
+			want: "\u00e1",
+		},
+		{
+			name: "success - decompose a composed string",
+			args: args{input: "\u00e1", form: NFD},
+			want: "a\u0301",
+		},
+		{
+			name: "success - compatibility composition",
+			args: args{input: "\ufb01", form: NFKC},
+			want: "fi",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.args.input, tt.args.form); got != tt.want {
+				t.Errorf("Normalize() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoveAccents(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "success - remove accents from portuguese word",
+			input: "ação",
+			want:  "acao",
+		},
+		{
+			name:  "success - remove accents from decomposed string",
+			input: "e\u0301le\u0300ve",
+			want:  "eleve",
+		},
+		{
+			name:  "success - keep characters without decomposition",
+			input: "Straße Øre",
+			want:  "Straße Øre",
+		},
+		{
+			name:  "success - string without accents",
+			input: "hello",
+			want:  "hello",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RemoveAccents(tt.input); got != tt.want {
+				t.Errorf("RemoveAccents() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ Package strings defines strings helpers.
 package strings
 
 import (
+	"crypto/sha256"
+	"crypto/subtle"
 	"regexp"
 	"strings"
 
@@ -175,6 +177,85 @@ func Reverse(input string) string {
 	return string(result)
 }
 
+// Substr returns length runes of the input starting at the rune index start.
+// Out of range values are clamped instead of panicking, so it is safe to use on
+// multi-byte UTF-8 strings.
+// For example:
+//
+//	Substr("ação", 1, 2) returns "çã"
+//	Substr("hello", 3, 10) returns "lo"
+func Substr(input string, start, length int) string {
+	runes := []rune(input)
+
+	if start < 0 {
+		start = 0
+	}
+
+	if start >= len(runes) || length <= 0 {
+		return ""
+	}
+
+	end := start + length
+	if end > len(runes) || end < start {
+		end = len(runes)
+	}
+
+	return string(runes[start:end])
+}
+
+// RuneAt returns the rune at the rune index i of the input.
+// It returns false if the index is out of range.
+func RuneAt(input string, i int) (rune, bool) {
+	if i < 0 {
+		return 0, false
+	}
+
+	for _, r := range input {
+		if i == 0 {
+			return r, true
+		}
+		i--
+	}
+
+	return 0, false
+}
+
+// JoinNonEmpty concatenates the non-empty parts with the separator placed between them.
+// Parts that are empty or contain only whitespace are skipped.
+// For example, JoinNonEmpty(", ", "Main St", "", "Springfield") returns "Main St, Springfield".
+func JoinNonEmpty(sep string, parts ...string) string {
+	nonEmpty := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		nonEmpty = append(nonEmpty, part)
+	}
+
+	return strings.Join(nonEmpty, sep)
+}
+
+// JoinFunc converts each item to a string with the provided function and
+// concatenates the results with the separator placed between them.
+func JoinFunc[T any](sep string, items []T, f func(T) string) string {
+	parts := make([]string, len(items))
+	for i, item := range items {
+		parts[i] = f(item)
+	}
+
+	return strings.Join(parts, sep)
+}
+
+// SecureEqual reports whether a and b are equal in constant time.
+// Both inputs are hashed before the comparison so that the time taken does not leak
+// their content nor their length, which makes it suitable to compare secrets like tokens.
+func SecureEqual(a, b string) bool {
+	hashA := sha256.Sum256([]byte(a))
+	hashB := sha256.Sum256([]byte(b))
+
+	return subtle.ConstantTimeCompare(hashA[:], hashB[:]) == 1
+}
+
 // CommonPrefix returns the longest common prefix of the given strings.
 // If no strings are provided, it returns an empty string.
 // If only one string is provided, it returns that string.
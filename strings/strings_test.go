@@ -1,6 +1,7 @@
 package strings
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 )
@@ -434,3 +435,205 @@ func TestCommonSuffix(t *testing.T) {
 		}
 	}
 }
+
+func TestSubstr(t *testing.T) {
+	type args struct {
+		input  string
+		start  int
+		length int
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "success - substring of an ascii string",
+			args: args{input: "hello world", start: 6, length: 5},
+			want: "world",
+		},
+		{
+			name: "success - substring of a multi-byte string",
+			args: args{input: "ação", start: 1, length: 2},
+			want: "çã",
+		},
+		{
+			name: "success - length is clamped to the end of the string",
+			args: args{input: "hello", start: 3, length: 10},
+			want: "lo",
+		},
+		{
+			name: "success - negative start is clamped to zero",
+			args: args{input: "hello", start: -2, length: 2},
+			want: "he",
+		},
+		{
+			name: "success - start beyond the end returns empty string",
+			args: args{input: "hello", start: 10, length: 2},
+			want: "",
+		},
+		{
+			name: "success - negative length returns empty string",
+			args: args{input: "hello", start: 1, length: -1},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Substr(tt.args.input, tt.args.start, tt.args.length); got != tt.want {
+				t.Errorf("Substr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRuneAt(t *testing.T) {
+	type args struct {
+		input string
+		i     int
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   rune
+		wantOk bool
+	}{
+		{
+			name:   "success - rune of an ascii string",
+			args:   args{input: "hello", i: 1},
+			want:   'e',
+			wantOk: true,
+		},
+		{
+			name:   "success - rune of a multi-byte string",
+			args:   args{input: "你好世界", i: 2},
+			want:   '世',
+			wantOk: true,
+		},
+		{
+			name:   "fail - index out of range",
+			args:   args{input: "ação", i: 4},
+			want:   0,
+			wantOk: false,
+		},
+		{
+			name:   "fail - negative index",
+			args:   args{input: "ação", i: -1},
+			want:   0,
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := RuneAt(tt.args.input, tt.args.i)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("RuneAt() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestJoinNonEmpty(t *testing.T) {
+	type args struct {
+		sep   string
+		parts []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "success - skip empty parts",
+			args: args{sep: ", ", parts: []string{"Main St", "", "Springfield", "  "}},
+			want: "Main St, Springfield",
+		},
+		{
+			name: "success - all parts are empty",
+			args: args{sep: "-", parts: []string{"", ""}},
+			want: "",
+		},
+		{
+			name: "success - no parts",
+			args: args{sep: "-", parts: nil},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := JoinNonEmpty(tt.args.sep, tt.args.parts...); got != tt.want {
+				t.Errorf("JoinNonEmpty() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJoinFunc(t *testing.T) {
+	type item struct {
+		ID int
+	}
+	tests := []struct {
+		name  string
+		items []item
+		want  string
+	}{
+		{
+			name:  "success - join struct fields",
+			items: []item{{ID: 1}, {ID: 2}, {ID: 3}},
+			want:  "user:1:2:3",
+		},
+		{
+			name:  "success - join empty slice",
+			items: []item{},
+			want:  "user:",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := "user:" + JoinFunc(":", tt.items, func(i item) string { return fmt.Sprintf("%d", i.ID) })
+			if got != tt.want {
+				t.Errorf("JoinFunc() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSecureEqual(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "success - equal strings",
+			args: args{a: "s3cr3t-token", b: "s3cr3t-token"},
+			want: true,
+		},
+		{
+			name: "success - empty strings",
+			args: args{a: "", b: ""},
+			want: true,
+		},
+		{
+			name: "fail - different strings with the same length",
+			args: args{a: "s3cr3t-token", b: "s3cr3t-tokem"},
+			want: false,
+		},
+		{
+			name: "fail - different lengths",
+			args: args{a: "s3cr3t", b: "s3cr3t-token"},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SecureEqual(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("SecureEqual() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package sync2
+
+import (
+	"context"
+	"sync"
+)
+
+// KeyedLimiter limits the number of concurrent holders per key while different keys proceed in parallel.
+// Keys without holders or waiters are removed, so the number of keys does not grow unbounded.
+type KeyedLimiter struct {
+	limit   int64
+	mu      sync.Mutex
+	entries map[string]*keyedEntry
+}
+
+type keyedEntry struct {
+	sem  *Semaphore
+	refs int
+}
+
+// NewKeyedLimiter returns a KeyedLimiter allowing up to limit concurrent holders per key.
+// A non-positive limit allows a single holder.
+func NewKeyedLimiter(limit int) *KeyedLimiter {
+	if limit <= 0 {
+		limit = 1
+	}
+
+	return &KeyedLimiter{limit: int64(limit), entries: make(map[string]*keyedEntry)}
+}
+
+// Acquire blocks until key has a free slot or ctx is done, and returns the function releasing the slot.
+func (k *KeyedLimiter) Acquire(ctx context.Context, key string) (func(), error) {
+	entry := k.ref(key)
+
+	if err := entry.sem.Acquire(ctx, 1); err != nil {
+		k.unref(key, entry)
+		return nil, err
+	}
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			entry.sem.Release(1)
+			k.unref(key, entry)
+		})
+	}, nil
+}
+
+// TryAcquire acquires a slot of key without blocking and returns the function releasing it, or false if none is free.
+func (k *KeyedLimiter) TryAcquire(key string) (func(), bool) {
+	entry := k.ref(key)
+
+	if !entry.sem.TryAcquire(1) {
+		k.unref(key, entry)
+		return nil, false
+	}
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			entry.sem.Release(1)
+			k.unref(key, entry)
+		})
+	}, true
+}
+
+// Len returns the number of keys with holders or waiters.
+func (k *KeyedLimiter) Len() int {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return len(k.entries)
+}
+
+func (k *KeyedLimiter) ref(key string) *keyedEntry {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	entry, ok := k.entries[key]
+	if !ok {
+		entry = &keyedEntry{sem: NewSemaphore(k.limit)}
+		k.entries[key] = entry
+	}
+
+	entry.refs++
+
+	return entry
+}
+
+func (k *KeyedLimiter) unref(key string, entry *keyedEntry) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	entry.refs--
+	if entry.refs == 0 {
+		delete(k.entries, key)
+	}
+}
+
+// KeyedMutex serializes work per key while different keys proceed in parallel.
+// Keys without holders or waiters are removed. The zero value is not usable, use NewKeyedMutex.
+type KeyedMutex struct {
+	limiter *KeyedLimiter
+}
+
+// NewKeyedMutex returns a KeyedMutex.
+func NewKeyedMutex() *KeyedMutex {
+	return &KeyedMutex{limiter: NewKeyedLimiter(1)}
+}
+
+// Lock locks key and returns the function unlocking it.
+func (m *KeyedMutex) Lock(key string) func() {
+	unlock, _ := m.limiter.Acquire(context.Background(), key)
+	return unlock
+}
+
+// LockContext locks key, giving up when ctx is done, and returns the function unlocking it.
+func (m *KeyedMutex) LockContext(ctx context.Context, key string) (func(), error) {
+	return m.limiter.Acquire(ctx, key)
+}
+
+// TryLock locks key without blocking and returns the function unlocking it, or false if key is locked.
+func (m *KeyedMutex) TryLock(key string) (func(), bool) {
+	return m.limiter.TryAcquire(key)
+}
+
+// Len returns the number of locked keys.
+func (m *KeyedMutex) Len() int {
+	return m.limiter.Len()
+}
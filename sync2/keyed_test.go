@@ -0,0 +1,98 @@
+package sync2
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestKeyedMutex(t *testing.T) {
+	m := NewKeyedMutex()
+
+	var wg sync.WaitGroup
+	var running, overlaps int64
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			unlock := m.Lock("user")
+			defer unlock()
+
+			if atomic.AddInt64(&running, 1) > 1 {
+				atomic.AddInt64(&overlaps, 1)
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt64(&running, -1)
+		}()
+	}
+	wg.Wait()
+
+	if overlaps != 0 {
+		t.Errorf("%d holders overlapped on the same key", overlaps)
+	}
+
+	if got := m.Len(); got != 0 {
+		t.Errorf("Len() = %v after unlocking, want %v", got, 0)
+	}
+}
+
+func TestKeyedMutexKeys(t *testing.T) {
+	m := NewKeyedMutex()
+
+	unlockA := m.Lock("a")
+	unlockB, ok := m.TryLock("b")
+	if !ok {
+		t.Fatalf("TryLock(b) = false while only a is locked")
+	}
+
+	if _, ok := m.TryLock("a"); ok {
+		t.Errorf("TryLock(a) = true while a is locked")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := m.LockContext(ctx, "a"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("LockContext() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if got := m.Len(); got != 2 {
+		t.Errorf("Len() = %v, want %v", got, 2)
+	}
+
+	unlockA()
+	unlockA() // unlocking twice is a no-op
+	unlockB()
+
+	if got := m.Len(); got != 0 {
+		t.Errorf("Len() = %v, want %v", got, 0)
+	}
+}
+
+func TestKeyedLimiter(t *testing.T) {
+	k := NewKeyedLimiter(2)
+
+	release1, err := k.Acquire(context.Background(), "key")
+	if err != nil {
+		t.Fatalf("Acquire() error = %v", err)
+	}
+
+	release2, ok := k.TryAcquire("key")
+	if !ok {
+		t.Fatalf("TryAcquire() = false within the limit")
+	}
+
+	if _, ok := k.TryAcquire("key"); ok {
+		t.Errorf("TryAcquire() = true over the limit")
+	}
+
+	release1()
+	release2()
+
+	if got := k.Len(); got != 0 {
+		t.Errorf("Len() = %v, want %v", got, 0)
+	}
+}
@@ -0,0 +1,98 @@
+package sync2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSemaphore(t *testing.T) {
+	s := NewSemaphore(3)
+
+	if err := s.Acquire(context.Background(), 2); err != nil {
+		t.Fatalf("Acquire() error = %v", err)
+	}
+
+	if s.TryAcquire(2) {
+		t.Errorf("TryAcquire() = true without enough weight")
+	}
+
+	if !s.TryAcquire(1) {
+		t.Errorf("TryAcquire() = false with enough weight")
+	}
+
+	if err := s.Acquire(context.Background(), 4); err == nil {
+		t.Errorf("Acquire() expected error for more than the size")
+	}
+
+	acquired := make(chan struct{})
+	go func() {
+		_ = s.Acquire(context.Background(), 3)
+		close(acquired)
+	}()
+
+	s.Release(1)
+	select {
+	case <-acquired:
+		t.Fatalf("Acquire() returned before enough weight was released")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	s.Release(2)
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatalf("Acquire() did not return after the weight was released")
+	}
+
+	s.Release(3)
+}
+
+func TestSemaphoreCancel(t *testing.T) {
+	s := NewSemaphore(2)
+	_ = s.Acquire(context.Background(), 2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := s.Acquire(ctx, 2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Acquire() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	s.Release(2)
+	if !s.TryAcquire(2) {
+		t.Errorf("TryAcquire() = false, a cancelled waiter must not keep its weight")
+	}
+}
+
+func TestSemaphoreFIFO(t *testing.T) {
+	s := NewSemaphore(2)
+	_ = s.Acquire(context.Background(), 2)
+
+	// a large waiter blocks smaller ones queued after it
+	large := make(chan struct{})
+	go func() {
+		_ = s.Acquire(context.Background(), 2)
+		close(large)
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	s.Release(1)
+	if s.TryAcquire(1) {
+		t.Errorf("TryAcquire() = true while a waiter is queued")
+	}
+
+	s.Release(1)
+	<-large
+}
+
+func TestSemaphoreReleasePanic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Release() did not panic when releasing more than held")
+		}
+	}()
+
+	NewSemaphore(1).Release(1)
+}
@@ -0,0 +1,166 @@
+/*
+Package taskgroup defines a bounded group of goroutines collecting their errors, like errgroup with panic recovery.
+*/
+package taskgroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Options contains options to configure a Group.
+type Options struct {
+	Limit       int           // Maximum number of tasks running at once, unlimited if zero
+	CollectAll  bool          // Run every task and return all errors instead of cancelling on the first one
+	TaskTimeout time.Duration // Timeout applied to the context of each task, none if zero
+}
+
+// Group runs tasks in goroutines and waits for them.
+type Group struct {
+	opts   Options
+	ctx    context.Context
+	cancel context.CancelFunc
+	sem    chan struct{}
+	wg     sync.WaitGroup
+
+	mu   sync.Mutex
+	errs []error
+}
+
+// New returns a Group and a context derived from ctx. Unless CollectAll is set, the context is
+// cancelled when the first task fails. It is always cancelled when Wait returns.
+func New(ctx context.Context, opts Options) (*Group, context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	g := &Group{opts: opts, ctx: ctx, cancel: cancel}
+	if opts.Limit > 0 {
+		g.sem = make(chan struct{}, opts.Limit)
+	}
+
+	return g, ctx
+}
+
+// Go runs fn in a new goroutine, blocking while Limit tasks are already running.
+// A panic in fn is recovered and reported as a *PanicError.
+func (g *Group) Go(fn func(ctx context.Context) error) {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+
+	g.wg.Add(1)
+	go func() {
+		defer func() {
+			if g.sem != nil {
+				<-g.sem
+			}
+			g.wg.Done()
+		}()
+
+		if err := g.run(fn); err != nil {
+			g.fail(err)
+		}
+	}()
+}
+
+// Wait waits for every task and returns the first error, or all errors as Errors when CollectAll is set.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	g.cancel()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	switch {
+	case len(g.errs) == 0:
+		return nil
+	case g.opts.CollectAll:
+		errs := make(Errors, len(g.errs))
+		copy(errs, g.errs)
+
+		return errs
+	default:
+		return g.errs[0]
+	}
+}
+
+func (g *Group) run(fn func(ctx context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+
+	ctx := g.ctx
+	if g.opts.TaskTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, g.opts.TaskTimeout)
+		defer cancel()
+	}
+
+	return fn(ctx)
+}
+
+func (g *Group) fail(err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.errs = append(g.errs, err)
+	if !g.opts.CollectAll {
+		g.cancel()
+	}
+}
+
+// PanicError is the error of a task that panicked.
+type PanicError struct {
+	Value interface{} // Value passed to panic
+	Stack []byte      // Stack trace of the panicking goroutine
+}
+
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("task panicked: %v", p.Value)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (p *PanicError) Unwrap() error {
+	err, _ := p.Value.(error)
+	return err
+}
+
+// Errors holds the errors of every failed task, in the order they failed.
+type Errors []error
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+
+	return fmt.Sprintf("%d tasks failed: %s", len(e), strings.Join(messages, "; "))
+}
+
+// Is reports whether any of the errors matches target, so errors.Is looks into every error.
+func (e Errors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// As finds the first error matching target, so errors.As looks into every error.
+func (e Errors) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,148 @@
+package taskgroup
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var errTest = errors.New("test error")
+
+func TestGroupSuccess(t *testing.T) {
+	g, _ := New(context.Background(), Options{})
+
+	var count int64
+	for i := 0; i < 10; i++ {
+		g.Go(func(ctx context.Context) error {
+			atomic.AddInt64(&count, 1)
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		t.Errorf("Wait() error = %v", err)
+	}
+
+	if count != 10 {
+		t.Errorf("tasks run = %v, want %v", count, 10)
+	}
+}
+
+func TestGroupLimit(t *testing.T) {
+	g, _ := New(context.Background(), Options{Limit: 2})
+
+	var running, peak int64
+	for i := 0; i < 10; i++ {
+		g.Go(func(ctx context.Context) error {
+			n := atomic.AddInt64(&running, 1)
+			for {
+				p := atomic.LoadInt64(&peak)
+				if n <= p || atomic.CompareAndSwapInt64(&peak, p, n) {
+					break
+				}
+			}
+
+			time.Sleep(time.Millisecond)
+			atomic.AddInt64(&running, -1)
+
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+	if peak > 2 {
+		t.Errorf("peak concurrency = %v, want at most %v", peak, 2)
+	}
+}
+
+func TestGroupFirstError(t *testing.T) {
+	g, ctx := New(context.Background(), Options{})
+
+	g.Go(func(ctx context.Context) error {
+		return errTest
+	})
+	g.Go(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	if err := g.Wait(); err != errTest {
+		t.Errorf("Wait() error = %v, want %v", err, errTest)
+	}
+
+	if ctx.Err() == nil {
+		t.Errorf("group context was not cancelled")
+	}
+}
+
+func TestGroupCollectAll(t *testing.T) {
+	g, ctx := New(context.Background(), Options{CollectAll: true})
+	errOther := errors.New("other error")
+
+	g.Go(func(ctx context.Context) error { return errTest })
+	g.Go(func(ctx context.Context) error { return errOther })
+	g.Go(func(ctx context.Context) error { return nil })
+
+	err := g.Wait()
+
+	var errs Errors
+	if !errors.As(err, &errs) || len(errs) != 2 {
+		t.Fatalf("Wait() error = %v, want two errors", err)
+	}
+
+	if !errors.Is(err, errTest) || !errors.Is(err, errOther) {
+		t.Errorf("errors.Is() = false for a collected error")
+	}
+
+	if !strings.HasPrefix(err.Error(), "2 tasks failed: ") {
+		t.Errorf("Error() = %q", err.Error())
+	}
+
+	// the context is only cancelled by Wait
+	if ctx.Err() == nil {
+		t.Errorf("group context was not cancelled after Wait()")
+	}
+}
+
+func TestGroupPanic(t *testing.T) {
+	g, _ := New(context.Background(), Options{})
+
+	g.Go(func(ctx context.Context) error {
+		panic(errTest)
+	})
+
+	err := g.Wait()
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("Wait() error = %v, want a *PanicError", err)
+	}
+
+	if !errors.Is(err, errTest) {
+		t.Errorf("errors.Is() = false for the panic value")
+	}
+
+	if len(panicErr.Stack) == 0 {
+		t.Errorf("PanicError.Stack is empty")
+	}
+
+	if got, want := panicErr.Error(), "task panicked: test error"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestGroupTaskTimeout(t *testing.T) {
+	g, _ := New(context.Background(), Options{TaskTimeout: time.Millisecond, CollectAll: true})
+
+	g.Go(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	if err := g.Wait(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
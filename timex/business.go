@@ -0,0 +1,140 @@
+package timex
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// maxNonBusinessDays is the number of consecutive non-business days after which AddBusinessDays gives up.
+const maxNonBusinessDays = 366
+
+// ErrNoBusinessDay is returned by AddBusinessDays when the calendars reject a whole year of consecutive days.
+var ErrNoBusinessDay = errors.New("no business day found")
+
+// HolidayCalendar reports whether a date is a holiday.
+type HolidayCalendar interface {
+	IsHoliday(t time.Time) bool
+}
+
+// HolidayFunc adapts a function to the HolidayCalendar interface.
+type HolidayFunc func(t time.Time) bool
+
+// IsHoliday calls f(t).
+func (f HolidayFunc) IsHoliday(t time.Time) bool {
+	return f(t)
+}
+
+// IsBusinessDay reports whether t is neither a weekend day nor a holiday in any of the calendars.
+func IsBusinessDay(t time.Time, calendars ...HolidayCalendar) bool {
+	if wd := t.Weekday(); wd == time.Saturday || wd == time.Sunday {
+		return false
+	}
+
+	for _, calendar := range calendars {
+		if calendar.IsHoliday(t) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AddBusinessDays adds n business days to t, moving backwards when n is negative.
+// The time of day is preserved. It returns ErrNoBusinessDay if more than a year of consecutive days
+// are not business days, as happens with calendars rejecting every day.
+func AddBusinessDays(t time.Time, n int, calendars ...HolidayCalendar) (time.Time, error) {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	skipped := 0
+	for n > 0 {
+		t = t.AddDate(0, 0, step)
+		if IsBusinessDay(t, calendars...) {
+			n, skipped = n-1, 0
+			continue
+		}
+
+		if skipped++; skipped > maxNonBusinessDays {
+			return time.Time{}, fmt.Errorf("%w within %d days of %s", ErrNoBusinessDay, maxNonBusinessDays, t.Format("2006-01-02"))
+		}
+	}
+
+	return t, nil
+}
+
+// BusinessDaysBetween returns the number of business days from a (inclusive) to b (exclusive).
+// The result is negative when b is before a.
+func BusinessDaysBetween(a, b time.Time, calendars ...HolidayCalendar) int {
+	sign := 1
+	if b.Before(a) {
+		a, b, sign = b, a, -1
+	}
+
+	start := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, a.Location())
+	end := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, a.Location())
+
+	count := 0
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		if IsBusinessDay(d, calendars...) {
+			count++
+		}
+	}
+
+	return sign * count
+}
+
+// BrazilHolidays is a calendar of the Brazilian national holidays, including the bank holidays
+// of Carnival and Corpus Christi.
+var BrazilHolidays HolidayCalendar = HolidayFunc(isBrazilHoliday)
+
+func isBrazilHoliday(t time.Time) bool {
+	year, month, day := t.Date()
+
+	switch {
+	case month == time.January && day == 1, // Confraternização Universal
+		month == time.April && day == 21,    // Tiradentes
+		month == time.May && day == 1,       // Dia do Trabalho
+		month == time.September && day == 7, // Independência
+		month == time.October && day == 12,  // Nossa Senhora Aparecida
+		month == time.November && day == 2,  // Finados
+		month == time.November && day == 15, // Proclamação da República
+		month == time.December && day == 25: // Natal
+		return true
+	case month == time.November && day == 20 && year >= 2024: // Consciência Negra
+		return true
+	}
+
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	easter := Easter(year)
+	for _, offset := range []int{-48, -47, -2, 60} { // Carnival Monday and Tuesday, Good Friday, Corpus Christi
+		if date.Equal(easter.AddDate(0, 0, offset)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Easter returns the date of the Western Easter Sunday of the year, in UTC.
+func Easter(year int) time.Time {
+	// anonymous Gregorian algorithm
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
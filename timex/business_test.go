@@ -0,0 +1,138 @@
+package timex
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	tests := []struct {
+		name      string
+		t         time.Time
+		calendars []HolidayCalendar
+		want      bool
+	}{
+		{name: "success - weekday", t: date(2024, time.October, 1), want: true},
+		{name: "success - saturday", t: date(2024, time.October, 5), want: false},
+		{name: "success - sunday", t: date(2024, time.October, 6), want: false},
+		{name: "success - weekday without calendar", t: date(2024, time.December, 25), want: true},
+		{name: "success - christmas in brazil", t: date(2024, time.December, 25), calendars: []HolidayCalendar{BrazilHolidays}, want: false},
+		{name: "success - custom calendar", t: date(2024, time.October, 1), calendars: []HolidayCalendar{
+			HolidayFunc(func(t time.Time) bool { return t.Month() == time.October && t.Day() == 1 }),
+		}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsBusinessDay(tt.t, tt.calendars...); got != tt.want {
+				t.Errorf("IsBusinessDay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	tests := []struct {
+		name      string
+		t         time.Time
+		n         int
+		calendars []HolidayCalendar
+		want      time.Time
+		wantErr   bool
+	}{
+		{name: "success - zero days", t: date(2024, time.October, 5), n: 0, want: date(2024, time.October, 5)},
+		{name: "success - within week", t: date(2024, time.October, 1), n: 2, want: date(2024, time.October, 3)},
+		{name: "success - over weekend", t: date(2024, time.October, 4), n: 1, want: date(2024, time.October, 7)},
+		{name: "success - from saturday", t: date(2024, time.October, 5), n: 1, want: date(2024, time.October, 7)},
+		{name: "success - backwards over weekend", t: date(2024, time.October, 7), n: -1, want: date(2024, time.October, 4)},
+		{name: "success - over brazilian holiday", t: date(2024, time.October, 11), n: 1, calendars: []HolidayCalendar{BrazilHolidays}, want: date(2024, time.October, 14)},
+		{name: "success - over carnival", t: date(2024, time.February, 9), n: 1, calendars: []HolidayCalendar{BrazilHolidays}, want: date(2024, time.February, 14)},
+		{name: "success - keeps time of day", t: time.Date(2024, time.October, 4, 15, 30, 0, 0, time.UTC), n: 1, want: time.Date(2024, time.October, 7, 15, 30, 0, 0, time.UTC)},
+		{name: "fail - every day is a holiday", t: date(2024, time.October, 1), n: 1, calendars: []HolidayCalendar{HolidayFunc(func(time.Time) bool { return true })}, wantErr: true},
+		{name: "fail - every day is a holiday backwards", t: date(2024, time.October, 1), n: -3, calendars: []HolidayCalendar{HolidayFunc(func(time.Time) bool { return true })}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AddBusinessDays(tt.t, tt.n, tt.calendars...)
+			if tt.wantErr {
+				if !errors.Is(err, ErrNoBusinessDay) {
+					t.Errorf("AddBusinessDays() error = %v, want %v", err, ErrNoBusinessDay)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("AddBusinessDays() error = %v", err)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("AddBusinessDays() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusinessDaysBetween(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b      time.Time
+		calendars []HolidayCalendar
+		want      int
+	}{
+		{name: "success - same day", a: date(2024, time.October, 1), b: date(2024, time.October, 1), want: 0},
+		{name: "success - full week", a: date(2024, time.September, 30), b: date(2024, time.October, 7), want: 5},
+		{name: "success - reversed", a: date(2024, time.October, 7), b: date(2024, time.September, 30), want: -5},
+		{name: "success - with holidays", a: date(2024, time.November, 1), b: date(2024, time.December, 1), calendars: []HolidayCalendar{BrazilHolidays}, want: 19},
+		{name: "success - ignores time of day", a: time.Date(2024, time.October, 1, 23, 0, 0, 0, time.UTC), b: time.Date(2024, time.October, 2, 1, 0, 0, 0, time.UTC), want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BusinessDaysBetween(tt.a, tt.b, tt.calendars...); got != tt.want {
+				t.Errorf("BusinessDaysBetween() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBrazilHolidays(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+		want bool
+	}{
+		{name: "success - tiradentes", t: date(2024, time.April, 21), want: true},
+		{name: "success - good friday", t: date(2024, time.March, 29), want: true},
+		{name: "success - corpus christi", t: date(2024, time.May, 30), want: true},
+		{name: "success - consciencia negra since 2024", t: date(2024, time.November, 20), want: true},
+		{name: "success - consciencia negra before 2024", t: date(2023, time.November, 20), want: false},
+		{name: "success - regular day", t: date(2024, time.October, 1), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BrazilHolidays.IsHoliday(tt.t); got != tt.want {
+				t.Errorf("BrazilHolidays.IsHoliday() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEaster(t *testing.T) {
+	tests := []struct {
+		year int
+		want time.Time
+	}{
+		{2000, date(2000, time.April, 23)},
+		{2024, date(2024, time.March, 31)},
+		{2025, date(2025, time.April, 20)},
+	}
+	for _, tt := range tests {
+		if got := Easter(tt.year); !got.Equal(tt.want) {
+			t.Errorf("Easter(%d) = %v, want %v", tt.year, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package timex
+
+import (
+	"testing"
+	"time"
+)
+
+var _ Clock = RealClock{}
+var _ Clock = (*FakeClock)(nil)
+
+func TestRealClock(t *testing.T) {
+	clock := RealClock{}
+
+	before := time.Now()
+	if got := clock.Now(); got.Before(before) {
+		t.Errorf("Now() = %v, want after %v", got, before)
+	}
+
+	select {
+	case <-clock.After(time.Millisecond):
+	case <-time.After(time.Second):
+		t.Errorf("After() did not fire")
+	}
+
+	timer := clock.NewTimer(time.Hour)
+	if !timer.Stop() {
+		t.Errorf("Timer.Stop() = false, want true")
+	}
+
+	ticker := clock.NewTicker(time.Millisecond)
+	defer ticker.Stop()
+
+	select {
+	case <-ticker.C():
+	case <-time.After(time.Second):
+		t.Errorf("Ticker did not tick")
+	}
+}
+
+func TestFakeClockTimer(t *testing.T) {
+	start := time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+	timer := clock.NewTimer(time.Minute)
+
+	clock.Advance(30 * time.Second)
+	select {
+	case <-timer.C():
+		t.Fatalf("timer fired before its deadline")
+	default:
+	}
+
+	clock.Advance(30 * time.Second)
+	select {
+	case got := <-timer.C():
+		if want := start.Add(time.Minute); !got.Equal(want) {
+			t.Errorf("timer fired at %v, want %v", got, want)
+		}
+	default:
+		t.Fatalf("timer did not fire at its deadline")
+	}
+
+	if timer.Stop() {
+		t.Errorf("Stop() = true for a fired timer, want false")
+	}
+
+	if timer.Reset(time.Second) {
+		t.Errorf("Reset() = true for a fired timer, want false")
+	}
+
+	if !timer.Stop() {
+		t.Errorf("Stop() = false for an active timer, want true")
+	}
+
+	clock.Advance(time.Hour)
+	select {
+	case <-timer.C():
+		t.Errorf("stopped timer fired")
+	default:
+	}
+
+	if got, want := clock.Now(), start.Add(time.Hour+time.Minute); !got.Equal(want) {
+		t.Errorf("Now() = %v, want %v", got, want)
+	}
+}
+
+func TestFakeClockTicker(t *testing.T) {
+	start := time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+	ticker := clock.NewTicker(time.Second)
+
+	for i := 1; i <= 3; i++ {
+		clock.Advance(time.Second)
+		if got, want := <-ticker.C(), start.Add(time.Duration(i)*time.Second); !got.Equal(want) {
+			t.Errorf("tick %d = %v, want %v", i, got, want)
+		}
+	}
+
+	// ticks nobody received are dropped
+	clock.Advance(5 * time.Second)
+	<-ticker.C()
+	select {
+	case <-ticker.C():
+		t.Errorf("ticker kept more than one pending tick")
+	default:
+	}
+
+	ticker.Stop()
+	clock.Advance(time.Minute)
+	select {
+	case <-ticker.C():
+		t.Errorf("stopped ticker ticked")
+	default:
+	}
+}
+
+func TestFakeClockSleep(t *testing.T) {
+	clock := NewFakeClock(time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC))
+	done := make(chan struct{})
+
+	go func() {
+		clock.Sleep(time.Hour)
+		close(done)
+	}()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Hour)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Errorf("Sleep() did not return after Advance()")
+	}
+}
+
+func TestFakeClockZeroDuration(t *testing.T) {
+	clock := NewFakeClock(time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC))
+
+	select {
+	case <-clock.After(0):
+	default:
+		t.Errorf("After(0) did not fire immediately")
+	}
+}
@@ -0,0 +1,99 @@
+package timex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr bool
+	}{
+		{name: "success - all stars", expr: "* * * * *"},
+		{name: "success - lists ranges and steps", expr: "0,30 9-17/2 1-15 */3 1-5"},
+		{name: "success - names", expr: "0 12 * JAN-mar MON,fri"},
+		{name: "success - macro", expr: "@hourly"},
+		{name: "success - sunday as 7", expr: "0 0 * * 7"},
+		{name: "fail - too few fields", expr: "* * * *", wantErr: true},
+		{name: "fail - unknown macro", expr: "@sometimes", wantErr: true},
+		{name: "fail - minute out of range", expr: "60 * * * *", wantErr: true},
+		{name: "fail - zero day of month", expr: "0 0 0 * *", wantErr: true},
+		{name: "fail - inverted range", expr: "0 10-5 * * *", wantErr: true},
+		{name: "fail - zero step", expr: "*/0 * * * *", wantErr: true},
+		{name: "fail - invalid name", expr: "0 0 * foo *", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCron(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseCron() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	from := time.Date(2024, time.October, 1, 10, 17, 30, 0, time.UTC) // a Tuesday
+
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{name: "success - every minute", expr: "* * * * *", from: from, want: time.Date(2024, time.October, 1, 10, 18, 0, 0, time.UTC)},
+		{name: "success - strictly after", expr: "18 * * * *", from: time.Date(2024, time.October, 1, 10, 18, 0, 0, time.UTC), want: time.Date(2024, time.October, 1, 11, 18, 0, 0, time.UTC)},
+		{name: "success - every 15 minutes", expr: "*/15 * * * *", from: from, want: time.Date(2024, time.October, 1, 10, 30, 0, 0, time.UTC)},
+		{name: "success - hourly macro", expr: "@hourly", from: from, want: time.Date(2024, time.October, 1, 11, 0, 0, 0, time.UTC)},
+		{name: "success - daily macro", expr: "@daily", from: from, want: time.Date(2024, time.October, 2, 0, 0, 0, 0, time.UTC)},
+		{name: "success - weekdays only", expr: "0 9 * * MON-FRI", from: time.Date(2024, time.October, 4, 10, 0, 0, 0, time.UTC), want: time.Date(2024, time.October, 7, 9, 0, 0, 0, time.UTC)},
+		{name: "success - next year", expr: "0 0 1 1 *", from: from, want: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "success - leap day", expr: "0 0 29 2 *", from: from, want: time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "success - day of month or day of week", expr: "0 0 15 * SUN", from: from, want: time.Date(2024, time.October, 6, 0, 0, 0, 0, time.UTC)},
+		{name: "success - never matches", expr: "0 0 30 2 *", from: from, want: time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseCron(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCron() error = %v", err)
+			}
+
+			if got := schedule.Next(tt.from); !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCronSchedulePrev(t *testing.T) {
+	from := time.Date(2024, time.October, 1, 10, 17, 30, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{name: "success - every minute", expr: "* * * * *", from: from, want: time.Date(2024, time.October, 1, 10, 17, 0, 0, time.UTC)},
+		{name: "success - strictly before", expr: "17 * * * *", from: time.Date(2024, time.October, 1, 10, 17, 0, 0, time.UTC), want: time.Date(2024, time.October, 1, 9, 17, 0, 0, time.UTC)},
+		{name: "success - previous day", expr: "30 23 * * *", from: from, want: time.Date(2024, time.September, 30, 23, 30, 0, 0, time.UTC)},
+		{name: "success - previous month", expr: "0 12 15 * *", from: from, want: time.Date(2024, time.September, 15, 12, 0, 0, 0, time.UTC)},
+		{name: "success - previous year", expr: "@yearly", from: from, want: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "success - never matches", expr: "0 0 31 4 *", from: from, want: time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseCron(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCron() error = %v", err)
+			}
+
+			if got := schedule.Prev(tt.from); !got.Equal(tt.want) {
+				t.Errorf("Prev() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package timex
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// FlexFormat is the output format of a FlexTime.
+type FlexFormat int
+
+const (
+	FormatRFC3339   FlexFormat = iota // RFC3339 string with nanoseconds, the default
+	FormatUnix                        // Unix seconds as a JSON number
+	FormatUnixMilli                   // Unix milliseconds as a JSON number
+)
+
+// FlexTime is a time.Time accepting RFC3339 strings, Unix seconds and Unix milliseconds, as JSON numbers or strings,
+// and any other layout supported by Parse. It is marshaled using Output and the zero time is marshaled as null.
+type FlexTime struct {
+	time.Time
+	Output FlexFormat
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f FlexTime) MarshalJSON() ([]byte, error) {
+	if f.IsZero() {
+		return []byte("null"), nil
+	}
+
+	switch f.Output {
+	case FormatUnix:
+		return []byte(strconv.FormatInt(f.Unix(), 10)), nil
+	case FormatUnixMilli:
+		return []byte(strconv.FormatInt(f.UnixMilli(), 10)), nil
+	default:
+		return json.Marshal(f.Format(time.RFC3339Nano))
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The Output of f is kept.
+func (f *FlexTime) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		f.Time = time.Time{}
+		return nil
+	}
+
+	value := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &value); err != nil {
+			return fmt.Errorf("failed to unmarshal time: %w", err)
+		}
+
+		if value == "" {
+			f.Time = time.Time{}
+			return nil
+		}
+	}
+
+	t, err := Parse(value)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal time: %w", err)
+	}
+
+	f.Time = t
+
+	return nil
+}
+
+// Scan implements sql.Scanner for time.Time, string, []byte and integer Unix timestamps.
+func (f *FlexTime) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		f.Time = time.Time{}
+	case time.Time:
+		f.Time = v
+	case int64:
+		return f.UnmarshalJSON([]byte(strconv.FormatInt(v, 10)))
+	case string:
+		return f.scanString(v)
+	case []byte:
+		return f.scanString(string(v))
+	default:
+		return fmt.Errorf("cannot scan %T into FlexTime", src)
+	}
+
+	return nil
+}
+
+func (f *FlexTime) scanString(s string) error {
+	if s == "" {
+		f.Time = time.Time{}
+		return nil
+	}
+
+	t, err := Parse(s)
+	if err != nil {
+		return fmt.Errorf("failed to scan time: %w", err)
+	}
+
+	f.Time = t
+
+	return nil
+}
+
+// Value implements driver.Valuer, the zero time is stored as NULL.
+func (f FlexTime) Value() (driver.Value, error) {
+	if f.IsZero() {
+		return nil, nil
+	}
+
+	return f.Time, nil
+}
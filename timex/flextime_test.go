@@ -0,0 +1,130 @@
+package timex
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestFlexTimeUnmarshalJSON(t *testing.T) {
+	want := time.Date(2024, time.October, 1, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		data    string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "success - rfc3339", data: `"2024-10-01T12:30:00Z"`, want: want},
+		{name: "success - unix seconds", data: `1727785800`, want: want},
+		{name: "success - unix milliseconds", data: `1727785800000`, want: want},
+		{name: "success - unix seconds as string", data: `"1727785800"`, want: want},
+		{name: "success - null", data: `null`, want: time.Time{}},
+		{name: "success - empty string", data: `""`, want: time.Time{}},
+		{name: "fail - invalid string", data: `"yesterday"`, wantErr: true},
+		{name: "fail - boolean", data: `true`, wantErr: true},
+		{name: "fail - float", data: `1727785800.5`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got FlexTime
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("UnmarshalJSON() = %v, want %v", got.Time, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlexTimeMarshalJSON(t *testing.T) {
+	value := time.Date(2024, time.October, 1, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		in   FlexTime
+		want string
+	}{
+		{name: "success - rfc3339 by default", in: FlexTime{Time: value}, want: `"2024-10-01T12:30:00Z"`},
+		{name: "success - unix seconds", in: FlexTime{Time: value, Output: FormatUnix}, want: `1727785800`},
+		{name: "success - unix milliseconds", in: FlexTime{Time: value, Output: FormatUnixMilli}, want: `1727785800000`},
+		{name: "success - zero time", in: FlexTime{}, want: `null`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.in)
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+
+			if string(got) != tt.want {
+				t.Errorf("MarshalJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlexTimeInStruct(t *testing.T) {
+	type event struct {
+		CreatedAt FlexTime `json:"created_at"`
+	}
+
+	e := event{CreatedAt: FlexTime{Output: FormatUnix}}
+	if err := json.Unmarshal([]byte(`{"created_at":"2024-10-01T12:30:00Z"}`), &e); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	got, err := json.Marshal(e)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	if want := `{"created_at":1727785800}`; string(got) != want {
+		t.Errorf("Marshal() = %s, want %s", got, want)
+	}
+}
+
+func TestFlexTimeScanValue(t *testing.T) {
+	want := time.Date(2024, time.October, 1, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "success - time", src: want, want: want},
+		{name: "success - string", src: "2024-10-01 12:30:00", want: want},
+		{name: "success - bytes", src: []byte("2024-10-01T12:30:00Z"), want: want},
+		{name: "success - integer", src: int64(1727785800), want: want},
+		{name: "success - nil", src: nil, want: time.Time{}},
+		{name: "fail - invalid string", src: "soon", wantErr: true},
+		{name: "fail - unsupported type", src: 1.5, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got FlexTime
+			err := got.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("Scan() = %v, want %v", got.Time, tt.want)
+			}
+		})
+	}
+
+	if v, err := (FlexTime{}).Value(); v != nil || err != nil {
+		t.Errorf("Value() = %v, %v, want nil, nil", v, err)
+	}
+
+	if v, err := (FlexTime{Time: want}).Value(); v != want || err != nil {
+		t.Errorf("Value() = %v, %v, want %v, nil", v, err, want)
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Package timex defines time and date helpers.
+*/
+package timex
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kashifkhan0771/utils/humanize"
+)
+
+const (
+	day   = 24 * time.Hour
+	month = 30 * day
+	year  = 365 * day
+)
+
+// HumanizeOptions contains options to humanize durations.
+type HumanizeOptions struct {
+	Locale humanize.Locale // Language of the output, English by default
+	Units  int             // Maximum number of units in the output, 1 by default
+}
+
+type unit struct {
+	duration time.Duration
+	names    map[humanize.Locale][2]string // singular and plural names per locale
+}
+
+var units = []unit{
+	{year, map[humanize.Locale][2]string{humanize.English: {"year", "years"}, humanize.PortugueseBR: {"ano", "anos"}}},
+	{month, map[humanize.Locale][2]string{humanize.English: {"month", "months"}, humanize.PortugueseBR: {"mês", "meses"}}},
+	{day, map[humanize.Locale][2]string{humanize.English: {"day", "days"}, humanize.PortugueseBR: {"dia", "dias"}}},
+	{time.Hour, map[humanize.Locale][2]string{humanize.English: {"hour", "hours"}, humanize.PortugueseBR: {"hora", "horas"}}},
+	{time.Minute, map[humanize.Locale][2]string{humanize.English: {"minute", "minutes"}, humanize.PortugueseBR: {"minuto", "minutos"}}},
+	{time.Second, map[humanize.Locale][2]string{humanize.English: {"second", "seconds"}, humanize.PortugueseBR: {"segundo", "segundos"}}},
+}
+
+type phrases struct {
+	and, ago, in, now string
+}
+
+var localePhrases = map[humanize.Locale]phrases{
+	humanize.English:      {and: "and", ago: "%s ago", in: "in %s", now: "just now"},
+	humanize.PortugueseBR: {and: "e", ago: "há %s", in: "em %s", now: "agora"},
+}
+
+// Humanize returns a human readable representation of the duration in English using its largest unit.
+// For example, Humanize(150 * time.Minute) returns "2 hours".
+func Humanize(d time.Duration) string {
+	return HumanizeWithOptions(d, HumanizeOptions{})
+}
+
+// HumanizeWithOptions returns a human readable representation of the duration with the provided options.
+// For example, with the PortugueseBR locale and 2 units, 150 minutes returns "2 horas e 30 minutos".
+// Months are considered to be 30 days long and years 365 days long.
+func HumanizeWithOptions(d time.Duration, options HumanizeOptions) string {
+	locale := normalizeLocale(options.Locale)
+
+	maxUnits := options.Units
+	if maxUnits <= 0 {
+		maxUnits = 1
+	}
+
+	if d < 0 {
+		d = -d
+	}
+
+	var parts []string
+	for _, u := range units {
+		if len(parts) == maxUnits {
+			break
+		}
+
+		n := d / u.duration
+		if n == 0 {
+			// skip leading units, but stop at the first empty unit after a non-empty one
+			if len(parts) > 0 {
+				break
+			}
+			continue
+		}
+
+		d -= n * u.duration
+		parts = append(parts, formatUnit(int64(n), u, locale))
+	}
+
+	if len(parts) == 0 {
+		return formatUnit(0, units[len(units)-1], locale)
+	}
+
+	if len(parts) == 1 {
+		return parts[0]
+	}
+
+	return strings.Join(parts[:len(parts)-1], ", ") + " " + localePhrases[locale].and + " " + parts[len(parts)-1]
+}
+
+// RelativeTime returns a human readable representation of t relative to now in English,
+// like "2 hours ago" or "in 3 days".
+func RelativeTime(t, now time.Time) string {
+	return RelativeTimeWithOptions(t, now, HumanizeOptions{})
+}
+
+// RelativeTimeWithOptions returns a human readable representation of t relative to now with the
+// provided options, like "há 2 horas" with the PortugueseBR locale.
+func RelativeTimeWithOptions(t, now time.Time, options HumanizeOptions) string {
+	p := localePhrases[normalizeLocale(options.Locale)]
+
+	d := now.Sub(t)
+	if d > -time.Second && d < time.Second {
+		return p.now
+	}
+
+	if d > 0 {
+		return fmt.Sprintf(p.ago, HumanizeWithOptions(d, options))
+	}
+
+	return fmt.Sprintf(p.in, HumanizeWithOptions(-d, options))
+}
+
+func formatUnit(n int64, u unit, locale humanize.Locale) string {
+	names := u.names[locale]
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, names[0])
+	}
+
+	return fmt.Sprintf("%d %s", n, names[1])
+}
+
+func normalizeLocale(locale humanize.Locale) humanize.Locale {
+	if _, ok := localePhrases[locale]; ok {
+		return locale
+	}
+
+	return humanize.English
+}
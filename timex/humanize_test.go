@@ -0,0 +1,114 @@
+package timex
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/humanize"
+)
+
+func TestHumanize(t *testing.T) {
+	tests := []struct {
+		name string
+		d    time.Duration
+		want string
+	}{
+		{name: "success - zero duration", d: 0, want: "0 seconds"},
+		{name: "success - one second", d: time.Second, want: "1 second"},
+		{name: "success - minutes", d: 5*time.Minute + 10*time.Second, want: "5 minutes"},
+		{name: "success - hours", d: 150 * time.Minute, want: "2 hours"},
+		{name: "success - days", d: 49 * time.Hour, want: "2 days"},
+		{name: "success - months", d: 65 * 24 * time.Hour, want: "2 months"},
+		{name: "success - years", d: 800 * 24 * time.Hour, want: "2 years"},
+		{name: "success - negative duration", d: -time.Hour, want: "1 hour"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Humanize(tt.d); got != tt.want {
+				t.Errorf("Humanize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHumanizeWithOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		d       time.Duration
+		options HumanizeOptions
+		want    string
+	}{
+		{
+			name:    "success - two units in english",
+			d:       150 * time.Minute,
+			options: HumanizeOptions{Units: 2},
+			want:    "2 hours and 30 minutes",
+		},
+		{
+			name:    "success - three units in english",
+			d:       26*time.Hour + 61*time.Second,
+			options: HumanizeOptions{Units: 3},
+			want:    "1 day, 2 hours and 1 minute",
+		},
+		{
+			name:    "success - stop at an empty unit",
+			d:       2*time.Hour + 5*time.Second,
+			options: HumanizeOptions{Units: 3},
+			want:    "2 hours",
+		},
+		{
+			name:    "success - portuguese",
+			d:       150 * time.Minute,
+			options: HumanizeOptions{Locale: humanize.PortugueseBR, Units: 2},
+			want:    "2 horas e 30 minutos",
+		},
+		{
+			name:    "success - portuguese singular",
+			d:       31 * 24 * time.Hour,
+			options: HumanizeOptions{Locale: humanize.PortugueseBR},
+			want:    "1 mês",
+		},
+		{
+			name:    "success - unknown locale falls back to english",
+			d:       time.Minute,
+			options: HumanizeOptions{Locale: "xx"},
+			want:    "1 minute",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HumanizeWithOptions(tt.d, tt.options); got != tt.want {
+				t.Errorf("HumanizeWithOptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRelativeTime(t *testing.T) {
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		t       time.Time
+		options HumanizeOptions
+		want    string
+	}{
+		{name: "success - past", t: now.Add(-2 * time.Hour), want: "2 hours ago"},
+		{name: "success - future", t: now.Add(3 * 24 * time.Hour), want: "in 3 days"},
+		{name: "success - now", t: now.Add(-500 * time.Millisecond), want: "just now"},
+		{name: "success - past in portuguese", t: now.Add(-2 * time.Hour), options: HumanizeOptions{Locale: humanize.PortugueseBR}, want: "há 2 horas"},
+		{name: "success - future in portuguese", t: now.Add(time.Minute), options: HumanizeOptions{Locale: humanize.PortugueseBR}, want: "em 1 minuto"},
+		{name: "success - now in portuguese", t: now, options: HumanizeOptions{Locale: humanize.PortugueseBR}, want: "agora"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RelativeTimeWithOptions(tt.t, now, tt.options); got != tt.want {
+				t.Errorf("RelativeTimeWithOptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := RelativeTime(now.Add(-time.Minute), now); got != "1 minute ago" {
+		t.Errorf("RelativeTime() = %v, want %v", got, "1 minute ago")
+	}
+}
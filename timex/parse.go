@@ -0,0 +1,87 @@
+package timex
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Layouts is the prioritized list of layouts tried by Parse and ParseIn.
+// Day first layouts, like 02/01/2006, are preferred over month first ones.
+var Layouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"20060102",
+	"02/01/2006 15:04:05",
+	"02/01/2006 15:04",
+	"02/01/2006",
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC850,
+	time.ANSIC,
+}
+
+// unixMillisThreshold separates Unix timestamps in seconds from timestamps in milliseconds.
+// Timestamps in seconds reach it in the year 5138, timestamps in milliseconds passed it in 1973.
+const unixMillisThreshold = 100_000_000_000
+
+// Parse parses a date in any of the Layouts or as a Unix timestamp in seconds or milliseconds.
+// Layouts are tried first, so 20240115 is the 15th of January 2024 rather than a timestamp.
+// Dates without time zone information are interpreted as UTC.
+func Parse(value string) (time.Time, error) {
+	return ParseIn(value, time.UTC)
+}
+
+// ParseIn parses a date in any of the Layouts or as a Unix timestamp in seconds or milliseconds.
+// Dates without time zone information are interpreted in the provided location.
+func ParseIn(value string, loc *time.Location) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, fmt.Errorf("cannot parse empty date")
+	}
+
+	if loc == nil {
+		return time.Time{}, fmt.Errorf("location cannot be nil")
+	}
+
+	for _, layout := range Layouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	if isUnixTimestamp(value) {
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to parse unix timestamp %q: %w", value, err)
+		}
+
+		if n >= unixMillisThreshold || n <= -unixMillisThreshold {
+			return time.UnixMilli(n).In(loc), nil
+		}
+
+		return time.Unix(n, 0).In(loc), nil
+	}
+
+	return time.Time{}, fmt.Errorf("cannot parse date %q: unknown format", value)
+}
+
+func isUnixTimestamp(value string) bool {
+	digits := strings.TrimPrefix(value, "-")
+	if digits == "" {
+		return false
+	}
+
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,73 @@
+package timex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "success - rfc3339", value: "2024-10-01T12:30:00Z", want: time.Date(2024, 10, 1, 12, 30, 0, 0, time.UTC)},
+		{name: "success - rfc3339 with offset", value: "2024-10-01T09:30:00-03:00", want: time.Date(2024, 10, 1, 12, 30, 0, 0, time.UTC)},
+		{name: "success - rfc3339 with nanoseconds", value: "2024-10-01T12:30:00.123456789Z", want: time.Date(2024, 10, 1, 12, 30, 0, 123456789, time.UTC)},
+		{name: "success - iso date time without zone", value: "2024-10-01T12:30:00", want: time.Date(2024, 10, 1, 12, 30, 0, 0, time.UTC)},
+		{name: "success - sql date time", value: "2024-10-01 12:30:00", want: time.Date(2024, 10, 1, 12, 30, 0, 0, time.UTC)},
+		{name: "success - iso date", value: "2024-10-01", want: time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "success - day first date", value: "02/01/2024", want: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{name: "success - day first date time", value: "02/01/2024 08:15:00", want: time.Date(2024, 1, 2, 8, 15, 0, 0, time.UTC)},
+		{name: "success - rfc1123", value: "Tue, 01 Oct 2024 12:30:00 GMT", want: time.Date(2024, 10, 1, 12, 30, 0, 0, time.UTC)},
+		{name: "success - unix seconds", value: "1727785800", want: time.Date(2024, 10, 1, 12, 30, 0, 0, time.UTC)},
+		{name: "success - compact date", value: "20240115", want: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{name: "success - unix seconds not a compact date", value: "20241340", want: time.Unix(20241340, 0)},
+		{name: "success - unix milliseconds", value: "1727785800500", want: time.Date(2024, 10, 1, 12, 30, 0, 500000000, time.UTC)},
+		{name: "success - surrounding whitespace", value: " 2024-10-01 ", want: time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "fail - empty value", value: "", wantErr: true},
+		{name: "fail - unknown format", value: "October first", wantErr: true},
+		{name: "fail - invalid date", value: "2024-13-45", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseIn(t *testing.T) {
+	loc := time.FixedZone("BRT", -3*60*60)
+
+	got, err := ParseIn("01/10/2024 09:30", loc)
+	if err != nil {
+		t.Fatalf("ParseIn() error = %v", err)
+	}
+
+	if want := time.Date(2024, 10, 1, 12, 30, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("ParseIn() = %v, want %v", got, want)
+	}
+
+	if got.Location() != loc {
+		t.Errorf("ParseIn() location = %v, want %v", got.Location(), loc)
+	}
+
+	// explicit offsets take precedence over the location
+	got, err = ParseIn("2024-10-01T12:30:00Z", loc)
+	if err != nil || !got.Equal(time.Date(2024, 10, 1, 12, 30, 0, 0, time.UTC)) {
+		t.Errorf("ParseIn() = %v, %v", got, err)
+	}
+
+	if _, err := ParseIn("2024-10-01", nil); err == nil {
+		t.Errorf("ParseIn() expected error for nil location")
+	}
+}
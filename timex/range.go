@@ -0,0 +1,115 @@
+package timex
+
+import (
+	"fmt"
+	"time"
+)
+
+// Range is the half-open time interval [Start, End).
+type Range struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Step is the calendar unit used to iterate over a Range.
+type Step int
+
+const (
+	StepDay Step = iota
+	StepWeek
+	StepMonth
+)
+
+// NewRange returns the range [start, end), or an error if end is before start.
+func NewRange(start, end time.Time) (Range, error) {
+	if end.Before(start) {
+		return Range{}, fmt.Errorf("range end %v is before start %v", end, start)
+	}
+
+	return Range{Start: start, End: end}, nil
+}
+
+// Duration returns the length of the range.
+func (r Range) Duration() time.Duration {
+	return r.End.Sub(r.Start)
+}
+
+// IsEmpty reports whether the range contains no instant.
+func (r Range) IsEmpty() bool {
+	return !r.Start.Before(r.End)
+}
+
+// Contains reports whether t is within the range.
+func (r Range) Contains(t time.Time) bool {
+	return !t.Before(r.Start) && t.Before(r.End)
+}
+
+// Overlaps reports whether the ranges share at least one instant.
+func (r Range) Overlaps(other Range) bool {
+	return r.Start.Before(other.End) && other.Start.Before(r.End)
+}
+
+// Intersect returns the range shared by both ranges, or false if they do not overlap.
+func (r Range) Intersect(other Range) (Range, bool) {
+	if !r.Overlaps(other) {
+		return Range{}, false
+	}
+
+	start, end := r.Start, r.End
+	if other.Start.After(start) {
+		start = other.Start
+	}
+
+	if other.End.Before(end) {
+		end = other.End
+	}
+
+	return Range{Start: start, End: end}, true
+}
+
+// Split splits the range into consecutive ranges of length d, the last one may be shorter.
+func (r Range) Split(d time.Duration) ([]Range, error) {
+	if d <= 0 {
+		return nil, fmt.Errorf("split duration must be positive, got %v", d)
+	}
+
+	result := make([]Range, 0)
+	for start := r.Start; start.Before(r.End); start = start.Add(d) {
+		end := start.Add(d)
+		if end.After(r.End) {
+			end = r.End
+		}
+
+		result = append(result, Range{Start: start, End: end})
+	}
+
+	return result, nil
+}
+
+// Each returns the instants of the range starting at Start and advancing by step.
+// Months are added to Start each time, so day overflow does not accumulate.
+func (r Range) Each(step Step) []time.Time {
+	result := make([]time.Time, 0)
+	for i := 0; ; i++ {
+		t := r.at(step, i)
+		if !t.Before(r.End) {
+			break
+		}
+
+		result = append(result, t)
+	}
+
+	return result
+}
+
+// at returns the i-th instant of the range for the step.
+func (r Range) at(step Step, i int) time.Time {
+	switch step {
+	case StepWeek:
+		return r.Start.AddDate(0, 0, 7*i)
+	case StepMonth:
+		return r.Start.AddDate(0, i, 0)
+	default:
+		return r.Start.AddDate(0, 0, i)
+	}
+}
@@ -0,0 +1,20 @@
+//go:build go1.23
+
+package timex
+
+import (
+	"iter"
+	"time"
+)
+
+// EachSeq returns a sequence lazily yielding the instants of the range starting at Start and advancing by step.
+func (r Range) EachSeq(step Step) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		for i := 0; ; i++ {
+			t := r.at(step, i)
+			if !t.Before(r.End) || !yield(t) {
+				return
+			}
+		}
+	}
+}
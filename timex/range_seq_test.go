@@ -0,0 +1,36 @@
+//go:build go1.23
+
+package timex
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRangeEachSeq(t *testing.T) {
+	r := Range{Start: date(2024, time.October, 1), End: date(2024, time.December, 1)}
+
+	got := make([]time.Time, 0)
+	for d := range r.EachSeq(StepDay) {
+		if len(got) == 3 {
+			break
+		}
+
+		got = append(got, d)
+	}
+
+	want := []time.Time{date(2024, time.October, 1), date(2024, time.October, 2), date(2024, time.October, 3)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("EachSeq() = %v, want %v", got, want)
+	}
+
+	months := make([]time.Time, 0)
+	for m := range r.EachSeq(StepMonth) {
+		months = append(months, m)
+	}
+
+	if want := r.Each(StepMonth); !reflect.DeepEqual(months, want) {
+		t.Errorf("EachSeq() = %v, want %v", months, want)
+	}
+}
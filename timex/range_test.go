@@ -0,0 +1,162 @@
+package timex
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewRange(t *testing.T) {
+	if _, err := NewRange(date(2024, time.October, 2), date(2024, time.October, 1)); err == nil {
+		t.Errorf("NewRange() expected error for end before start")
+	}
+
+	r, err := NewRange(date(2024, time.October, 1), date(2024, time.October, 2))
+	if err != nil {
+		t.Fatalf("NewRange() error = %v", err)
+	}
+
+	if r.Duration() != 24*time.Hour {
+		t.Errorf("Duration() = %v, want %v", r.Duration(), 24*time.Hour)
+	}
+
+	if r.IsEmpty() {
+		t.Errorf("IsEmpty() = true, want false")
+	}
+}
+
+func TestRangeContains(t *testing.T) {
+	r := Range{Start: date(2024, time.October, 1), End: date(2024, time.October, 10)}
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want bool
+	}{
+		{name: "success - start is included", t: date(2024, time.October, 1), want: true},
+		{name: "success - inside", t: date(2024, time.October, 5), want: true},
+		{name: "success - end is excluded", t: date(2024, time.October, 10), want: false},
+		{name: "success - before", t: date(2024, time.September, 30), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.Contains(tt.t); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangeIntersect(t *testing.T) {
+	r := Range{Start: date(2024, time.October, 1), End: date(2024, time.October, 10)}
+
+	tests := []struct {
+		name   string
+		other  Range
+		want   Range
+		wantOk bool
+	}{
+		{
+			name:   "success - partial overlap",
+			other:  Range{Start: date(2024, time.October, 5), End: date(2024, time.October, 15)},
+			want:   Range{Start: date(2024, time.October, 5), End: date(2024, time.October, 10)},
+			wantOk: true,
+		},
+		{
+			name:   "success - contained",
+			other:  Range{Start: date(2024, time.October, 2), End: date(2024, time.October, 3)},
+			want:   Range{Start: date(2024, time.October, 2), End: date(2024, time.October, 3)},
+			wantOk: true,
+		},
+		{
+			name:   "success - adjacent ranges do not overlap",
+			other:  Range{Start: date(2024, time.October, 10), End: date(2024, time.October, 12)},
+			wantOk: false,
+		},
+		{
+			name:   "success - disjoint",
+			other:  Range{Start: date(2024, time.November, 1), End: date(2024, time.November, 2)},
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.Overlaps(tt.other); got != tt.wantOk {
+				t.Errorf("Overlaps() = %v, want %v", got, tt.wantOk)
+			}
+
+			got, ok := r.Intersect(tt.other)
+			if ok != tt.wantOk {
+				t.Errorf("Intersect() ok = %v, want %v", ok, tt.wantOk)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Intersect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangeSplit(t *testing.T) {
+	r := Range{Start: date(2024, time.October, 1), End: date(2024, time.October, 1).Add(5 * time.Hour)}
+
+	got, err := r.Split(2 * time.Hour)
+	if err != nil {
+		t.Fatalf("Split() error = %v", err)
+	}
+
+	start := date(2024, time.October, 1)
+	want := []Range{
+		{Start: start, End: start.Add(2 * time.Hour)},
+		{Start: start.Add(2 * time.Hour), End: start.Add(4 * time.Hour)},
+		{Start: start.Add(4 * time.Hour), End: start.Add(5 * time.Hour)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Split() = %v, want %v", got, want)
+	}
+
+	if _, err := r.Split(0); err == nil {
+		t.Errorf("Split() expected error for zero duration")
+	}
+}
+
+func TestRangeEach(t *testing.T) {
+	tests := []struct {
+		name string
+		r    Range
+		step Step
+		want []time.Time
+	}{
+		{
+			name: "success - days",
+			r:    Range{Start: date(2024, time.October, 30), End: date(2024, time.November, 2)},
+			step: StepDay,
+			want: []time.Time{date(2024, time.October, 30), date(2024, time.October, 31), date(2024, time.November, 1)},
+		},
+		{
+			name: "success - weeks",
+			r:    Range{Start: date(2024, time.October, 1), End: date(2024, time.October, 16)},
+			step: StepWeek,
+			want: []time.Time{date(2024, time.October, 1), date(2024, time.October, 8), date(2024, time.October, 15)},
+		},
+		{
+			name: "success - months",
+			r:    Range{Start: date(2024, time.January, 15), End: date(2024, time.April, 1)},
+			step: StepMonth,
+			want: []time.Time{date(2024, time.January, 15), date(2024, time.February, 15), date(2024, time.March, 15)},
+		},
+		{
+			name: "success - empty range",
+			r:    Range{Start: date(2024, time.October, 1), End: date(2024, time.October, 1)},
+			step: StepDay,
+			want: []time.Time{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Each(tt.step); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Each() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
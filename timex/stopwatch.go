@@ -0,0 +1,92 @@
+package timex
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Lap is a named segment measured by a Stopwatch.
+type Lap struct {
+	Name     string
+	Duration time.Duration
+}
+
+// Stopwatch measures the elapsed time and named segments of an operation. It is safe for concurrent use.
+type Stopwatch struct {
+	mu    sync.Mutex
+	start time.Time
+	last  time.Time
+	laps  []Lap
+	clock Clock
+}
+
+// NewStopwatch returns a started Stopwatch.
+func NewStopwatch() *Stopwatch {
+	return NewStopwatchWithClock(RealClock{})
+}
+
+// NewStopwatchWithClock returns a started Stopwatch measuring time with clock.
+func NewStopwatchWithClock(clock Clock) *Stopwatch {
+	start := clock.Now()
+
+	return &Stopwatch{start: start, last: start, clock: clock}
+}
+
+// Lap records the segment since the previous lap, or since the start, under name and returns its duration.
+// It can be deferred, e.g. defer sw.Lap("cleanup").
+func (s *Stopwatch) Lap(name string) time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.clock.Now()
+	d := now.Sub(s.last)
+	s.last = now
+	s.laps = append(s.laps, Lap{Name: name, Duration: d})
+
+	return d
+}
+
+// Elapsed returns the time since the stopwatch was started.
+func (s *Stopwatch) Elapsed() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.clock.Now().Sub(s.start)
+}
+
+// Laps returns a copy of the recorded laps in order.
+func (s *Stopwatch) Laps() []Lap {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	laps := make([]Lap, len(s.laps))
+	copy(laps, s.laps)
+
+	return laps
+}
+
+// Reset restarts the stopwatch and clears the recorded laps.
+func (s *Stopwatch) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.start = s.clock.Now()
+	s.last = s.start
+	s.laps = nil
+}
+
+// Report returns one line per lap followed by the total elapsed time, e.g. "load: 1.5s\ntotal: 1.5s".
+func (s *Stopwatch) Report() string {
+	laps, total := s.Laps(), s.Elapsed()
+
+	var b strings.Builder
+	for _, lap := range laps {
+		fmt.Fprintf(&b, "%s: %v\n", lap.Name, lap.Duration)
+	}
+
+	fmt.Fprintf(&b, "total: %v", total)
+
+	return b.String()
+}
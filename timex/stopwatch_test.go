@@ -0,0 +1,72 @@
+package timex
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestStopwatch(t *testing.T) {
+	clock := NewFakeClock(time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC))
+	sw := NewStopwatchWithClock(clock)
+
+	clock.Advance(time.Second)
+	if got := sw.Lap("load"); got != time.Second {
+		t.Errorf("Lap() = %v, want %v", got, time.Second)
+	}
+
+	clock.Advance(time.Second)
+	if got := sw.Lap("process"); got != time.Second {
+		t.Errorf("Lap() = %v, want %v", got, time.Second)
+	}
+
+	want := []Lap{{Name: "load", Duration: time.Second}, {Name: "process", Duration: time.Second}}
+	if got := sw.Laps(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Laps() = %v, want %v", got, want)
+	}
+
+	clock.Advance(time.Second)
+	if got := sw.Elapsed(); got != 3*time.Second {
+		t.Errorf("Elapsed() = %v, want %v", got, 3*time.Second)
+	}
+
+	if got, want := sw.Report(), "load: 1s\nprocess: 1s\ntotal: 3s"; got != want {
+		t.Errorf("Report() = %q, want %q", got, want)
+	}
+
+	sw.Reset()
+	if got := sw.Laps(); len(got) != 0 {
+		t.Errorf("Laps() after Reset() = %v, want empty", got)
+	}
+}
+
+func TestStopwatchDeferred(t *testing.T) {
+	sw := NewStopwatchWithClock(NewFakeClock(time.Now()))
+
+	func() {
+		defer sw.Lap("deferred")
+	}()
+
+	if got := sw.Laps(); len(got) != 1 || got[0].Name != "deferred" {
+		t.Errorf("Laps() = %v, want one deferred lap", got)
+	}
+}
+
+func TestStopwatchConcurrent(t *testing.T) {
+	sw := NewStopwatch()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sw.Lap("worker")
+		}()
+	}
+	wg.Wait()
+
+	if got := len(sw.Laps()); got != 10 {
+		t.Errorf("len(Laps()) = %v, want %v", got, 10)
+	}
+}
@@ -0,0 +1,78 @@
+package timex
+
+import "time"
+
+// StartOfDay returns midnight of the day of t in loc. A nil loc uses the location of t.
+func StartOfDay(t time.Time, loc *time.Location) time.Time {
+	t = in(t, loc)
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// EndOfDay returns the last nanosecond of the day of t in loc.
+func EndOfDay(t time.Time, loc *time.Location) time.Time {
+	return end(StartOfDay(t, loc).AddDate(0, 0, 1))
+}
+
+// StartOfWeek returns midnight of the first day of the week of t in loc, weeks starting on weekStart.
+func StartOfWeek(t time.Time, loc *time.Location, weekStart time.Weekday) time.Time {
+	start := StartOfDay(t, loc)
+	offset := (int(start.Weekday()) - int(weekStart) + 7) % 7
+
+	return start.AddDate(0, 0, -offset)
+}
+
+// EndOfWeek returns the last nanosecond of the week of t in loc, weeks starting on weekStart.
+func EndOfWeek(t time.Time, loc *time.Location, weekStart time.Weekday) time.Time {
+	return end(StartOfWeek(t, loc, weekStart).AddDate(0, 0, 7))
+}
+
+// StartOfMonth returns midnight of the first day of the month of t in loc.
+func StartOfMonth(t time.Time, loc *time.Location) time.Time {
+	t = in(t, loc)
+
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfMonth returns the last nanosecond of the month of t in loc.
+func EndOfMonth(t time.Time, loc *time.Location) time.Time {
+	return end(StartOfMonth(t, loc).AddDate(0, 1, 0))
+}
+
+// StartOfQuarter returns midnight of the first day of the quarter of t in loc.
+func StartOfQuarter(t time.Time, loc *time.Location) time.Time {
+	t = in(t, loc)
+	month := time.Month((int(t.Month())-1)/3*3 + 1)
+
+	return time.Date(t.Year(), month, 1, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfQuarter returns the last nanosecond of the quarter of t in loc.
+func EndOfQuarter(t time.Time, loc *time.Location) time.Time {
+	return end(StartOfQuarter(t, loc).AddDate(0, 3, 0))
+}
+
+// StartOfYear returns midnight of the first day of the year of t in loc.
+func StartOfYear(t time.Time, loc *time.Location) time.Time {
+	t = in(t, loc)
+
+	return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfYear returns the last nanosecond of the year of t in loc.
+func EndOfYear(t time.Time, loc *time.Location) time.Time {
+	return end(StartOfYear(t, loc).AddDate(1, 0, 0))
+}
+
+func in(t time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		return t
+	}
+
+	return t.In(loc)
+}
+
+// end returns the instant right before the start of the next period.
+func end(next time.Time) time.Time {
+	return next.Add(-time.Nanosecond)
+}
@@ -0,0 +1,40 @@
+package timex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStartEndOf(t *testing.T) {
+	brt := time.FixedZone("BRT", -3*60*60)
+	// 2024-10-02 01:30 UTC is still 2024-10-01 in BRT
+	input := time.Date(2024, time.October, 2, 1, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		got  time.Time
+		want time.Time
+	}{
+		{name: "success - start of day in location", got: StartOfDay(input, brt), want: time.Date(2024, time.October, 1, 0, 0, 0, 0, brt)},
+		{name: "success - start of day nil location", got: StartOfDay(input, nil), want: time.Date(2024, time.October, 2, 0, 0, 0, 0, time.UTC)},
+		{name: "success - end of day", got: EndOfDay(input, brt), want: time.Date(2024, time.October, 1, 23, 59, 59, 999999999, brt)},
+		{name: "success - start of week on monday", got: StartOfWeek(input, brt, time.Monday), want: time.Date(2024, time.September, 30, 0, 0, 0, 0, brt)},
+		{name: "success - start of week on sunday", got: StartOfWeek(input, brt, time.Sunday), want: time.Date(2024, time.September, 29, 0, 0, 0, 0, brt)},
+		{name: "success - start of week on its first day", got: StartOfWeek(time.Date(2024, time.September, 30, 12, 0, 0, 0, brt), brt, time.Monday), want: time.Date(2024, time.September, 30, 0, 0, 0, 0, brt)},
+		{name: "success - end of week", got: EndOfWeek(input, brt, time.Monday), want: time.Date(2024, time.October, 6, 23, 59, 59, 999999999, brt)},
+		{name: "success - start of month", got: StartOfMonth(input, brt), want: time.Date(2024, time.October, 1, 0, 0, 0, 0, brt)},
+		{name: "success - end of month", got: EndOfMonth(time.Date(2024, time.February, 10, 0, 0, 0, 0, brt), brt), want: time.Date(2024, time.February, 29, 23, 59, 59, 999999999, brt)},
+		{name: "success - start of quarter", got: StartOfQuarter(input, brt), want: time.Date(2024, time.October, 1, 0, 0, 0, 0, brt)},
+		{name: "success - start of quarter mid quarter", got: StartOfQuarter(time.Date(2024, time.May, 20, 0, 0, 0, 0, brt), brt), want: time.Date(2024, time.April, 1, 0, 0, 0, 0, brt)},
+		{name: "success - end of quarter", got: EndOfQuarter(input, brt), want: time.Date(2024, time.December, 31, 23, 59, 59, 999999999, brt)},
+		{name: "success - start of year", got: StartOfYear(input, brt), want: time.Date(2024, time.January, 1, 0, 0, 0, 0, brt)},
+		{name: "success - end of year", got: EndOfYear(input, brt), want: time.Date(2024, time.December, 31, 23, 59, 59, 999999999, brt)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ibanLengths is the length of the IBANs of each country in the SWIFT IBAN registry.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BI": 27,
+	"BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28,
+	"EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23,
+	"GL": 18, "GR": 27, "GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+	"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "LY": 25,
+	"MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27, "MT": 31, "MU": 30, "NI": 28, "NL": 18,
+	"NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33,
+	"SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+// IBAN reports whether s is a valid International Bank Account Number: a known country code, the
+// length of that country and a valid ISO 7064 mod 97-10 check. Spaces are ignored and letters may be lowercase.
+func IBAN(s string) bool {
+	iban := strings.ToUpper(strip(s, " "))
+	if len(iban) < 5 || ibanLengths[iban[:2]] != len(iban) || !isDigits(iban[2:4]) {
+		return false
+	}
+
+	// The check moves the first four characters to the end and reads letters as 10 to 35.
+	remainder := 0
+	for _, c := range iban[4:] + iban[:4] {
+		switch {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		default:
+			return false
+		}
+	}
+
+	return remainder == 1
+}
+
+// Brazilian bank codes (COMPE) supported by BRBankAccount.
+const (
+	BankBancoDoBrasil = "001"
+	BankBradesco      = "237"
+	BankItau          = "341"
+	BankCaixa         = "104"
+)
+
+var (
+	// ErrUnsupportedBank is returned by BRBankAccount for banks without known check digit rules.
+	ErrUnsupportedBank = errors.New("unsupported bank")
+	// ErrBankBranch is returned by BRBankAccount for invalid branch numbers.
+	ErrBankBranch = errors.New("invalid bank branch")
+	// ErrBankAccount is returned by BRBankAccount for invalid account numbers.
+	ErrBankAccount = errors.New("invalid bank account")
+)
+
+// brBank holds the formats and check digit rules of a Brazilian bank.
+type brBank struct {
+	accountLength int                               // Digits of the account without check digit, left padded with zeros
+	branchDigit   func(branch string) byte          // Check digit of the branch, nil if branches have none
+	accountDigit  func(branch, account string) byte // Check digit of the account
+}
+
+var brBanks = map[string]brBank{
+	BankBancoDoBrasil: {
+		accountLength: 8,
+		branchDigit:   func(branch string) byte { return mod11Digit(branch, []int{5, 4, 3, 2}, 'X') },
+		accountDigit: func(_, account string) byte {
+			return mod11Digit(account, []int{9, 8, 7, 6, 5, 4, 3, 2}, 'X')
+		},
+	},
+	BankBradesco: {
+		accountLength: 7,
+		branchDigit:   func(branch string) byte { return mod11Digit(branch, []int{5, 4, 3, 2}, 'P') },
+		accountDigit: func(_, account string) byte {
+			return mod11Digit(account, []int{2, 7, 6, 5, 4, 3, 2}, 'P')
+		},
+	},
+	BankItau: {
+		accountLength: 5,
+		accountDigit: func(branch, account string) byte {
+			// Luhn style modulo 10 over the branch and account.
+			sum := 0
+			for i, c := range branch + account {
+				d := int(c-'0') * (2 - i%2)
+				sum += d/10 + d%10
+			}
+
+			return byte('0' + (10-sum%10)%10)
+		},
+	},
+	BankCaixa: {
+		accountLength: 11, // Three digit operation code followed by the account number
+		accountDigit: func(branch, account string) byte {
+			weights := []int{8, 7, 6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+
+			sum := 0
+			for i, c := range branch + account {
+				sum += int(c-'0') * weights[i]
+			}
+
+			return byte('0' + sum*10%11%10)
+		},
+	},
+}
+
+// BRBankAccount validates the check digits of a Brazilian bank branch and account, written as
+// "1584-9" and "00210169-6". bank is the COMPE code, such as BankItau. Branches have four digits and
+// a check digit only for Banco do Brasil and Bradesco, where it is optional. Accounts must end with their
+// check digit and are left padded with zeros; Caixa accounts include the three digit operation code.
+// Errors are ErrUnsupportedBank, ErrBankBranch or ErrBankAccount.
+func BRBankAccount(bank, branch, account string) error {
+	b, ok := brBanks[fmt.Sprintf("%03s", strings.TrimSpace(bank))]
+	if !ok {
+		return fmt.Errorf("%w %q", ErrUnsupportedBank, bank)
+	}
+
+	branchNumber, branchDigit, hasDigit := splitDigit(branch)
+	if len(branchNumber) != 4 || !isDigits(branchNumber) || (hasDigit && b.branchDigit == nil) ||
+		(hasDigit && branchDigit != b.branchDigit(branchNumber)) {
+		return fmt.Errorf("%w %q", ErrBankBranch, branch)
+	}
+
+	accountNumber, accountDigit, hasDigit := splitDigit(account)
+	if !hasDigit || accountNumber == "" || len(accountNumber) > b.accountLength || !isDigits(accountNumber) {
+		return fmt.Errorf("%w %q", ErrBankAccount, account)
+	}
+
+	accountNumber = strings.Repeat("0", b.accountLength-len(accountNumber)) + accountNumber
+	if accountDigit != b.accountDigit(branchNumber, accountNumber) {
+		return fmt.Errorf("%w %q", ErrBankAccount, account)
+	}
+
+	return nil
+}
+
+// splitDigit splits "1234-5" into "1234" and '5'. Numbers without dash have no check digit.
+func splitDigit(s string) (string, byte, bool) {
+	number, digit, ok := strings.Cut(strings.TrimSpace(s), "-")
+	if !ok {
+		return number, 0, false
+	}
+
+	if len(digit) != 1 {
+		return number, 0, true // An invalid digit never matches a computed one
+	}
+
+	return number, strings.ToUpper(digit)[0], true
+}
+
+// mod11Digit computes a modulo 11 check digit as 11 minus the remainder of the weighted sum, where
+// 11 gives '0' and 10 gives ten, the letter used by the bank.
+func mod11Digit(s string, weights []int, ten byte) byte {
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		sum += int(s[i]-'0') * weights[i]
+	}
+
+	switch d := 11 - sum%11; d {
+	case 11:
+		return '0'
+	case 10:
+		return ten
+	default:
+		return byte('0' + d)
+	}
+}
@@ -0,0 +1,76 @@
+package validate
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIBAN(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{name: "success - gb", input: "GB82 WEST 1234 5698 7654 32", want: true},
+		{name: "success - de", input: "DE89370400440532013000", want: true},
+		{name: "success - fr with letters", input: "FR14 2004 1010 0505 0001 3M02 606", want: true},
+		{name: "success - br", input: "BR1800360305000010009795493C1", want: true},
+		{name: "success - no shortest", input: "NO9386011117947", want: true},
+		{name: "success - lowercase", input: "gb82west12345698765432", want: true},
+		{name: "fail - check digits", input: "GB83 WEST 1234 5698 7654 32"},
+		{name: "fail - transposed digits", input: "GB82 WEST 1234 5698 7654 23"},
+		{name: "fail - wrong length for country", input: "GB82 WEST 1234 5698 7654 3"},
+		{name: "fail - unknown country", input: "XX82WEST12345698765432"},
+		{name: "fail - letters in check digits", input: "GBA2WEST12345698765432"},
+		{name: "fail - symbol", input: "GB82-WEST-1234-5698-7654"},
+		{name: "fail - empty", input: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IBAN(tt.input); got != tt.want {
+				t.Errorf("IBAN(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBRBankAccount(t *testing.T) {
+	tests := []struct {
+		name    string
+		bank    string
+		branch  string
+		account string
+		wantErr error
+	}{
+		{name: "success - banco do brasil", bank: BankBancoDoBrasil, branch: "1584-9", account: "00210169-6"},
+		{name: "success - banco do brasil unpadded", bank: "1", branch: "1584", account: "210169-6"},
+		{name: "success - banco do brasil x digit", bank: BankBancoDoBrasil, branch: "0040-x", account: "1-9"},
+		{name: "success - bradesco", bank: BankBradesco, branch: "1465-6", account: "0238069-2"},
+		{name: "success - bradesco p digit", bank: BankBradesco, branch: "0040-P", account: "0000001-9"},
+		{name: "success - itau", bank: BankItau, branch: "2545", account: "02366-1"},
+		{name: "success - caixa", bank: BankCaixa, branch: "2004", account: "00100000448-6"},
+		{name: "fail - unsupported bank", bank: "999", branch: "0001", account: "1-1", wantErr: ErrUnsupportedBank},
+		{name: "fail - banco do brasil branch digit", bank: BankBancoDoBrasil, branch: "1584-8", account: "00210169-6",
+			wantErr: ErrBankBranch},
+		{name: "fail - banco do brasil account digit", bank: BankBancoDoBrasil, branch: "1584-9", account: "00210169-5",
+			wantErr: ErrBankAccount},
+		{name: "fail - bradesco account digit", bank: BankBradesco, branch: "1465", account: "0238069-3",
+			wantErr: ErrBankAccount},
+		{name: "fail - itau account digit", bank: BankItau, branch: "2545", account: "02366-2", wantErr: ErrBankAccount},
+		{name: "fail - itau branch digit", bank: BankItau, branch: "2545-1", account: "02366-1", wantErr: ErrBankBranch},
+		{name: "fail - caixa account digit", bank: BankCaixa, branch: "2004", account: "00100000448-7",
+			wantErr: ErrBankAccount},
+		{name: "fail - short branch", bank: BankItau, branch: "254", account: "02366-1", wantErr: ErrBankBranch},
+		{name: "fail - missing account digit", bank: BankItau, branch: "2545", account: "02366", wantErr: ErrBankAccount},
+		{name: "fail - long account digit", bank: BankItau, branch: "2545", account: "02366-11", wantErr: ErrBankAccount},
+		{name: "fail - account too long", bank: BankItau, branch: "2545", account: "023660-1", wantErr: ErrBankAccount},
+		{name: "fail - letters in account", bank: BankItau, branch: "2545", account: "0236A-1", wantErr: ErrBankAccount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := BRBankAccount(tt.bank, tt.branch, tt.account); !errors.Is(err, tt.wantErr) {
+				t.Errorf("BRBankAccount() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Package validate defines validators and normalizers for common identifiers and user input.
+*/
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+var (
+	cpfWeights  = []int{11, 10, 9, 8, 7, 6, 5, 4, 3, 2}
+	cnpjWeights = []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+)
+
+// CPF reports whether s is a valid Brazilian individual taxpayer number, formatted ("529.982.247-25")
+// or not ("52998224725"). Numbers made of a single repeated digit, which pass the check digits, are rejected.
+func CPF(s string) bool {
+	cpf := StripCPF(s)
+	if len(cpf) != 11 || !isDigits(cpf) || isRepeated(cpf) {
+		return false
+	}
+
+	return checkDigit(cpf[:9], cpfWeights[1:]) == cpf[9] && checkDigit(cpf[:10], cpfWeights) == cpf[10]
+}
+
+// CNPJ reports whether s is a valid Brazilian company taxpayer number, formatted ("11.222.333/0001-81")
+// or not ("11222333000181"). The alphanumeric format introduced in 2026, where the first twelve characters
+// may be uppercase letters, is accepted. Numbers made of a single repeated character are rejected.
+func CNPJ(s string) bool {
+	cnpj := StripCNPJ(s)
+	if len(cnpj) != 14 || !isDigits(cnpj[12:]) || isRepeated(cnpj) {
+		return false
+	}
+
+	for i := 0; i < 12; i++ {
+		if !isDigit(cnpj[i]) && (cnpj[i] < 'A' || cnpj[i] > 'Z') {
+			return false
+		}
+	}
+
+	return checkDigit(cnpj[:12], cnpjWeights[1:]) == cnpj[12] && checkDigit(cnpj[:13], cnpjWeights) == cnpj[13]
+}
+
+// StripCPF removes the dots, dashes and spaces of a formatted CPF.
+func StripCPF(s string) string {
+	return strip(s, ".- ")
+}
+
+// StripCNPJ removes the dots, slashes, dashes and spaces of a formatted CNPJ.
+func StripCNPJ(s string) string {
+	return strip(s, "./- ")
+}
+
+// FormatCPF returns a valid CPF formatted as "000.000.000-00".
+func FormatCPF(s string) (string, error) {
+	if !CPF(s) {
+		return "", fmt.Errorf("invalid CPF %q", s)
+	}
+
+	cpf := StripCPF(s)
+
+	return cpf[:3] + "." + cpf[3:6] + "." + cpf[6:9] + "-" + cpf[9:], nil
+}
+
+// FormatCNPJ returns a valid CNPJ formatted as "00.000.000/0000-00".
+func FormatCNPJ(s string) (string, error) {
+	if !CNPJ(s) {
+		return "", fmt.Errorf("invalid CNPJ %q", s)
+	}
+
+	cnpj := StripCNPJ(s)
+
+	return cnpj[:2] + "." + cnpj[2:5] + "." + cnpj[5:8] + "/" + cnpj[8:12] + "-" + cnpj[12:], nil
+}
+
+// checkDigit computes the modulo 11 check digit of s with weights. Characters are valued by their
+// offset from '0', which is the digit value for digits and the value defined for alphanumeric CNPJs for letters.
+func checkDigit(s string, weights []int) byte {
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		sum += int(s[i]-'0') * weights[i]
+	}
+
+	if r := sum % 11; r >= 2 {
+		return byte('0' + 11 - r)
+	}
+
+	return '0'
+}
+
+func strip(s, cutset string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(cutset, r) {
+			return -1
+		}
+
+		return r
+	}, s)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isRepeated(s string) bool {
+	return strings.Count(s, s[:1]) == len(s)
+}
@@ -0,0 +1,112 @@
+package validate
+
+import "testing"
+
+func TestCPF(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{name: "success - formatted", input: "529.982.247-25", want: true},
+		{name: "success - digits only", input: "52998224725", want: true},
+		{name: "success - check digit zero", input: "111.444.777-35", want: true},
+		{name: "fail - wrong first check digit", input: "529.982.247-15"},
+		{name: "fail - wrong second check digit", input: "529.982.247-26"},
+		{name: "fail - repeated digits", input: "111.111.111-11"},
+		{name: "fail - too short", input: "5299822472"},
+		{name: "fail - letters", input: "529.982.24A-25"},
+		{name: "fail - empty", input: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CPF(tt.input); got != tt.want {
+				t.Errorf("CPF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCNPJ(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{name: "success - formatted", input: "11.222.333/0001-81", want: true},
+		{name: "success - digits only", input: "11222333000181", want: true},
+		{name: "success - alphanumeric", input: "12.ABC.345/01DE-35", want: true},
+		{name: "fail - wrong check digit", input: "11.222.333/0001-82"},
+		{name: "fail - repeated digits", input: "00.000.000/0000-00"},
+		{name: "fail - lowercase letters", input: "12.abc.345/01de-35"},
+		{name: "fail - letter in check digits", input: "12.ABC.345/01DE-3A"},
+		{name: "fail - too long", input: "112223330001810"},
+		{name: "fail - empty", input: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CNPJ(tt.input); got != tt.want {
+				t.Errorf("CNPJ() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatCPF(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "success - digits only", input: "52998224725", want: "529.982.247-25"},
+		{name: "success - already formatted", input: "529.982.247-25", want: "529.982.247-25"},
+		{name: "success - spaces", input: " 529 982 247 25 ", want: "529.982.247-25"},
+		{name: "fail - invalid", input: "52998224726", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatCPF(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FormatCPF() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("FormatCPF() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatCNPJ(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "success - digits only", input: "11222333000181", want: "11.222.333/0001-81"},
+		{name: "success - alphanumeric", input: "12ABC34501DE35", want: "12.ABC.345/01DE-35"},
+		{name: "fail - invalid", input: "11222333000180", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatCNPJ(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FormatCNPJ() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("FormatCNPJ() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrip(t *testing.T) {
+	if got := StripCPF("529.982.247-25"); got != "52998224725" {
+		t.Errorf("StripCPF() = %v, want 52998224725", got)
+	}
+
+	if got := StripCNPJ("11.222.333/0001-81"); got != "11222333000181" {
+		t.Errorf("StripCNPJ() = %v, want 11222333000181", got)
+	}
+}
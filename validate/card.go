@@ -0,0 +1,148 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Brand is the brand of a payment card.
+type Brand string
+
+// Brands detected by CardNumber.
+const (
+	BrandUnknown    Brand = "unknown"
+	BrandVisa       Brand = "visa"
+	BrandMastercard Brand = "mastercard"
+	BrandAmex       Brand = "amex"
+	BrandElo        Brand = "elo"
+	BrandHipercard  Brand = "hipercard"
+)
+
+var (
+	// ErrCardNumber is returned for card numbers with an invalid format or check digit.
+	ErrCardNumber = errors.New("invalid card number")
+	// ErrCardExpiry is returned for malformed card expiry dates.
+	ErrCardExpiry = errors.New("invalid card expiry")
+	// ErrCardExpired is returned for card expiry dates in the past.
+	ErrCardExpired = errors.New("card is expired")
+)
+
+// prefixRange is an inclusive range of card number prefixes of the same length.
+type prefixRange struct {
+	low, high string
+}
+
+type brandRule struct {
+	brand    Brand
+	prefixes []prefixRange
+	lengths  []int
+}
+
+// brandRules are checked in order, as Elo and Hipercard ranges overlap Visa, Mastercard and Discover ones.
+var brandRules = []brandRule{
+	{brand: BrandElo, lengths: []int{16}, prefixes: []prefixRange{
+		{"401178", "401179"}, {"431274", "431274"}, {"438935", "438935"}, {"451416", "451416"},
+		{"457393", "457393"}, {"457631", "457632"}, {"504175", "504175"}, {"506699", "506778"},
+		{"509000", "509999"}, {"627780", "627780"}, {"636297", "636297"}, {"636368", "636368"},
+		{"650031", "650033"}, {"650035", "650051"}, {"650405", "650439"}, {"650485", "650538"},
+		{"650541", "650598"}, {"650700", "650718"}, {"650720", "650727"}, {"650901", "650978"},
+		{"651652", "651679"}, {"655000", "655019"}, {"655021", "655058"},
+	}},
+	{brand: BrandHipercard, lengths: []int{13, 16, 19}, prefixes: []prefixRange{
+		{"384100", "384100"}, {"384140", "384140"}, {"384160", "384160"}, {"606282", "606282"},
+		{"637095", "637095"}, {"637568", "637568"}, {"637599", "637599"}, {"637609", "637609"},
+		{"637612", "637612"},
+	}},
+	{brand: BrandAmex, lengths: []int{15}, prefixes: []prefixRange{{"34", "34"}, {"37", "37"}}},
+	{brand: BrandMastercard, lengths: []int{16}, prefixes: []prefixRange{{"51", "55"}, {"2221", "2720"}}},
+	{brand: BrandVisa, lengths: []int{13, 16, 19}, prefixes: []prefixRange{{"4", "4"}}},
+}
+
+// CardNumber validates a payment card number, ignoring spaces and dashes, and returns its brand.
+// The number must have 12 to 19 digits and a valid Luhn check digit. Valid numbers of brands that are
+// not recognized, or with a length not issued by their brand, return BrandUnknown.
+func CardNumber(s string) (Brand, error) {
+	number := strip(s, " -")
+	if len(number) < 12 || len(number) > 19 || !isDigits(number) || !luhn(number) {
+		return "", ErrCardNumber
+	}
+
+	for _, rule := range brandRules {
+		if rule.matches(number) {
+			if !containsInt(rule.lengths, len(number)) {
+				return BrandUnknown, nil
+			}
+
+			return rule.brand, nil
+		}
+	}
+
+	return BrandUnknown, nil
+}
+
+func (r brandRule) matches(number string) bool {
+	for _, p := range r.prefixes {
+		prefix := number[:len(p.low)]
+		if prefix >= p.low && prefix <= p.high {
+			return true
+		}
+	}
+
+	return false
+}
+
+func luhn(number string) bool {
+	sum := 0
+	for i := 0; i < len(number); i++ {
+		d := int(number[len(number)-1-i] - '0')
+		if i%2 == 1 {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+
+		sum += d
+	}
+
+	return sum%10 == 0
+}
+
+func containsInt(values []int, v int) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CardExpiry validates a card expiry date formatted as "MM/YY" or "MM/YYYY". Cards are valid through
+// the last day of their expiry month, in the location of now. Errors are ErrCardExpiry or ErrCardExpired.
+func CardExpiry(s string, now time.Time) error {
+	m, y, ok := strings.Cut(strings.TrimSpace(s), "/")
+	if !ok || len(m) != 2 || (len(y) != 2 && len(y) != 4) || !isDigits(m) || !isDigits(y) {
+		return fmt.Errorf("%w %q", ErrCardExpiry, s)
+	}
+
+	month, _ := strconv.Atoi(m)
+	year, _ := strconv.Atoi(y)
+
+	if month < 1 || month > 12 {
+		return fmt.Errorf("%w %q", ErrCardExpiry, s)
+	}
+
+	if len(y) == 2 {
+		year += now.Year() / 100 * 100
+	}
+
+	// The first instant after the expiry month.
+	if !now.Before(time.Date(year, time.Month(month)+1, 1, 0, 0, 0, 0, now.Location())) {
+		return ErrCardExpired
+	}
+
+	return nil
+}
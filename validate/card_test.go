@@ -0,0 +1,83 @@
+package validate
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCardNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Brand
+		wantErr bool
+	}{
+		{name: "success - visa", input: "4111 1111 1111 1111", want: BrandVisa},
+		{name: "success - visa 13 digits", input: "4222222222222", want: BrandVisa},
+		{name: "success - mastercard", input: "5555-5555-5555-4444", want: BrandMastercard},
+		{name: "success - mastercard 2 series low", input: "2221000000000009", want: BrandMastercard},
+		{name: "success - mastercard 2 series high", input: "2720999999999996", want: BrandMastercard},
+		{name: "success - amex", input: "3782 822463 10005", want: BrandAmex},
+		{name: "success - elo", input: "6362970000457013", want: BrandElo},
+		{name: "success - elo in visa range", input: "4011780000000006", want: BrandElo},
+		{name: "success - elo in visa range 451416", input: "4514160000000003", want: BrandElo},
+		{name: "success - elo 509", input: "5091000000000009", want: BrandElo},
+		{name: "success - elo 650", input: "6504870000000004", want: BrandElo},
+		{name: "success - hipercard", input: "6062825624254001", want: BrandHipercard},
+		{name: "success - hipercard 19 digits", input: "3841000000000000004", want: BrandHipercard},
+		{name: "success - unknown brand", input: "6011111111111117", want: BrandUnknown},
+		{name: "success - hipercard 3841", input: "3841000000000007", want: BrandHipercard},
+		{name: "success - amex with invalid length", input: "3400000000000000", want: BrandUnknown},
+		{name: "fail - luhn", input: "4111111111111112", wantErr: true},
+		{name: "fail - letters", input: "4111-1111-1111-111A", wantErr: true},
+		{name: "fail - too short", input: "41111111111", wantErr: true},
+		{name: "fail - too long", input: "41111111111111111111", wantErr: true},
+		{name: "fail - empty", input: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CardNumber(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CardNumber() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, ErrCardNumber) {
+				t.Errorf("CardNumber() error = %v, want %v", err, ErrCardNumber)
+			}
+			if got != tt.want {
+				t.Errorf("CardNumber() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCardExpiry(t *testing.T) {
+	now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		input   string
+		now     time.Time
+		wantErr error
+	}{
+		{name: "success - future", input: "12/27", now: now},
+		{name: "success - four digit year", input: "01/2030", now: now},
+		{name: "success - current month", input: "03/25", now: now},
+		{name: "success - last instant of month", input: "03/25", now: time.Date(2025, 3, 31, 23, 59, 59, 0, time.UTC)},
+		{name: "success - december", input: "12/25", now: time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{name: "fail - previous month", input: "02/25", now: now, wantErr: ErrCardExpired},
+		{name: "fail - after expiry month", input: "03/25", now: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), wantErr: ErrCardExpired},
+		{name: "fail - invalid month", input: "13/27", now: now, wantErr: ErrCardExpiry},
+		{name: "fail - zero month", input: "00/27", now: now, wantErr: ErrCardExpiry},
+		{name: "fail - single digit month", input: "1/27", now: now, wantErr: ErrCardExpiry},
+		{name: "fail - three digit year", input: "01/270", now: now, wantErr: ErrCardExpiry},
+		{name: "fail - no separator", input: "0127", now: now, wantErr: ErrCardExpiry},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CardExpiry(tt.input, tt.now); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CardExpiry() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
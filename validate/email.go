@@ -0,0 +1,245 @@
+package validate
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/kashifkhan0771/utils/cache"
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+const atext = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789!#$%&'*+-/=?^_`{|}~"
+
+// Email reports whether s is a syntactically valid RFC 5322 address (addr-spec) as accepted by RFC 5321:
+// a dot-atom or quoted local part of up to 64 characters, and a domain name with at least two labels or
+// an address literal such as "[192.0.2.1]", for a total of up to 254 characters. Display names, comments
+// and internationalized addresses are not accepted.
+func Email(s string) bool {
+	if len(s) > 254 {
+		return false
+	}
+
+	at := strings.LastIndexByte(s, '@')
+	if at < 0 {
+		return false
+	}
+
+	local, domain := s[:at], s[at+1:]
+
+	return len(local) <= 64 && validLocalPart(local) && (validDomain(domain) || validAddressLiteral(domain))
+}
+
+func validLocalPart(local string) bool {
+	if len(local) >= 2 && local[0] == '"' && local[len(local)-1] == '"' {
+		return validQuotedString(local[1 : len(local)-1])
+	}
+
+	return validDotAtom(local)
+}
+
+func validDotAtom(s string) bool {
+	if s == "" || s[0] == '.' || s[len(s)-1] == '.' || strings.Contains(s, "..") {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '.' && strings.IndexByte(atext, s[i]) < 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func validQuotedString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\':
+			// A quoted pair escapes any printable character or space.
+			i++
+			if i == len(s) || s[i] < ' ' || s[i] > '~' {
+				return false
+			}
+		case c == '"' || c < ' ' || c > '~':
+			return false
+		}
+	}
+
+	return true
+}
+
+// validDomain reports whether s is a hostname of at least two labels whose top-level label is not numeric.
+func validDomain(s string) bool {
+	labels := strings.Split(s, ".")
+	if len(labels) < 2 {
+		return false
+	}
+
+	for _, label := range labels {
+		if !validLabel(label) {
+			return false
+		}
+	}
+
+	return !isDigits(labels[len(labels)-1])
+}
+
+func validLabel(label string) bool {
+	if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+		if !isDigit(c) && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && c != '-' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func validAddressLiteral(s string) bool {
+	if len(s) < 2 || s[0] != '[' || s[len(s)-1] != ']' {
+		return false
+	}
+
+	s = s[1 : len(s)-1]
+	if ip := strings.TrimPrefix(s, "IPv6:"); ip != s {
+		return strings.Contains(ip, ":") && net.ParseIP(ip) != nil
+	}
+
+	return strings.Count(s, ".") == 3 && net.ParseIP(s).To4() != nil
+}
+
+// Resolver looks up the DNS records used by EmailChecker. *net.Resolver implements it.
+type Resolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// EmailCheckerOptions contains options to configure an EmailChecker.
+type EmailCheckerOptions struct {
+	Resolver      Resolver      // DNS resolver, net.DefaultResolver by default
+	Timeout       time.Duration // Timeout of the lookups of a domain, 5 seconds by default
+	CacheTTL      time.Duration // Lifetime of cached domain results, 10 minutes by default
+	CacheCapacity int           // Number of cached domains, 1024 by default
+	Clock         timex.Clock   // Clock measuring the cache lifetimes, the real clock by default
+}
+
+// EmailChecker checks whether the domains of email addresses accept mail, caching the results by domain.
+// It is safe for concurrent use.
+type EmailChecker struct {
+	opts  EmailCheckerOptions
+	cache *cache.LRU[string, deliverability]
+}
+
+type deliverability struct {
+	ok      bool
+	expires time.Time
+}
+
+var defaultEmailChecker = NewEmailChecker(EmailCheckerOptions{})
+
+// NewEmailChecker returns an EmailChecker configured with opts.
+func NewEmailChecker(opts EmailCheckerOptions) *EmailChecker {
+	if opts.Resolver == nil {
+		opts.Resolver = net.DefaultResolver
+	}
+
+	if opts.Timeout <= 0 {
+		opts.Timeout = 5 * time.Second
+	}
+
+	if opts.CacheTTL <= 0 {
+		opts.CacheTTL = 10 * time.Minute
+	}
+
+	if opts.CacheCapacity <= 0 {
+		opts.CacheCapacity = 1024
+	}
+
+	if opts.Clock == nil {
+		opts.Clock = timex.RealClock{}
+	}
+
+	c, _ := cache.NewLRU[string, deliverability](opts.CacheCapacity)
+
+	return &EmailChecker{opts: opts, cache: c}
+}
+
+// EmailDeliverable reports whether s is a valid email address whose domain accepts mail, using a shared
+// EmailChecker with the default options.
+func EmailDeliverable(ctx context.Context, s string) (bool, error) {
+	return defaultEmailChecker.Deliverable(ctx, s)
+}
+
+// Deliverable reports whether s is a valid email address whose domain accepts mail: it has MX records,
+// or an address record used as implicit MX when it has none. Domains publishing a null MX (RFC 7505) do not
+// accept mail. An error is returned when the lookup fails, for instance on timeout, rather than when the
+// domain does not exist; such failures are not cached. Address literals are deliverable without lookup.
+func (c *EmailChecker) Deliverable(ctx context.Context, s string) (bool, error) {
+	if !Email(s) {
+		return false, nil
+	}
+
+	domain := strings.ToLower(s[strings.LastIndexByte(s, '@')+1:])
+	if domain[0] == '[' {
+		return true, nil
+	}
+
+	now := c.opts.Clock.Now()
+	if cached, ok := c.cache.Get(domain); ok && now.Before(cached.expires) {
+		return cached.ok, nil
+	}
+
+	ok, err := c.lookup(ctx, domain)
+	if err != nil {
+		return false, err
+	}
+
+	c.cache.Set(domain, deliverability{ok: ok, expires: now.Add(c.opts.CacheTTL)})
+
+	return ok, nil
+}
+
+func (c *EmailChecker) lookup(ctx context.Context, domain string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.opts.Timeout)
+	defer cancel()
+
+	records, err := c.opts.Resolver.LookupMX(ctx, domain+".")
+	if err != nil && !isNotFound(err) {
+		return false, err
+	}
+
+	if len(records) > 0 {
+		for _, mx := range records {
+			if mx.Host != "." && mx.Host != "" {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	addrs, err := c.opts.Resolver.LookupHost(ctx, domain+".")
+	if err != nil {
+		if isNotFound(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return len(addrs) > 0, nil
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
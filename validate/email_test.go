@@ -0,0 +1,169 @@
+package validate
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kashifkhan0771/utils/timex"
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestEmail(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{name: "success - simple", input: "user@example.com", want: true},
+		{name: "success - subaddress", input: "user.name+tag@mail.example.co.uk", want: true},
+		{name: "success - special characters", input: "o'brien!#$%&*=?^_`{|}~@example.com", want: true},
+		{name: "success - quoted local part", input: `"john doe"@example.com`, want: true},
+		{name: "success - quoted at and escape", input: `"a@b \"c\""@example.com`, want: true},
+		{name: "success - ipv4 literal", input: "user@[192.0.2.1]", want: true},
+		{name: "success - ipv6 literal", input: "user@[IPv6:2001:db8::1]", want: true},
+		{name: "success - hyphenated domain", input: "user@my-domain.io", want: true},
+		{name: "fail - no at", input: "user.example.com"},
+		{name: "fail - empty local part", input: "@example.com"},
+		{name: "fail - leading dot", input: ".user@example.com"},
+		{name: "fail - consecutive dots", input: "us..er@example.com"},
+		{name: "fail - unquoted space", input: "john doe@example.com"},
+		{name: "fail - unescaped quote", input: `"a"b"@example.com`},
+		{name: "fail - single label domain", input: "user@localhost"},
+		{name: "fail - numeric tld", input: "user@192.0.2.1"},
+		{name: "fail - label with leading hyphen", input: "user@-example.com"},
+		{name: "fail - empty label", input: "user@example..com"},
+		{name: "fail - underscore in domain", input: "user@exa_mple.com"},
+		{name: "fail - bad ipv4 literal", input: "user@[192.0.2.300]"},
+		{name: "fail - ipv6 literal without tag", input: "user@[2001:db8::1]"},
+		{name: "fail - display name", input: "John <user@example.com>"},
+		{name: "fail - local part too long", input: strings.Repeat("a", 65) + "@example.com"},
+		{name: "fail - label too long", input: "user@" + strings.Repeat("a", 64) + ".com"},
+		{name: "fail - too long", input: "user@" + strings.Repeat(strings.Repeat("a", 60)+".", 5) + "com"},
+		{name: "fail - unicode", input: "usér@example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Email(tt.input); got != tt.want {
+				t.Errorf("Email(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+type fakeResolver struct {
+	mu      sync.Mutex
+	mx      map[string][]*net.MX
+	hosts   map[string][]string
+	err     error
+	lookups int
+}
+
+func (r *fakeResolver) LookupMX(_ context.Context, name string) ([]*net.MX, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lookups++
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if records, ok := r.mx[name]; ok {
+		return records, nil
+	}
+
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (r *fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	if addrs, ok := r.hosts[host]; ok {
+		return addrs, nil
+	}
+
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+func TestEmailCheckerDeliverable(t *testing.T) {
+	resolver := &fakeResolver{
+		mx: map[string][]*net.MX{
+			"example.com.": {{Host: "mx.example.com.", Pref: 10}},
+			"null.com.":    {{Host: ".", Pref: 0}},
+		},
+		hosts: map[string][]string{"implicit.com.": {"192.0.2.1"}},
+	}
+	checker := NewEmailChecker(EmailCheckerOptions{Resolver: resolver})
+
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{name: "success - mx records", input: "user@Example.com", want: true},
+		{name: "success - implicit mx", input: "user@implicit.com", want: true},
+		{name: "success - address literal", input: "user@[192.0.2.1]", want: true},
+		{name: "fail - null mx", input: "user@null.com"},
+		{name: "fail - unknown domain", input: "user@nowhere.com"},
+		{name: "fail - invalid address", input: "not an email"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checker.Deliverable(context.Background(), tt.input)
+			if err != nil || got != tt.want {
+				t.Errorf("Deliverable() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmailCheckerCache(t *testing.T) {
+	clock := timex.NewFakeClock(epoch)
+	resolver := &fakeResolver{mx: map[string][]*net.MX{"example.com.": {{Host: "mx.example.com."}}}}
+	checker := NewEmailChecker(EmailCheckerOptions{Resolver: resolver, CacheTTL: time.Minute, Clock: clock})
+	ctx := context.Background()
+
+	for _, email := range []string{"a@example.com", "b@EXAMPLE.com"} {
+		if ok, err := checker.Deliverable(ctx, email); !ok || err != nil {
+			t.Fatalf("Deliverable() = %v, %v, want true", ok, err)
+		}
+	}
+
+	if resolver.lookups != 1 {
+		t.Errorf("lookups = %v, want 1 with a cached domain", resolver.lookups)
+	}
+
+	clock.Advance(time.Minute)
+	_, _ = checker.Deliverable(ctx, "a@example.com")
+
+	if resolver.lookups != 2 {
+		t.Errorf("lookups = %v, want 2 after the cache expired", resolver.lookups)
+	}
+}
+
+func TestEmailCheckerError(t *testing.T) {
+	resolver := &fakeResolver{err: &net.DNSError{Err: "i/o timeout", IsTimeout: true}}
+	checker := NewEmailChecker(EmailCheckerOptions{Resolver: resolver})
+
+	for i := 0; i < 2; i++ {
+		ok, err := checker.Deliverable(context.Background(), "user@example.com")
+
+		var dnsErr *net.DNSError
+		if ok || !errors.As(err, &dnsErr) {
+			t.Errorf("Deliverable() = %v, %v, want a DNS error", ok, err)
+		}
+	}
+
+	if resolver.lookups != 2 {
+		t.Errorf("lookups = %v, want 2 as failures are not cached", resolver.lookups)
+	}
+}
+
+func TestEmailDeliverableInvalid(t *testing.T) {
+	if ok, err := EmailDeliverable(context.Background(), "user@@example.com"); ok || err != nil {
+		t.Errorf("EmailDeliverable() = %v, %v, want false without lookup", ok, err)
+	}
+}
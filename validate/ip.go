@@ -0,0 +1,159 @@
+package validate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+)
+
+// ErrCIDR is wrapped by the errors returned for malformed ranges.
+var ErrCIDR = errors.New("invalid cidr")
+
+// IP reports whether s is an IPv4 or IPv6 address in canonical notation.
+// Octets with leading zeros, such as "010.0.0.1", and IPv6 zones are rejected.
+func IP(s string) bool {
+	return net.ParseIP(s) != nil
+}
+
+// CIDR reports whether s is an IPv4 or IPv6 network in CIDR notation, such as "10.0.0.0/8".
+// Host bits are allowed, as in "10.1.2.3/8".
+func CIDR(s string) bool {
+	_, _, err := net.ParseCIDR(s)
+
+	return err == nil
+}
+
+// IsPrivate reports whether s is an IPv4 address of RFC 1918 or an IPv6 unique local address of RFC 4193.
+func IsPrivate(s string) bool {
+	ip := net.ParseIP(s)
+
+	return ip != nil && ip.IsPrivate()
+}
+
+// IsLoopback reports whether s is a loopback address, such as "127.0.0.1" or "::1".
+func IsLoopback(s string) bool {
+	ip := net.ParseIP(s)
+
+	return ip != nil && ip.IsLoopback()
+}
+
+// IsPublic reports whether s is a globally routable address: not loopback, private, link-local, multicast,
+// unspecified, or in other special purpose ranges such as carrier-grade NAT, benchmarking or documentation.
+// It uses the same rules as the ForbidPrivateIP option of URL.
+func IsPublic(s string) bool {
+	ip := net.ParseIP(s)
+
+	return ip != nil && publicIP(ip)
+}
+
+// IPInRanges reports whether ip is contained in any of cidrs. Use NewIPMatcher to check many addresses
+// against the same ranges.
+func IPInRanges(ip string, cidrs []string) (bool, error) {
+	m, err := NewIPMatcher(cidrs)
+	if err != nil {
+		return false, err
+	}
+
+	return m.Contains(ip), nil
+}
+
+// ipRange is an inclusive range of addresses of the same length.
+type ipRange struct {
+	first, last net.IP
+}
+
+// IPMatcher matches addresses against a fixed set of ranges in logarithmic time. It is safe for concurrent use.
+type IPMatcher struct {
+	v4, v6 []ipRange
+}
+
+// NewIPMatcher returns a matcher for cidrs, in CIDR notation or single addresses such as "192.0.2.1".
+// As in net.IPNet, IPv4 addresses only match IPv4 ranges, including in their IPv4-mapped IPv6 form
+// "::ffff:192.0.2.1". Overlapping ranges are merged, so large allowlists stay cheap to query.
+func NewIPMatcher(cidrs []string) (*IPMatcher, error) {
+	m := &IPMatcher{}
+
+	for _, cidr := range cidrs {
+		r, err := parseRange(cidr)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(r.first) == net.IPv4len {
+			m.v4 = append(m.v4, r)
+		} else {
+			m.v6 = append(m.v6, r)
+		}
+	}
+
+	m.v4, m.v6 = mergeRanges(m.v4), mergeRanges(m.v6)
+
+	return m, nil
+}
+
+// Contains reports whether the address s is in one of the ranges of m. Invalid addresses are not contained.
+func (m *IPMatcher) Contains(s string) bool {
+	return m.ContainsIP(net.ParseIP(s))
+}
+
+// ContainsIP reports whether ip is in one of the ranges of m.
+func (m *IPMatcher) ContainsIP(ip net.IP) bool {
+	ranges := m.v6
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, ranges = ip4, m.v4
+	} else if len(ip) != net.IPv6len {
+		return false
+	}
+
+	i := sort.Search(len(ranges), func(i int) bool {
+		return bytes.Compare(ranges[i].last, ip) >= 0
+	})
+
+	return i < len(ranges) && bytes.Compare(ranges[i].first, ip) <= 0
+}
+
+func parseRange(cidr string) (ipRange, error) {
+	if ip := net.ParseIP(cidr); ip != nil {
+		if ip4 := ip.To4(); ip4 != nil {
+			ip = ip4
+		}
+
+		return ipRange{first: ip, last: ip}, nil
+	}
+
+	_, n, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return ipRange{}, fmt.Errorf("%w %q", ErrCIDR, cidr)
+	}
+
+	last := make(net.IP, len(n.IP))
+	for i := range n.IP {
+		last[i] = n.IP[i] | ^n.Mask[i]
+	}
+
+	return ipRange{first: n.IP, last: last}, nil
+}
+
+// mergeRanges sorts ranges and merges the overlapping ones, leaving disjoint ranges in ascending order.
+func mergeRanges(ranges []ipRange) []ipRange {
+	sort.Slice(ranges, func(i, j int) bool {
+		return bytes.Compare(ranges[i].first, ranges[j].first) < 0
+	})
+
+	var merged []ipRange
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && bytes.Compare(r.first, merged[n-1].last) <= 0 {
+			if bytes.Compare(r.last, merged[n-1].last) > 0 {
+				merged[n-1].last = r.last
+			}
+
+			continue
+		}
+
+		merged = append(merged, r)
+	}
+
+	return merged
+}
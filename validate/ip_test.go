@@ -0,0 +1,170 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+)
+
+func TestIP(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{name: "success - ipv4", input: "192.0.2.1", want: true},
+		{name: "success - ipv6", input: "2001:db8::1", want: true},
+		{name: "success - ipv4 mapped", input: "::ffff:192.0.2.1", want: true},
+		{name: "fail - leading zeros", input: "010.0.0.1"},
+		{name: "fail - out of range", input: "256.0.0.1"},
+		{name: "fail - zone", input: "fe80::1%eth0"},
+		{name: "fail - cidr", input: "10.0.0.0/8"},
+		{name: "fail - empty", input: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IP(tt.input); got != tt.want {
+				t.Errorf("IP(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCIDR(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{name: "success - ipv4", input: "10.0.0.0/8", want: true},
+		{name: "success - ipv6", input: "2001:db8::/32", want: true},
+		{name: "success - host bits", input: "10.1.2.3/8", want: true},
+		{name: "fail - address", input: "10.0.0.1"},
+		{name: "fail - prefix too long", input: "10.0.0.0/33"},
+		{name: "fail - empty prefix", input: "10.0.0.0/"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CIDR(tt.input); got != tt.want {
+				t.Errorf("CIDR(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifiers(t *testing.T) {
+	tests := []struct {
+		input                     string
+		private, loopback, public bool
+	}{
+		{input: "10.1.2.3", private: true},
+		{input: "172.16.0.1", private: true},
+		{input: "192.168.1.1", private: true},
+		{input: "fd00::1", private: true},
+		{input: "127.0.0.1", loopback: true},
+		{input: "::1", loopback: true},
+		{input: "169.254.169.254"},
+		{input: "100.64.0.1"},
+		{input: "0.0.0.0"},
+		{input: "2001:db8::1"},
+		{input: "8.8.8.8", public: true},
+		{input: "2606:4700::1111", public: true},
+		{input: "not an ip"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := IsPrivate(tt.input); got != tt.private {
+				t.Errorf("IsPrivate(%q) = %v, want %v", tt.input, got, tt.private)
+			}
+			if got := IsLoopback(tt.input); got != tt.loopback {
+				t.Errorf("IsLoopback(%q) = %v, want %v", tt.input, got, tt.loopback)
+			}
+			if got := IsPublic(tt.input); got != tt.public {
+				t.Errorf("IsPublic(%q) = %v, want %v", tt.input, got, tt.public)
+			}
+		})
+	}
+}
+
+func TestIPInRanges(t *testing.T) {
+	cidrs := []string{"10.0.0.0/8", "192.168.1.0/24", "203.0.113.7", "2001:db8::/32"}
+
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{name: "success - first address", input: "10.0.0.0", want: true},
+		{name: "success - last address", input: "10.255.255.255", want: true},
+		{name: "success - single address", input: "203.0.113.7", want: true},
+		{name: "success - ipv6", input: "2001:db8:ffff::1", want: true},
+		{name: "success - ipv4 mapped", input: "::ffff:192.168.1.20", want: true},
+		{name: "fail - before range", input: "9.255.255.255"},
+		{name: "fail - after range", input: "11.0.0.0"},
+		{name: "fail - next to single address", input: "203.0.113.8"},
+		{name: "fail - other ipv6", input: "2001:db9::1"},
+		{name: "fail - invalid", input: "10.0.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IPInRanges(tt.input, cidrs)
+			if err != nil {
+				t.Fatalf("IPInRanges() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("IPInRanges(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := IPInRanges("10.0.0.1", []string{"10.0.0.0/99"}); !errors.Is(err, ErrCIDR) {
+		t.Errorf("IPInRanges() error = %v, want %v", err, ErrCIDR)
+	}
+}
+
+func TestIPMatcher(t *testing.T) {
+	m, err := NewIPMatcher([]string{"10.0.0.0/16", "10.0.128.0/17", "10.0.0.0/24", "10.2.0.0/16", "::/0"})
+	if err != nil {
+		t.Fatalf("NewIPMatcher() error = %v", err)
+	}
+
+	if len(m.v4) != 2 || len(m.v6) != 1 {
+		t.Errorf("NewIPMatcher() ranges = %d ipv4, %d ipv6, want 2, 1", len(m.v4), len(m.v6))
+	}
+
+	tests := []struct {
+		ip   net.IP
+		want bool
+	}{
+		{ip: net.ParseIP("10.0.200.1"), want: true},
+		{ip: net.ParseIP("10.2.3.4"), want: true},
+		{ip: net.ParseIP("10.1.0.1")},
+		{ip: net.ParseIP("fe80::1"), want: true},
+		{ip: net.IP{10, 0, 0, 1}, want: true},
+		{ip: nil},
+	}
+	for _, tt := range tests {
+		if got := m.ContainsIP(tt.ip); got != tt.want {
+			t.Errorf("ContainsIP(%v) = %v, want %v", tt.ip, got, tt.want)
+		}
+	}
+}
+
+func TestIPMatcherLarge(t *testing.T) {
+	var cidrs []string
+	for i := 0; i < 256; i++ {
+		for j := 0; j < 256; j += 2 {
+			cidrs = append(cidrs, fmt.Sprintf("10.%d.%d.0/24", i, j))
+		}
+	}
+
+	m, err := NewIPMatcher(cidrs)
+	if err != nil {
+		t.Fatalf("NewIPMatcher() error = %v", err)
+	}
+
+	if !m.Contains("10.200.42.1") || m.Contains("10.200.43.1") {
+		t.Errorf("Contains() mismatch on even and odd /24 ranges")
+	}
+}
@@ -0,0 +1,132 @@
+package validate
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Country is an ISO 3166-1 country.
+type Country struct {
+	Alpha2  string // Two letter code, such as "BR"
+	Alpha3  string // Three letter code, such as "BRA"
+	Numeric string // Three digit code, such as "076"
+	Name    string // English short name, such as "Brazil"
+}
+
+// Currency is an ISO 4217 currency.
+type Currency struct {
+	Code     string // Three letter code, such as "BRL"
+	Numeric  string // Three digit code, such as "986"
+	Name     string // English name, such as "Brazilian Real"
+	Symbol   string // Local symbol, such as "R$", or the code when it has none
+	Decimals int    // Number of minor unit digits, -1 for currencies without minor unit such as gold
+}
+
+// Language is an ISO 639 language.
+type Language struct {
+	Alpha2        string // ISO 639-1 code, such as "pt", empty for languages without one
+	Alpha3        string // ISO 639-2/T code, such as "por"
+	Bibliographic string // ISO 639-2/B code when it differs from Alpha3, such as "ger" for "deu"
+	Name          string // English name, such as "Portuguese"
+}
+
+// isoTables indexes the ISO tables by every code of their entries, in uppercase.
+type isoTables struct {
+	countries  map[string]Country
+	currencies map[string]Currency
+	languages  map[string]Language
+}
+
+var (
+	isoOnce sync.Once
+	iso     isoTables
+)
+
+func loadISO() *isoTables {
+	isoOnce.Do(func() {
+		iso.countries = make(map[string]Country)
+		for _, f := range splitTable(countryData) {
+			c := Country{Alpha2: f[0], Alpha3: f[1], Numeric: f[2], Name: f[3]}
+			iso.countries[c.Alpha2], iso.countries[c.Alpha3], iso.countries[c.Numeric] = c, c, c
+		}
+
+		iso.currencies = make(map[string]Currency)
+		for _, f := range splitTable(currencyData) {
+			decimals, _ := strconv.Atoi(f[2])
+
+			c := Currency{Code: f[0], Numeric: f[1], Decimals: decimals, Symbol: f[3], Name: f[4]}
+			if c.Symbol == "" {
+				c.Symbol = c.Code
+			}
+
+			iso.currencies[c.Code], iso.currencies[c.Numeric] = c, c
+		}
+
+		iso.languages = make(map[string]Language)
+		for _, f := range splitTable(languageData) {
+			l := Language{Alpha2: f[0], Alpha3: f[1], Bibliographic: f[2], Name: f[3]}
+			for _, code := range f[:3] {
+				if code != "" {
+					iso.languages[strings.ToUpper(code)] = l
+				}
+			}
+		}
+	})
+
+	return &iso
+}
+
+func splitTable(data string) [][]string {
+	lines := strings.Split(data, "\n")
+
+	rows := make([][]string, len(lines))
+	for i, line := range lines {
+		rows[i] = strings.Split(line, "|")
+	}
+
+	return rows
+}
+
+// CountryCode reports whether s is an ISO 3166-1 alpha-2 or alpha-3 country code, ignoring case.
+func CountryCode(s string) bool {
+	c, ok := LookupCountry(s)
+
+	return ok && c.Numeric != s
+}
+
+// LookupCountry returns the country of an alpha-2, alpha-3 or numeric ISO 3166-1 code, ignoring case.
+func LookupCountry(code string) (Country, bool) {
+	c, ok := loadISO().countries[strings.ToUpper(code)]
+
+	return c, ok
+}
+
+// CurrencyCode reports whether s is an ISO 4217 currency code, ignoring case.
+func CurrencyCode(s string) bool {
+	c, ok := LookupCurrency(s)
+
+	return ok && c.Numeric != s
+}
+
+// LookupCurrency returns the currency of an alphabetic or numeric ISO 4217 code, ignoring case.
+func LookupCurrency(code string) (Currency, bool) {
+	c, ok := loadISO().currencies[strings.ToUpper(code)]
+
+	return c, ok
+}
+
+// LanguageCode reports whether s is an ISO 639-1 or ISO 639-2 (terminologic or bibliographic) language code,
+// ignoring case.
+func LanguageCode(s string) bool {
+	_, ok := LookupLanguage(s)
+
+	return ok
+}
+
+// LookupLanguage returns the language of an ISO 639-1 or ISO 639-2 code, ignoring case.
+func LookupLanguage(code string) (Language, bool) {
+	l, ok := loadISO().languages[strings.ToUpper(code)]
+
+	return l, ok
+}
@@ -0,0 +1,926 @@
+package validate
+
+// ISO tables, one entry per line with fields separated by "|": alpha-2, alpha-3, numeric code and
+// name of countries (ISO 3166-1); code, numeric code, minor units, symbol and name of currencies
+// (ISO 4217); alpha-2, alpha-3 and bibliographic alpha-3 codes and name of languages (ISO 639-1 and 639-2).
+
+const countryData = `AD|AND|020|Andorra
+AE|ARE|784|United Arab Emirates
+AF|AFG|004|Afghanistan
+AG|ATG|028|Antigua and Barbuda
+AI|AIA|660|Anguilla
+AL|ALB|008|Albania
+AM|ARM|051|Armenia
+AO|AGO|024|Angola
+AQ|ATA|010|Antarctica
+AR|ARG|032|Argentina
+AS|ASM|016|American Samoa
+AT|AUT|040|Austria
+AU|AUS|036|Australia
+AW|ABW|533|Aruba
+AX|ALA|248|Åland Islands
+AZ|AZE|031|Azerbaijan
+BA|BIH|070|Bosnia and Herzegovina
+BB|BRB|052|Barbados
+BD|BGD|050|Bangladesh
+BE|BEL|056|Belgium
+BF|BFA|854|Burkina Faso
+BG|BGR|100|Bulgaria
+BH|BHR|048|Bahrain
+BI|BDI|108|Burundi
+BJ|BEN|204|Benin
+BL|BLM|652|Saint Barthélemy
+BM|BMU|060|Bermuda
+BN|BRN|096|Brunei Darussalam
+BO|BOL|068|Bolivia
+BQ|BES|535|Bonaire, Sint Eustatius and Saba
+BR|BRA|076|Brazil
+BS|BHS|044|Bahamas
+BT|BTN|064|Bhutan
+BV|BVT|074|Bouvet Island
+BW|BWA|072|Botswana
+BY|BLR|112|Belarus
+BZ|BLZ|084|Belize
+CA|CAN|124|Canada
+CC|CCK|166|Cocos (Keeling) Islands
+CD|COD|180|Congo, The Democratic Republic of the
+CF|CAF|140|Central African Republic
+CG|COG|178|Congo
+CH|CHE|756|Switzerland
+CI|CIV|384|Côte d'Ivoire
+CK|COK|184|Cook Islands
+CL|CHL|152|Chile
+CM|CMR|120|Cameroon
+CN|CHN|156|China
+CO|COL|170|Colombia
+CR|CRI|188|Costa Rica
+CU|CUB|192|Cuba
+CV|CPV|132|Cabo Verde
+CW|CUW|531|Curaçao
+CX|CXR|162|Christmas Island
+CY|CYP|196|Cyprus
+CZ|CZE|203|Czechia
+DE|DEU|276|Germany
+DJ|DJI|262|Djibouti
+DK|DNK|208|Denmark
+DM|DMA|212|Dominica
+DO|DOM|214|Dominican Republic
+DZ|DZA|012|Algeria
+EC|ECU|218|Ecuador
+EE|EST|233|Estonia
+EG|EGY|818|Egypt
+EH|ESH|732|Western Sahara
+ER|ERI|232|Eritrea
+ES|ESP|724|Spain
+ET|ETH|231|Ethiopia
+FI|FIN|246|Finland
+FJ|FJI|242|Fiji
+FK|FLK|238|Falkland Islands (Malvinas)
+FM|FSM|583|Micronesia, Federated States of
+FO|FRO|234|Faroe Islands
+FR|FRA|250|France
+GA|GAB|266|Gabon
+GB|GBR|826|United Kingdom
+GD|GRD|308|Grenada
+GE|GEO|268|Georgia
+GF|GUF|254|French Guiana
+GG|GGY|831|Guernsey
+GH|GHA|288|Ghana
+GI|GIB|292|Gibraltar
+GL|GRL|304|Greenland
+GM|GMB|270|Gambia
+GN|GIN|324|Guinea
+GP|GLP|312|Guadeloupe
+GQ|GNQ|226|Equatorial Guinea
+GR|GRC|300|Greece
+GS|SGS|239|South Georgia and the South Sandwich Islands
+GT|GTM|320|Guatemala
+GU|GUM|316|Guam
+GW|GNB|624|Guinea-Bissau
+GY|GUY|328|Guyana
+HK|HKG|344|Hong Kong
+HM|HMD|334|Heard Island and McDonald Islands
+HN|HND|340|Honduras
+HR|HRV|191|Croatia
+HT|HTI|332|Haiti
+HU|HUN|348|Hungary
+ID|IDN|360|Indonesia
+IE|IRL|372|Ireland
+IL|ISR|376|Israel
+IM|IMN|833|Isle of Man
+IN|IND|356|India
+IO|IOT|086|British Indian Ocean Territory
+IQ|IRQ|368|Iraq
+IR|IRN|364|Iran
+IS|ISL|352|Iceland
+IT|ITA|380|Italy
+JE|JEY|832|Jersey
+JM|JAM|388|Jamaica
+JO|JOR|400|Jordan
+JP|JPN|392|Japan
+KE|KEN|404|Kenya
+KG|KGZ|417|Kyrgyzstan
+KH|KHM|116|Cambodia
+KI|KIR|296|Kiribati
+KM|COM|174|Comoros
+KN|KNA|659|Saint Kitts and Nevis
+KP|PRK|408|North Korea
+KR|KOR|410|South Korea
+KW|KWT|414|Kuwait
+KY|CYM|136|Cayman Islands
+KZ|KAZ|398|Kazakhstan
+LA|LAO|418|Laos
+LB|LBN|422|Lebanon
+LC|LCA|662|Saint Lucia
+LI|LIE|438|Liechtenstein
+LK|LKA|144|Sri Lanka
+LR|LBR|430|Liberia
+LS|LSO|426|Lesotho
+LT|LTU|440|Lithuania
+LU|LUX|442|Luxembourg
+LV|LVA|428|Latvia
+LY|LBY|434|Libya
+MA|MAR|504|Morocco
+MC|MCO|492|Monaco
+MD|MDA|498|Moldova
+ME|MNE|499|Montenegro
+MF|MAF|663|Saint Martin (French part)
+MG|MDG|450|Madagascar
+MH|MHL|584|Marshall Islands
+MK|MKD|807|North Macedonia
+ML|MLI|466|Mali
+MM|MMR|104|Myanmar
+MN|MNG|496|Mongolia
+MO|MAC|446|Macao
+MP|MNP|580|Northern Mariana Islands
+MQ|MTQ|474|Martinique
+MR|MRT|478|Mauritania
+MS|MSR|500|Montserrat
+MT|MLT|470|Malta
+MU|MUS|480|Mauritius
+MV|MDV|462|Maldives
+MW|MWI|454|Malawi
+MX|MEX|484|Mexico
+MY|MYS|458|Malaysia
+MZ|MOZ|508|Mozambique
+NA|NAM|516|Namibia
+NC|NCL|540|New Caledonia
+NE|NER|562|Niger
+NF|NFK|574|Norfolk Island
+NG|NGA|566|Nigeria
+NI|NIC|558|Nicaragua
+NL|NLD|528|Netherlands
+NO|NOR|578|Norway
+NP|NPL|524|Nepal
+NR|NRU|520|Nauru
+NU|NIU|570|Niue
+NZ|NZL|554|New Zealand
+OM|OMN|512|Oman
+PA|PAN|591|Panama
+PE|PER|604|Peru
+PF|PYF|258|French Polynesia
+PG|PNG|598|Papua New Guinea
+PH|PHL|608|Philippines
+PK|PAK|586|Pakistan
+PL|POL|616|Poland
+PM|SPM|666|Saint Pierre and Miquelon
+PN|PCN|612|Pitcairn
+PR|PRI|630|Puerto Rico
+PS|PSE|275|Palestine, State of
+PT|PRT|620|Portugal
+PW|PLW|585|Palau
+PY|PRY|600|Paraguay
+QA|QAT|634|Qatar
+RE|REU|638|Réunion
+RO|ROU|642|Romania
+RS|SRB|688|Serbia
+RU|RUS|643|Russian Federation
+RW|RWA|646|Rwanda
+SA|SAU|682|Saudi Arabia
+SB|SLB|090|Solomon Islands
+SC|SYC|690|Seychelles
+SD|SDN|729|Sudan
+SE|SWE|752|Sweden
+SG|SGP|702|Singapore
+SH|SHN|654|Saint Helena, Ascension and Tristan da Cunha
+SI|SVN|705|Slovenia
+SJ|SJM|744|Svalbard and Jan Mayen
+SK|SVK|703|Slovakia
+SL|SLE|694|Sierra Leone
+SM|SMR|674|San Marino
+SN|SEN|686|Senegal
+SO|SOM|706|Somalia
+SR|SUR|740|Suriname
+SS|SSD|728|South Sudan
+ST|STP|678|Sao Tome and Principe
+SV|SLV|222|El Salvador
+SX|SXM|534|Sint Maarten (Dutch part)
+SY|SYR|760|Syria
+SZ|SWZ|748|Eswatini
+TC|TCA|796|Turks and Caicos Islands
+TD|TCD|148|Chad
+TF|ATF|260|French Southern Territories
+TG|TGO|768|Togo
+TH|THA|764|Thailand
+TJ|TJK|762|Tajikistan
+TK|TKL|772|Tokelau
+TL|TLS|626|Timor-Leste
+TM|TKM|795|Turkmenistan
+TN|TUN|788|Tunisia
+TO|TON|776|Tonga
+TR|TUR|792|Türkiye
+TT|TTO|780|Trinidad and Tobago
+TV|TUV|798|Tuvalu
+TW|TWN|158|Taiwan
+TZ|TZA|834|Tanzania
+UA|UKR|804|Ukraine
+UG|UGA|800|Uganda
+UM|UMI|581|United States Minor Outlying Islands
+US|USA|840|United States
+UY|URY|858|Uruguay
+UZ|UZB|860|Uzbekistan
+VA|VAT|336|Holy See (Vatican City State)
+VC|VCT|670|Saint Vincent and the Grenadines
+VE|VEN|862|Venezuela
+VG|VGB|092|Virgin Islands, British
+VI|VIR|850|Virgin Islands, U.S.
+VN|VNM|704|Vietnam
+VU|VUT|548|Vanuatu
+WF|WLF|876|Wallis and Futuna
+WS|WSM|882|Samoa
+YE|YEM|887|Yemen
+YT|MYT|175|Mayotte
+ZA|ZAF|710|South Africa
+ZM|ZMB|894|Zambia
+ZW|ZWE|716|Zimbabwe`
+
+const currencyData = `AED|784|2|د.إ|UAE Dirham
+AFN|971|2||Afghani
+ALL|008|2||Lek
+AMD|051|2||Armenian Dram
+ANG|532|2||Netherlands Antillean Guilder
+AOA|973|2||Kwanza
+ARS|032|2|$|Argentine Peso
+AUD|036|2|$|Australian Dollar
+AWG|533|2||Aruban Florin
+AZN|944|2|₼|Azerbaijan Manat
+BAM|977|2||Convertible Mark
+BBD|052|2|$|Barbados Dollar
+BDT|050|2|৳|Taka
+BGN|975|2||Bulgarian Lev
+BHD|048|3||Bahraini Dinar
+BIF|108|0||Burundi Franc
+BMD|060|2||Bermudian Dollar
+BND|096|2||Brunei Dollar
+BOB|068|2|Bs|Boliviano
+BOV|984|2||Mvdol
+BRL|986|2|R$|Brazilian Real
+BSD|044|2|$|Bahamian Dollar
+BTN|064|2||Ngultrum
+BWP|072|2||Pula
+BYN|933|2||Belarusian Ruble
+BZD|084|2||Belize Dollar
+CAD|124|2|$|Canadian Dollar
+CDF|976|2||Congolese Franc
+CHE|947|2||WIR Euro
+CHF|756|2|CHF|Swiss Franc
+CHW|948|2||WIR Franc
+CLF|990|4||Unidad de Fomento
+CLP|152|0|$|Chilean Peso
+CNY|156|2|¥|Yuan Renminbi
+COP|170|2|$|Colombian Peso
+COU|970|2||Unidad de Valor Real
+CRC|188|2|₡|Costa Rican Colon
+CUC|931|2||Peso Convertible
+CUP|192|2||Cuban Peso
+CVE|132|2||Cabo Verde Escudo
+CZK|203|2|Kč|Czech Koruna
+DJF|262|0||Djibouti Franc
+DKK|208|2|kr|Danish Krone
+DOP|214|2|RD$|Dominican Peso
+DZD|012|2||Algerian Dinar
+EGP|818|2|E£|Egyptian Pound
+ERN|232|2||Nakfa
+ETB|230|2||Ethiopian Birr
+EUR|978|2|€|Euro
+FJD|242|2||Fiji Dollar
+FKP|238|2||Falkland Islands Pound
+GBP|826|2|£|Pound Sterling
+GEL|981|2|₾|Lari
+GHS|936|2|₵|Ghana Cedi
+GIP|292|2||Gibraltar Pound
+GMD|270|2||Dalasi
+GNF|324|0||Guinean Franc
+GTQ|320|2|Q|Quetzal
+GYD|328|2||Guyana Dollar
+HKD|344|2|$|Hong Kong Dollar
+HNL|340|2|L|Lempira
+HRK|191|2||Kuna
+HTG|332|2||Gourde
+HUF|348|2|Ft|Forint
+IDR|360|2|Rp|Rupiah
+ILS|376|2|₪|New Israeli Sheqel
+INR|356|2|₹|Indian Rupee
+IQD|368|3||Iraqi Dinar
+IRR|364|2||Iranian Rial
+ISK|352|0|kr|Iceland Krona
+JMD|388|2|$|Jamaican Dollar
+JOD|400|3||Jordanian Dinar
+JPY|392|0|¥|Yen
+KES|404|2|KSh|Kenyan Shilling
+KGS|417|2||Som
+KHR|116|2||Riel
+KMF|174|0||Comorian Franc
+KPW|408|2||North Korean Won
+KRW|410|0|₩|Won
+KWD|414|3||Kuwaiti Dinar
+KYD|136|2||Cayman Islands Dollar
+KZT|398|2|₸|Tenge
+LAK|418|2|₭|Lao Kip
+LBP|422|2||Lebanese Pound
+LKR|144|2|Rs|Sri Lanka Rupee
+LRD|430|2||Liberian Dollar
+LSL|426|2||Loti
+LYD|434|3||Libyan Dinar
+MAD|504|2||Moroccan Dirham
+MDL|498|2||Moldovan Leu
+MGA|969|2||Malagasy Ariary
+MKD|807|2||Denar
+MMK|104|2||Kyat
+MNT|496|2|₮|Tugrik
+MOP|446|2||Pataca
+MRU|929|2||Ouguiya
+MUR|480|2||Mauritius Rupee
+MVR|462|2||Rufiyaa
+MWK|454|2||Malawi Kwacha
+MXN|484|2|$|Mexican Peso
+MXV|979|2||Mexican Unidad de Inversion (UDI)
+MYR|458|2|RM|Malaysian Ringgit
+MZN|943|2||Mozambique Metical
+NAD|516|2||Namibia Dollar
+NGN|566|2|₦|Naira
+NIO|558|2|C$|Cordoba Oro
+NOK|578|2|kr|Norwegian Krone
+NPR|524|2|Rs|Nepalese Rupee
+NZD|554|2|$|New Zealand Dollar
+OMR|512|3||Rial Omani
+PAB|590|2|B/.|Balboa
+PEN|604|2|S/|Sol
+PGK|598|2||Kina
+PHP|608|2|₱|Philippine Peso
+PKR|586|2|Rs|Pakistan Rupee
+PLN|985|2|zł|Zloty
+PYG|600|0|₲|Guarani
+QAR|634|2||Qatari Rial
+RON|946|2|lei|Romanian Leu
+RSD|941|2||Serbian Dinar
+RUB|643|2|₽|Russian Ruble
+RWF|646|0||Rwanda Franc
+SAR|682|2|﷼|Saudi Riyal
+SBD|090|2||Solomon Islands Dollar
+SCR|690|2||Seychelles Rupee
+SDG|938|2||Sudanese Pound
+SEK|752|2|kr|Swedish Krona
+SGD|702|2|$|Singapore Dollar
+SHP|654|2||Saint Helena Pound
+SLE|925|2||Leone
+SLL|694|2||Leone
+SOS|706|2||Somali Shilling
+SRD|968|2||Surinam Dollar
+SSP|728|2||South Sudanese Pound
+STN|930|2||Dobra
+SVC|222|2||El Salvador Colon
+SYP|760|2||Syrian Pound
+SZL|748|2||Lilangeni
+THB|764|2|฿|Baht
+TJS|972|2||Somoni
+TMT|934|2||Turkmenistan New Manat
+TND|788|3||Tunisian Dinar
+TOP|776|2||Pa’anga
+TRY|949|2|₺|Turkish Lira
+TTD|780|2|$|Trinidad and Tobago Dollar
+TWD|901|2|$|New Taiwan Dollar
+TZS|834|2||Tanzanian Shilling
+UAH|980|2|₴|Hryvnia
+UGX|800|0||Uganda Shilling
+USD|840|2|$|US Dollar
+USN|997|2||US Dollar (Next day)
+UYI|940|0||Uruguay Peso en Unidades Indexadas (UI)
+UYU|858|2|$|Peso Uruguayo
+UYW|927|4||Unidad Previsional
+UZS|860|2||Uzbekistan Sum
+VED|926|2||Bolívar Soberano
+VES|928|2|Bs.S|Bolívar Soberano
+VND|704|0|₫|Dong
+VUV|548|0||Vatu
+WST|882|2||Tala
+XAF|950|0||CFA Franc BEAC
+XAG|961|-1||Silver
+XAU|959|-1||Gold
+XBA|955|-1||Bond Markets Unit European Composite Unit (EURCO)
+XBB|956|-1||Bond Markets Unit European Monetary Unit (E.M.U.-6)
+XBC|957|-1||Bond Markets Unit European Unit of Account 9 (E.U.A.-9)
+XBD|958|-1||Bond Markets Unit European Unit of Account 17 (E.U.A.-17)
+XCD|951|2|$|East Caribbean Dollar
+XCG|532|2|Cg|Caribbean Guilder
+XDR|960|-1||SDR (Special Drawing Right)
+XOF|952|0||CFA Franc BCEAO
+XPD|964|-1||Palladium
+XPF|953|0||CFP Franc
+XPT|962|-1||Platinum
+XSU|994|-1||Sucre
+XTS|963|-1||Codes specifically reserved for testing purposes
+XUA|965|-1||ADB Unit of Account
+XXX|999|-1||The codes assigned for transactions where no currency is involved
+YER|886|2||Yemeni Rial
+ZAR|710|2|R|Rand
+ZMW|967|2||Zambian Kwacha
+ZWG|924|2||Zimbabwe Gold
+ZWL|932|2||Zimbabwe Dollar`
+
+const languageData = `aa|aar||Afar
+ab|abk||Abkhazian
+|ace||Achinese
+|ach||Acoli
+|ada||Adangme
+|ady||Adyghe; Adygei
+|afa||Afro-Asiatic languages
+|afh||Afrihili
+af|afr||Afrikaans
+|ain||Ainu
+ak|aka||Akan
+|akk||Akkadian
+|ale||Aleut
+|alg||Algonquian languages
+|alt||Southern Altai
+am|amh||Amharic
+|ang||English, Old (ca. 450-1100)
+|anp||Angika
+|apa||Apache languages
+ar|ara||Arabic
+|arc||Official Aramaic (700-300 BCE); Imperial Aramaic (700-300 BCE)
+an|arg||Aragonese
+|arn||Mapudungun; Mapuche
+|arp||Arapaho
+|art||Artificial languages
+|arw||Arawak
+as|asm||Assamese
+|ast||Asturian; Bable; Leonese; Asturleonese
+|ath||Athapascan languages
+|aus||Australian languages
+av|ava||Avaric
+ae|ave||Avestan
+|awa||Awadhi
+ay|aym||Aymara
+az|aze||Azerbaijani
+|bad||Banda languages
+|bai||Bamileke languages
+ba|bak||Bashkir
+|bal||Baluchi
+bm|bam||Bambara
+|ban||Balinese
+|bas||Basa
+|bat||Baltic languages
+|bej||Beja; Bedawiyet
+be|bel||Belarusian
+|bem||Bemba
+bn|ben||Bengali
+|ber||Berber languages
+|bho||Bhojpuri
+bh|bih||Bihari languages
+|bik||Bikol
+|bin||Bini; Edo
+bi|bis||Bislama
+|bla||Siksika
+|bnt||Bantu (Other)
+bo|bod|tib|Tibetan
+bs|bos||Bosnian
+|bra||Braj
+br|bre||Breton
+|btk||Batak languages
+|bua||Buriat
+|bug||Buginese
+bg|bul||Bulgarian
+|byn||Blin; Bilin
+|cad||Caddo
+|cai||Central American Indian languages
+|car||Galibi Carib
+ca|cat||Catalan; Valencian
+|cau||Caucasian languages
+|ceb||Cebuano
+|cel||Celtic languages
+cs|ces|cze|Czech
+ch|cha||Chamorro
+|chb||Chibcha
+ce|che||Chechen
+|chg||Chagatai
+|chk||Chuukese
+|chm||Mari
+|chn||Chinook jargon
+|cho||Choctaw
+|chp||Chipewyan; Dene Suline
+|chr||Cherokee
+cu|chu||Church Slavic; Old Slavonic; Church Slavonic; Old Bulgarian; Old Church Slavonic
+cv|chv||Chuvash
+|chy||Cheyenne
+|cmc||Chamic languages
+|cnr||Montenegrin
+|cop||Coptic
+kw|cor||Cornish
+co|cos||Corsican
+|cpe||Creoles and pidgins, English based
+|cpf||Creoles and pidgins, French-based
+|cpp||Creoles and pidgins, Portuguese-based
+cr|cre||Cree
+|crh||Crimean Tatar; Crimean Turkish
+|crp||Creoles and pidgins
+|csb||Kashubian
+|cus||Cushitic languages
+cy|cym|wel|Welsh
+|dak||Dakota
+da|dan||Danish
+|dar||Dargwa
+|day||Land Dayak languages
+|del||Delaware
+|den||Slave (Athapascan)
+de|deu|ger|German
+|dgr||Dogrib
+|din||Dinka
+dv|div||Divehi; Dhivehi; Maldivian
+|doi||Dogri
+|dra||Dravidian languages
+|dsb||Lower Sorbian
+|dua||Duala
+|dum||Dutch, Middle (ca. 1050-1350)
+|dyu||Dyula
+dz|dzo||Dzongkha
+|efi||Efik
+|egy||Egyptian (Ancient)
+|eka||Ekajuk
+el|ell|gre|Greek, Modern (1453-)
+|elx||Elamite
+en|eng||English
+|enm||English, Middle (1100-1500)
+eo|epo||Esperanto
+et|est||Estonian
+eu|eus|baq|Basque
+ee|ewe||Ewe
+|ewo||Ewondo
+|fan||Fang
+fo|fao||Faroese
+fa|fas|per|Persian
+|fat||Fanti
+fj|fij||Fijian
+|fil||Filipino; Pilipino
+fi|fin||Finnish
+|fiu||Finno-Ugrian languages
+|fon||Fon
+fr|fra|fre|French
+|frm||French, Middle (ca. 1400-1600)
+|fro||French, Old (842-ca. 1400)
+|frr||Northern Frisian
+|frs||Eastern Frisian
+fy|fry||Western Frisian
+ff|ful||Fulah
+|fur||Friulian
+|gaa||Ga
+|gay||Gayo
+|gba||Gbaya
+|gem||Germanic languages
+|gez||Geez
+|gil||Gilbertese
+gd|gla||Gaelic; Scottish Gaelic
+ga|gle||Irish
+gl|glg||Galician
+gv|glv||Manx
+|gmh||German, Middle High (ca. 1050-1500)
+|goh||German, Old High (ca. 750-1050)
+|gon||Gondi
+|gor||Gorontalo
+|got||Gothic
+|grb||Grebo
+|grc||Greek, Ancient (to 1453)
+gn|grn||Guarani
+|gsw||Swiss German; Alemannic; Alsatian
+gu|guj||Gujarati
+|gwi||Gwich'in
+|hai||Haida
+ht|hat||Haitian; Haitian Creole
+ha|hau||Hausa
+|haw||Hawaiian
+he|heb||Hebrew
+hz|her||Herero
+|hil||Hiligaynon
+|him||Himachali languages; Western Pahari languages
+hi|hin||Hindi
+|hit||Hittite
+|hmn||Hmong; Mong
+ho|hmo||Hiri Motu
+hr|hrv||Croatian
+|hsb||Upper Sorbian
+hu|hun||Hungarian
+|hup||Hupa
+hy|hye|arm|Armenian
+|iba||Iban
+ig|ibo||Igbo
+io|ido||Ido
+ii|iii||Sichuan Yi; Nuosu
+|ijo||Ijo languages
+iu|iku||Inuktitut
+ie|ile||Interlingue; Occidental
+|ilo||Iloko
+ia|ina||Interlingua (International Auxiliary Language Association)
+|inc||Indic languages
+id|ind||Indonesian
+|ine||Indo-European languages
+|inh||Ingush
+ik|ipk||Inupiaq
+|ira||Iranian languages
+|iro||Iroquoian languages
+is|isl|ice|Icelandic
+it|ita||Italian
+jv|jav||Javanese
+|jbo||Lojban
+ja|jpn||Japanese
+|jpr||Judeo-Persian
+|jrb||Judeo-Arabic
+|kaa||Kara-Kalpak
+|kab||Kabyle
+|kac||Kachin; Jingpho
+kl|kal||Kalaallisut; Greenlandic
+|kam||Kamba
+kn|kan||Kannada
+|kar||Karen languages
+ks|kas||Kashmiri
+ka|kat|geo|Georgian
+kr|kau||Kanuri
+|kaw||Kawi
+kk|kaz||Kazakh
+|kbd||Kabardian
+|kha||Khasi
+|khi||Khoisan languages
+km|khm||Central Khmer
+|kho||Khotanese; Sakan
+ki|kik||Kikuyu; Gikuyu
+rw|kin||Kinyarwanda
+ky|kir||Kirghiz; Kyrgyz
+|kmb||Kimbundu
+|kok||Konkani
+kv|kom||Komi
+kg|kon||Kongo
+ko|kor||Korean
+|kos||Kosraean
+|kpe||Kpelle
+|krc||Karachay-Balkar
+|krl||Karelian
+|kro||Kru languages
+|kru||Kurukh
+kj|kua||Kuanyama; Kwanyama
+|kum||Kumyk
+ku|kur||Kurdish
+|kut||Kutenai
+|lad||Ladino
+|lah||Lahnda
+|lam||Lamba
+lo|lao||Lao
+la|lat||Latin
+lv|lav||Latvian
+|lez||Lezghian
+li|lim||Limburgan; Limburger; Limburgish
+ln|lin||Lingala
+lt|lit||Lithuanian
+|lol||Mongo
+|loz||Lozi
+lb|ltz||Luxembourgish; Letzeburgesch
+|lua||Luba-Lulua
+lu|lub||Luba-Katanga
+lg|lug||Ganda
+|lui||Luiseno
+|lun||Lunda
+|luo||Luo (Kenya and Tanzania)
+|lus||Lushai
+|mad||Madurese
+|mag||Magahi
+mh|mah||Marshallese
+|mai||Maithili
+|mak||Makasar
+ml|mal||Malayalam
+|man||Mandingo
+|map||Austronesian languages
+mr|mar||Marathi
+|mas||Masai
+|mdf||Moksha
+|mdr||Mandar
+|men||Mende
+|mga||Irish, Middle (900-1200)
+|mic||Mi'kmaq; Micmac
+|min||Minangkabau
+|mis||Uncoded languages
+mk|mkd|mac|Macedonian
+|mkh||Mon-Khmer languages
+mg|mlg||Malagasy
+mt|mlt||Maltese
+|mnc||Manchu
+|mni||Manipuri
+|mno||Manobo languages
+|moh||Mohawk
+mn|mon||Mongolian
+|mos||Mossi
+mi|mri|mao|Maori
+ms|msa|may|Malay
+|mul||Multiple languages
+|mun||Munda languages
+|mus||Creek
+|mwl||Mirandese
+|mwr||Marwari
+my|mya|bur|Burmese
+|myn||Mayan languages
+|myv||Erzya
+|nah||Nahuatl languages
+|nai||North American Indian languages
+|nap||Neapolitan
+na|nau||Nauru
+nv|nav||Navajo; Navaho
+nr|nbl||Ndebele, South; South Ndebele
+nd|nde||Ndebele, North; North Ndebele
+ng|ndo||Ndonga
+|nds||Low German; Low Saxon; German, Low; Saxon, Low
+ne|nep||Nepali
+|new||Nepal Bhasa; Newari
+|nia||Nias
+|nic||Niger-Kordofanian languages
+|niu||Niuean
+nl|nld|dut|Dutch; Flemish
+nn|nno||Norwegian Nynorsk; Nynorsk, Norwegian
+nb|nob||Bokmål, Norwegian; Norwegian Bokmål
+|nog||Nogai
+|non||Norse, Old
+no|nor||Norwegian
+|nqo||N'Ko
+|nso||Pedi; Sepedi; Northern Sotho
+|nub||Nubian languages
+|nwc||Classical Newari; Old Newari; Classical Nepal Bhasa
+ny|nya||Chichewa; Chewa; Nyanja
+|nym||Nyamwezi
+|nyn||Nyankole
+|nyo||Nyoro
+|nzi||Nzima
+oc|oci||Occitan (post 1500); Provençal
+oj|oji||Ojibwa
+or|ori||Oriya
+om|orm||Oromo
+|osa||Osage
+os|oss||Ossetian; Ossetic
+|ota||Turkish, Ottoman (1500-1928)
+|oto||Otomian languages
+|paa||Papuan languages
+|pag||Pangasinan
+|pal||Pahlavi
+|pam||Pampanga; Kapampangan
+pa|pan||Panjabi; Punjabi
+|pap||Papiamento
+|pau||Palauan
+|peo||Persian, Old (ca. 600-400 B.C.)
+|phi||Philippine languages
+|phn||Phoenician
+pi|pli||Pali
+pl|pol||Polish
+|pon||Pohnpeian
+pt|por||Portuguese
+|pra||Prakrit languages
+|pro||Provençal, Old (to 1500)
+ps|pus||Pushto; Pashto
+qu|que||Quechua
+|raj||Rajasthani
+|rap||Rapanui
+|rar||Rarotongan; Cook Islands Maori
+|roa||Romance languages
+rm|roh||Romansh
+|rom||Romany
+ro|ron|rum|Romanian; Moldavian; Moldovan
+rn|run||Rundi
+|rup||Aromanian; Arumanian; Macedo-Romanian
+ru|rus||Russian
+|sad||Sandawe
+sg|sag||Sango
+|sah||Yakut
+|sai||South American Indian (Other)
+|sal||Salishan languages
+|sam||Samaritan Aramaic
+sa|san||Sanskrit
+|sas||Sasak
+|sat||Santali
+|scn||Sicilian
+|sco||Scots
+|sel||Selkup
+|sem||Semitic languages
+|sga||Irish, Old (to 900)
+|sgn||Sign Languages
+|shn||Shan
+|sid||Sidamo
+si|sin||Sinhala; Sinhalese
+|sio||Siouan languages
+|sit||Sino-Tibetan languages
+|sla||Slavic languages
+sk|slk|slo|Slovak
+sl|slv||Slovenian
+|sma||Southern Sami
+se|sme||Northern Sami
+|smi||Sami languages
+|smj||Lule Sami
+|smn||Inari Sami
+sm|smo||Samoan
+|sms||Skolt Sami
+sn|sna||Shona
+sd|snd||Sindhi
+|snk||Soninke
+|sog||Sogdian
+so|som||Somali
+|son||Songhai languages
+st|sot||Sotho, Southern
+es|spa||Spanish; Castilian
+sq|sqi|alb|Albanian
+sc|srd||Sardinian
+|srn||Sranan Tongo
+sr|srp||Serbian
+|srr||Serer
+|ssa||Nilo-Saharan languages
+ss|ssw||Swati
+|suk||Sukuma
+su|sun||Sundanese
+|sus||Susu
+|sux||Sumerian
+sw|swa||Swahili
+sv|swe||Swedish
+|syc||Classical Syriac
+|syr||Syriac
+ty|tah||Tahitian
+|tai||Tai languages
+ta|tam||Tamil
+tt|tat||Tatar
+te|tel||Telugu
+|tem||Timne
+|ter||Tereno
+|tet||Tetum
+tg|tgk||Tajik
+tl|tgl||Tagalog
+th|tha||Thai
+|tig||Tigre
+ti|tir||Tigrinya
+|tiv||Tiv
+|tkl||Tokelau
+|tlh||Klingon; tlhIngan-Hol
+|tli||Tlingit
+|tmh||Tamashek
+|tog||Tonga (Nyasa)
+to|ton||Tonga (Tonga Islands)
+|tpi||Tok Pisin
+|tsi||Tsimshian
+tn|tsn||Tswana
+ts|tso||Tsonga
+tk|tuk||Turkmen
+|tum||Tumbuka
+|tup||Tupi languages
+tr|tur||Turkish
+|tut||Altaic languages
+|tvl||Tuvalu
+tw|twi||Twi
+|tyv||Tuvinian
+|udm||Udmurt
+|uga||Ugaritic
+ug|uig||Uighur; Uyghur
+uk|ukr||Ukrainian
+|umb||Umbundu
+|und||Undetermined
+ur|urd||Urdu
+uz|uzb||Uzbek
+|vai||Vai
+ve|ven||Venda
+vi|vie||Vietnamese
+vo|vol||Volapük
+|vot||Votic
+|wak||Wakashan languages
+|wal||Walamo
+|war||Waray
+|was||Washo
+|wen||Sorbian languages
+wa|wln||Walloon
+wo|wol||Wolof
+|xal||Kalmyk; Oirat
+xh|xho||Xhosa
+|yao||Yao
+|yap||Yapese
+yi|yid||Yiddish
+yo|yor||Yoruba
+|ypk||Yupik languages
+|zap||Zapotec
+|zbl||Blissymbols; Blissymbolics; Bliss
+|zen||Zenaga
+|zgh||Standard Moroccan Tamazight
+za|zha||Zhuang; Chuang
+zh|zho|chi|Chinese
+|znd||Zande languages
+zu|zul||Zulu
+|zun||Zuni
+|zxx||No linguistic content; Not applicable
+|zza||Zaza; Dimili; Dimli; Kirdki; Kirmanjki; Zazaki`
@@ -0,0 +1,118 @@
+package validate
+
+import "testing"
+
+func TestCountryCode(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{name: "success - alpha-2", input: "BR", want: true},
+		{name: "success - alpha-3", input: "USA", want: true},
+		{name: "success - lowercase", input: "de", want: true},
+		{name: "fail - numeric", input: "076"},
+		{name: "fail - unknown", input: "XX"},
+		{name: "fail - empty", input: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountryCode(tt.input); got != tt.want {
+				t.Errorf("CountryCode(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookupCountry(t *testing.T) {
+	want := Country{Alpha2: "BR", Alpha3: "BRA", Numeric: "076", Name: "Brazil"}
+
+	for _, code := range []string{"BR", "bra", "076"} {
+		if got, ok := LookupCountry(code); !ok || got != want {
+			t.Errorf("LookupCountry(%q) = %+v, %v, want %+v", code, got, ok, want)
+		}
+	}
+
+	if got, _ := LookupCountry("KR"); got.Name != "South Korea" {
+		t.Errorf("LookupCountry() = %+v, want the common name", got)
+	}
+
+	if _, ok := LookupCountry("ZZ"); ok {
+		t.Errorf("LookupCountry() found an unknown code")
+	}
+}
+
+func TestCurrencyCode(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{name: "success - uppercase", input: "BRL", want: true},
+		{name: "success - lowercase", input: "eur", want: true},
+		{name: "fail - numeric", input: "986"},
+		{name: "fail - unknown", input: "ABC"},
+		{name: "fail - country code", input: "BR"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CurrencyCode(tt.input); got != tt.want {
+				t.Errorf("CurrencyCode(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookupCurrency(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want Currency
+	}{
+		{name: "success - brl", code: "BRL", want: Currency{Code: "BRL", Numeric: "986", Name: "Brazilian Real", Symbol: "R$", Decimals: 2}},
+		{name: "success - numeric", code: "978", want: Currency{Code: "EUR", Numeric: "978", Name: "Euro", Symbol: "€", Decimals: 2}},
+		{name: "success - no minor unit digits", code: "JPY", want: Currency{Code: "JPY", Numeric: "392", Name: "Yen", Symbol: "¥", Decimals: 0}},
+		{name: "success - three decimals", code: "KWD", want: Currency{Code: "KWD", Numeric: "414", Name: "Kuwaiti Dinar", Symbol: "KWD", Decimals: 3}},
+		{name: "success - not applicable", code: "XAU", want: Currency{Code: "XAU", Numeric: "959", Name: "Gold", Symbol: "XAU", Decimals: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, ok := LookupCurrency(tt.code); !ok || got != tt.want {
+				t.Errorf("LookupCurrency() = %+v, %v, want %+v", got, ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestLanguageCode(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{name: "success - iso 639-1", input: "pt", want: true},
+		{name: "success - iso 639-2 terminologic", input: "deu", want: true},
+		{name: "success - iso 639-2 bibliographic", input: "ger", want: true},
+		{name: "success - without iso 639-1 code", input: "haw", want: true},
+		{name: "success - uppercase", input: "EN", want: true},
+		{name: "fail - unknown", input: "xx"},
+		{name: "fail - locale", input: "pt-BR"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LanguageCode(tt.input); got != tt.want {
+				t.Errorf("LanguageCode(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookupLanguage(t *testing.T) {
+	want := Language{Alpha2: "de", Alpha3: "deu", Bibliographic: "ger", Name: "German"}
+
+	for _, code := range []string{"de", "DEU", "ger"} {
+		if got, ok := LookupLanguage(code); !ok || got != want {
+			t.Errorf("LookupLanguage(%q) = %+v, %v, want %+v", code, got, ok, want)
+		}
+	}
+}
@@ -0,0 +1,483 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// RuleFunc reports whether value satisfies a custom rule. param is the text after "=" in the tag, if any.
+// Pointers are dereferenced before the rule is called, and rules are not called for nil pointers.
+type RuleFunc func(value reflect.Value, param string) bool
+
+// FieldError is a field failing a rule of its validate tag.
+type FieldError struct {
+	Field string // Path of the field using JSON names, such as "address.city" or "items[2].name"
+	Rule  string // Name of the failed rule, such as "min"
+	Param string // Parameter of the rule, such as "3"
+	kind  reflect.Kind
+}
+
+// Error implements the error interface.
+func (e FieldError) Error() string {
+	return e.Field + " " + e.Message()
+}
+
+// Message returns a description of the failure without the field name, such as "must be at least 3 characters".
+func (e FieldError) Message() string {
+	unit := ""
+	switch e.kind {
+	case reflect.String:
+		unit = " characters"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		unit = " items"
+	}
+
+	switch e.Rule {
+	case "required":
+		return "is required"
+	case "min":
+		return "must be at least " + e.Param + unit
+	case "max":
+		return "must be at most " + e.Param + unit
+	case "len":
+		return "must be exactly " + e.Param + unit
+	case "oneof":
+		return "must be one of " + e.Param
+	case "email":
+		return "must be a valid email address"
+	case "url":
+		return "must be a valid URL"
+	case "cpf", "cnpj":
+		return "must be a valid " + strings.ToUpper(e.Rule)
+	default:
+		return "failed the " + e.Rule + " rule"
+	}
+}
+
+// Errors is the list of fields failing validation returned by Struct.
+type Errors []FieldError
+
+// Error implements the error interface.
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, fe := range e {
+		messages[i] = fe.Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// check is the form of the builtin rules, which may reject their parameter or the kind of value.
+type check func(value reflect.Value, param string) (bool, error)
+
+var (
+	rulesMu sync.RWMutex
+	rules   = map[string]check{
+		"min":   checkMin,
+		"max":   checkMax,
+		"len":   checkLen,
+		"oneof": checkOneOf,
+		"email": stringRule(Email),
+		"url":   stringRule(func(s string) bool { return URL(s, URLOptions{RequireHost: true}) == nil }),
+		"cpf":   stringRule(CPF),
+		"cnpj":  stringRule(CNPJ),
+	}
+)
+
+// Register adds a custom rule usable in validate tags, replacing any rule of the same name.
+// "required", "omitempty" and "dive" cannot be replaced.
+func Register(name string, fn RuleFunc) error {
+	if name == "" || strings.ContainsAny(name, ",= ") || fn == nil {
+		return fmt.Errorf("invalid rule %q", name)
+	}
+
+	if name == "required" || name == "omitempty" || name == "dive" {
+		return fmt.Errorf("rule %q cannot be replaced", name)
+	}
+
+	rulesMu.Lock()
+	defer rulesMu.Unlock()
+
+	rules[name] = func(value reflect.Value, param string) (bool, error) {
+		return fn(value, param), nil
+	}
+
+	return nil
+}
+
+/*
+Struct validates the exported fields of the struct v, or pointer to struct, according to their validate tags,
+such as `validate:"required,min=3,max=50"`. Rules are separated by commas and checked in order, stopping at
+the first failure of each field:
+
+  - required: the value is not zero, nil or empty
+  - omitempty: skip the other rules when the value is zero
+  - min=n, max=n, len=n: bounds of numbers, or of the length of strings (in runes), slices and maps
+  - oneof=a b c: the string or number is one of the space separated values
+  - email, url, cpf, cnpj: the string is valid for Email, URL with a required host, CPF or CNPJ
+  - dive: the following rules apply to each element of a slice, array or map instead of the field itself
+
+Nested structs, and structs in pointers, slices, arrays and maps, are validated recursively.
+A value referencing itself, directly or through other values, is validated once per cycle.
+Failures are returned as Errors; other errors report invalid tags or arguments.
+*/
+func Struct(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("validate: %T is not a struct", v)
+	}
+
+	w := walk{visiting: make(map[visit]bool)}
+	if key, ok := visitOf(rv); ok {
+		w.visiting[key] = true
+	}
+
+	if err := validateStruct(rv, "", &w); err != nil {
+		return err
+	}
+
+	if len(w.errs) > 0 {
+		return w.errs
+	}
+
+	return nil
+}
+
+// walk is the state of the validation of a struct.
+type walk struct {
+	errs     Errors
+	visiting map[visit]bool // values being validated, to stop at cycles through pointers and maps
+}
+
+// visit identifies a struct, slice or map reachable from several paths.
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
+
+type rule struct {
+	name  string
+	param string
+}
+
+func validateStruct(rv reflect.Value, prefix string, w *walk) error {
+	t := rv.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("validate")
+		if tag == "-" {
+			continue
+		}
+
+		path := prefix
+		if !field.Anonymous || field.Type.Kind() != reflect.Struct {
+			path = joinPath(prefix, fieldName(field))
+		}
+
+		fieldRules, elemRules, dive := parseTag(tag)
+		if err := validateValue(rv.Field(i), path, fieldRules, elemRules, dive, w); err != nil {
+			return fmt.Errorf("validate: field %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+func validateValue(v reflect.Value, path string, fieldRules, elemRules []rule, dive bool, w *walk) error {
+	for _, r := range fieldRules {
+		ok, stop, err := applyRule(v, r)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			w.errs = append(w.errs, FieldError{Field: path, Rule: r.name, Param: r.param, kind: indirect(v).Kind()})
+
+			return nil
+		}
+
+		if stop {
+			return nil
+		}
+	}
+
+	v = indirect(v)
+
+	// a value already being validated up the path is part of a cycle, validating it again would never end
+	if key, ok := visitOf(v); ok {
+		if w.visiting[key] {
+			return nil
+		}
+
+		w.visiting[key] = true
+		defer delete(w.visiting, key)
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		return validateStruct(v, path, w)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := validateElem(v.Index(i), fmt.Sprintf("%s[%d]", path, i), elemRules, dive, w); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			elemPath := fmt.Sprintf("%s[%v]", path, iter.Key().Interface())
+			if err := validateElem(iter.Value(), elemPath, elemRules, dive, w); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateElem(v reflect.Value, path string, elemRules []rule, dive bool, w *walk) error {
+	if !dive {
+		elemRules = nil
+	}
+
+	return validateValue(v, path, elemRules, nil, false, w)
+}
+
+// applyRule checks a rule against v. stop is true when the remaining rules must be skipped.
+func applyRule(v reflect.Value, r rule) (ok, stop bool, err error) {
+	switch r.name {
+	case "required":
+		return !isEmpty(v), false, nil
+	case "omitempty":
+		return true, isEmpty(v), nil
+	}
+
+	rulesMu.RLock()
+	fn, found := rules[r.name]
+	rulesMu.RUnlock()
+
+	if !found {
+		return false, false, fmt.Errorf("unknown rule %q", r.name)
+	}
+
+	v = indirect(v)
+	if !v.IsValid() {
+		return true, false, nil // Rules do not apply to nil pointers, rejected by required if needed
+	}
+
+	ok, err = fn(v, r.param)
+
+	return ok, false, err
+}
+
+func parseTag(tag string) (fieldRules, elemRules []rule, dive bool) {
+	if tag == "" {
+		return nil, nil, false
+	}
+
+	for _, part := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(strings.TrimSpace(part), "=")
+		if name == "dive" {
+			dive = true
+
+			continue
+		}
+
+		if dive {
+			elemRules = append(elemRules, rule{name: name, param: param})
+		} else {
+			fieldRules = append(fieldRules, rule{name: name, param: param})
+		}
+	}
+
+	return fieldRules, elemRules, dive
+}
+
+// fieldName returns the JSON name of the field, or its Go name when it has none.
+func fieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+
+	return name
+}
+
+func joinPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "." + name
+}
+
+// visitOf returns the identity of v if it can be reached again through a pointer, interface or map.
+func visitOf(v reflect.Value) (visit, bool) {
+	switch v.Kind() {
+	case reflect.Struct, reflect.Array:
+		if v.CanAddr() {
+			return visit{ptr: v.UnsafeAddr(), typ: v.Type()}, true
+		}
+	case reflect.Slice, reflect.Map:
+		if !v.IsNil() {
+			return visit{ptr: v.Pointer(), typ: v.Type(), len: v.Len()}, true
+		}
+	}
+
+	return visit{}, false
+}
+
+// indirect dereferences pointers and interfaces, returning the zero Value for nil ones.
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+
+		v = v.Elem()
+	}
+
+	return v
+}
+
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
+
+func stringRule(fn func(string) bool) check {
+	return func(v reflect.Value, _ string) (bool, error) {
+		if v.Kind() != reflect.String {
+			return false, fmt.Errorf("rule requires a string, got %s", v.Kind())
+		}
+
+		return fn(v.String()), nil
+	}
+}
+
+func checkMin(v reflect.Value, param string) (bool, error) {
+	cmp, err := compare(v, param)
+
+	return cmp >= 0, err
+}
+
+func checkMax(v reflect.Value, param string) (bool, error) {
+	cmp, err := compare(v, param)
+
+	return cmp <= 0, err
+}
+
+func checkLen(v reflect.Value, param string) (bool, error) {
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		cmp, err := compare(v, param)
+
+		return cmp == 0, err
+	default:
+		return false, fmt.Errorf("len requires a string, slice, array or map, got %s", v.Kind())
+	}
+}
+
+// compare returns -1, 0 or 1 as the number or length of v is lower than, equal to or greater than param.
+func compare(v reflect.Value, param string) (int, error) {
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return 0, fmt.Errorf("invalid length %q", param)
+		}
+
+		length := v.Len()
+		if v.Kind() == reflect.String {
+			length = utf8.RuneCountInString(v.String())
+		}
+
+		return sign(float64(length) - float64(n)), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(param, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid integer %q", param)
+		}
+
+		return compareOrdered(v.Int(), n), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(param, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid unsigned integer %q", param)
+		}
+
+		return compareOrdered(v.Uint(), n), nil
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid number %q", param)
+		}
+
+		return sign(v.Float() - n), nil
+	default:
+		return 0, fmt.Errorf("cannot compare %s", v.Kind())
+	}
+}
+
+func compareOrdered[T int64 | uint64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func sign(f float64) int {
+	switch {
+	case f < 0:
+		return -1
+	case f > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func checkOneOf(v reflect.Value, param string) (bool, error) {
+	var s string
+
+	switch v.Kind() {
+	case reflect.String:
+		s = v.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s = strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		s = strconv.FormatUint(v.Uint(), 10)
+	default:
+		return false, fmt.Errorf("oneof requires a string or integer, got %s", v.Kind())
+	}
+
+	for _, allowed := range strings.Fields(param) {
+		if s == allowed {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,316 @@
+package validate
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type address struct {
+	City    string `json:"city" validate:"required"`
+	ZipCode string `json:"zip_code" validate:"omitempty,len=8"`
+}
+
+type item struct {
+	Name     string `json:"name" validate:"required,max=10"`
+	Quantity int    `json:"quantity" validate:"min=1,max=99"`
+}
+
+type signup struct {
+	Name     string            `json:"name" validate:"required,min=3,max=50"`
+	Email    string            `json:"email" validate:"required,email"`
+	Website  string            `json:"website,omitempty" validate:"omitempty,url"`
+	Plan     string            `json:"plan" validate:"oneof=free pro enterprise"`
+	Age      *int              `json:"age" validate:"omitempty,min=18"`
+	Score    float64           `json:"score" validate:"max=1.5"`
+	Level    uint8             `json:"level" validate:"oneof=1 2 3"`
+	Tags     []string          `json:"tags" validate:"max=3,dive,required,max=5"`
+	Address  address           `json:"address"`
+	Shipping *address          `json:"shipping"`
+	Items    []item            `json:"items" validate:"required"`
+	Meta     map[string]string `json:"meta" validate:"dive,max=3"`
+	Document string            `validate:"omitempty,cpf"`
+	internal string            `validate:"required"`
+	Ignored  string            `validate:"-"`
+}
+
+func validSignup() signup {
+	age := 30
+
+	return signup{
+		Name:    "Ada Lovelace",
+		Email:   "ada@example.com",
+		Website: "https://example.com",
+		Plan:    "pro",
+		Age:     &age,
+		Score:   1.5,
+		Level:   2,
+		Tags:    []string{"math"},
+		Address: address{City: "London", ZipCode: "01310100"},
+		Items:   []item{{Name: "book", Quantity: 1}},
+		Meta:    map[string]string{"ref": "abc"},
+	}
+}
+
+func TestStruct(t *testing.T) {
+	if err := Struct(validSignup()); err != nil {
+		t.Fatalf("Struct() error = %v", err)
+	}
+
+	s := validSignup()
+	if err := Struct(&s); err != nil {
+		t.Fatalf("Struct() error = %v for a pointer", err)
+	}
+
+	underage := 17
+
+	tests := []struct {
+		name   string
+		modify func(s *signup)
+		want   []FieldError
+	}{
+		{name: "fail - required", modify: func(s *signup) { s.Name = "" },
+			want: []FieldError{{Field: "name", Rule: "required"}}},
+		{name: "fail - min runes", modify: func(s *signup) { s.Name = "Zé" },
+			want: []FieldError{{Field: "name", Rule: "min", Param: "3"}}},
+		{name: "fail - email", modify: func(s *signup) { s.Email = "ada" },
+			want: []FieldError{{Field: "email", Rule: "email"}}},
+		{name: "fail - url", modify: func(s *signup) { s.Website = "not a url" },
+			want: []FieldError{{Field: "website", Rule: "url"}}},
+		{name: "fail - oneof", modify: func(s *signup) { s.Plan = "gold" },
+			want: []FieldError{{Field: "plan", Rule: "oneof", Param: "free pro enterprise"}}},
+		{name: "fail - pointer min", modify: func(s *signup) { s.Age = &underage },
+			want: []FieldError{{Field: "age", Rule: "min", Param: "18"}}},
+		{name: "fail - float max", modify: func(s *signup) { s.Score = 1.6 },
+			want: []FieldError{{Field: "score", Rule: "max", Param: "1.5"}}},
+		{name: "fail - uint oneof", modify: func(s *signup) { s.Level = 4 },
+			want: []FieldError{{Field: "level", Rule: "oneof", Param: "1 2 3"}}},
+		{name: "fail - slice length", modify: func(s *signup) { s.Tags = []string{"a", "b", "c", "d"} },
+			want: []FieldError{{Field: "tags", Rule: "max", Param: "3"}}},
+		{name: "fail - dive", modify: func(s *signup) { s.Tags = []string{"ok", "", "toolong"} },
+			want: []FieldError{{Field: "tags[1]", Rule: "required"}, {Field: "tags[2]", Rule: "max", Param: "5"}}},
+		{name: "fail - dive map", modify: func(s *signup) { s.Meta = map[string]string{"ref": "abcd"} },
+			want: []FieldError{{Field: "meta[ref]", Rule: "max", Param: "3"}}},
+		{name: "fail - nested struct", modify: func(s *signup) { s.Address = address{ZipCode: "123"} },
+			want: []FieldError{{Field: "address.city", Rule: "required"}, {Field: "address.zip_code", Rule: "len", Param: "8"}}},
+		{name: "fail - nested pointer", modify: func(s *signup) { s.Shipping = &address{} },
+			want: []FieldError{{Field: "shipping.city", Rule: "required"}}},
+		{name: "fail - empty slice", modify: func(s *signup) { s.Items = []item{} },
+			want: []FieldError{{Field: "items", Rule: "required"}}},
+		{name: "fail - structs in slice", modify: func(s *signup) { s.Items = []item{{Name: "a", Quantity: 1}, {Quantity: 100}} },
+			want: []FieldError{{Field: "items[1].name", Rule: "required"}, {Field: "items[1].quantity", Rule: "max", Param: "99"}}},
+		{name: "fail - go field name", modify: func(s *signup) { s.Document = "123" },
+			want: []FieldError{{Field: "Document", Rule: "cpf"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := validSignup()
+			tt.modify(&s)
+
+			err := Struct(s)
+
+			var errs Errors
+			if !errors.As(err, &errs) {
+				t.Fatalf("Struct() error = %v, want Errors", err)
+			}
+
+			got := make([]FieldError, len(errs))
+			for i, fe := range errs {
+				got[i] = FieldError{Field: fe.Field, Rule: fe.Rule, Param: fe.Param}
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Struct() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStructMessages(t *testing.T) {
+	s := validSignup()
+	s.Name = "Al"
+	s.Tags = nil
+	s.Items = nil
+
+	err := Struct(s)
+	want := "name must be at least 3 characters; items is required"
+
+	if err == nil || err.Error() != want {
+		t.Errorf("Struct() error = %v, want %v", err, want)
+	}
+
+	tests := []struct {
+		err  FieldError
+		want string
+	}{
+		{err: FieldError{Rule: "max", Param: "3", kind: reflect.Slice}, want: "must be at most 3 items"},
+		{err: FieldError{Rule: "len", Param: "8", kind: reflect.String}, want: "must be exactly 8 characters"},
+		{err: FieldError{Rule: "min", Param: "1", kind: reflect.Int}, want: "must be at least 1"},
+		{err: FieldError{Rule: "oneof", Param: "a b"}, want: "must be one of a b"},
+		{err: FieldError{Rule: "url"}, want: "must be a valid URL"},
+		{err: FieldError{Rule: "cnpj"}, want: "must be a valid CNPJ"},
+		{err: FieldError{Rule: "even"}, want: "failed the even rule"},
+	}
+	for _, tt := range tests {
+		if got := tt.err.Message(); got != tt.want {
+			t.Errorf("Message() = %v, want %v", got, tt.want)
+		}
+	}
+}
+
+func TestStructEmbedded(t *testing.T) {
+	type Base struct {
+		ID string `json:"id" validate:"required"`
+	}
+
+	type user struct {
+		Base
+		Name string `json:"name"`
+	}
+
+	var errs Errors
+	if err := Struct(user{}); !errors.As(err, &errs) || len(errs) != 1 || errs[0].Field != "id" {
+		t.Errorf("Struct() error = %v, want id is required", err)
+	}
+}
+
+type node struct {
+	Name     string         `json:"name" validate:"required"`
+	Next     *node          `json:"next"`
+	Children map[string]any `json:"children"`
+}
+
+func TestStructCycles(t *testing.T) {
+	selfRef := &node{Name: "self"}
+	selfRef.Next = selfRef
+
+	emptySelf := &node{}
+	emptySelf.Next = emptySelf
+
+	loop := &node{Name: "a"}
+	loop.Next = &node{Next: loop}
+
+	self := &node{Name: "self", Children: map[string]any{}}
+	self.Children["self"] = self.Children
+
+	shared := &node{}
+	pair := node{Name: "pair", Next: &node{Name: "left", Next: shared}, Children: map[string]any{"right": &node{Name: "right", Next: shared}}}
+
+	tests := []struct {
+		name       string
+		value      any
+		wantFields []string
+	}{
+		{name: "success - self reference", value: selfRef, wantFields: nil},
+		{name: "fail - self reference failing a rule", value: emptySelf, wantFields: []string{"name"}},
+		{name: "fail - cycle through pointers", value: loop, wantFields: []string{"next.name"}},
+		{name: "success - cycle through a map", value: self, wantFields: nil},
+		{name: "fail - shared pointer reported on each path", value: pair, wantFields: []string{"next.next.name", "children[right].next.name"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Struct(tt.value)
+
+			var errs Errors
+			if err != nil && !errors.As(err, &errs) {
+				t.Fatalf("Struct() error = %v", err)
+			}
+
+			var got []string
+			for _, e := range errs {
+				got = append(got, e.Field)
+			}
+
+			if !reflect.DeepEqual(got, tt.wantFields) {
+				t.Errorf("Struct() fields = %v, want %v", got, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	err := Register("even", func(v reflect.Value, _ string) bool {
+		return v.CanInt() && v.Int()%2 == 0
+	})
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	err = Register("prefix", func(v reflect.Value, param string) bool {
+		return strings.HasPrefix(v.String(), param)
+	})
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	type order struct {
+		Count int    `validate:"even"`
+		Code  string `validate:"prefix=ORD-"`
+	}
+
+	if err := Struct(order{Count: 2, Code: "ORD-1"}); err != nil {
+		t.Errorf("Struct() error = %v", err)
+	}
+
+	if err := Struct(order{Count: 3, Code: "X"}); err == nil || err.Error() != "Count failed the even rule; Code failed the prefix rule" {
+		t.Errorf("Struct() error = %v", err)
+	}
+
+	for _, name := range []string{"", "a,b", "required", "dive"} {
+		if err := Register(name, func(reflect.Value, string) bool { return true }); err == nil {
+			t.Errorf("Register(%q) expected error", name)
+		}
+	}
+
+	if err := Register("nil", nil); err == nil {
+		t.Errorf("Register() expected error for a nil function")
+	}
+}
+
+func TestStructInvalid(t *testing.T) {
+	type unknownRule struct {
+		Name string `validate:"shiny"`
+	}
+
+	type badParam struct {
+		Name string `validate:"min=three"`
+	}
+
+	type badKind struct {
+		Count int `validate:"email"`
+	}
+
+	type badLen struct {
+		Count int `validate:"len=3"`
+	}
+
+	type badOneOf struct {
+		Flag bool `validate:"oneof=true"`
+	}
+
+	var nilPointer *signup
+
+	tests := []struct {
+		name  string
+		input interface{}
+	}{
+		{name: "fail - not a struct", input: "signup"},
+		{name: "fail - nil pointer", input: nilPointer},
+		{name: "fail - unknown rule", input: unknownRule{}},
+		{name: "fail - bad parameter", input: badParam{}},
+		{name: "fail - string rule on int", input: badKind{}},
+		{name: "fail - len on int", input: badLen{}},
+		{name: "fail - oneof on bool", input: badOneOf{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Struct(tt.input)
+
+			var errs Errors
+			if err == nil || errors.As(err, &errs) {
+				t.Errorf("Struct() error = %v, want a non validation error", err)
+			}
+		})
+	}
+}